@@ -194,12 +194,27 @@ var allNameOptions = map[string]nameOption{
 
 // calls checks
 
+// expectPodmanPodsMatchingSelector expects the search for the pods running the Kubernetes components on Podman
+func expectPodmanPodsMatchingSelector(clientset clientset.Clientset, testContext testContext) {
+	podmanMock := clientset.PodmanClient.(*podman.MockClient)
+	selector := "app.kubernetes.io/instance=my-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=app"
+	if testContext.runningInOption != "deploy" {
+		podmanMock.EXPECT().GetPodsMatchingSelector(selector+",odo.dev/mode=Dev").Return(&corev1.PodList{}, nil).AnyTimes()
+	}
+	if testContext.runningInOption != "dev" {
+		podmanMock.EXPECT().GetPodsMatchingSelector(selector+",odo.dev/mode=Deploy").Return(&corev1.PodList{}, nil).AnyTimes()
+	}
+}
+
 var checkCallsNonDeployedComponent = func(t *testing.T, clientset clientset.Clientset, testContext testContext) {
 	if strings.Contains(testContext.platform, "podman") &&
 		testContext.runningInOption != "deploy" {
 		podmanMock := clientset.PodmanClient.(*podman.MockClient)
 		podmanMock.EXPECT().PodLs()
 	}
+	if strings.Contains(testContext.platform, "podman") {
+		expectPodmanPodsMatchingSelector(clientset, testContext)
+	}
 	if strings.Contains(testContext.platform, "kubernetes") {
 		kubeMock := clientset.KubernetesClient.(*kclient.MockClientInterface)
 		dep := appsv1.Deployment{}
@@ -226,6 +241,10 @@ var checkCallsDeployedComponent = func(t *testing.T, clientset clientset.Clients
 		podmanMock.EXPECT().KubeGenerate("my-component-app").Return(&pod, nil)
 		// The pod and its volumes should be deleted
 		podmanMock.EXPECT().CleanupPodResources(&pod, true)
+		podmanMock.EXPECT().NetworkRm("odo-my-component-app")
+	}
+	if strings.Contains(testContext.platform, "podman") {
+		expectPodmanPodsMatchingSelector(clientset, testContext)
 	}
 	if strings.Contains(testContext.platform, "kubernetes") {
 		kubeMock := clientset.KubernetesClient.(*kclient.MockClientInterface)
@@ -29,8 +29,11 @@ Examples:
 
 	mainCommands = `Main Commands:
   build-images Build images
+  cp           Copy files between the local machine and a container of the component running in the Dev mode
   deploy       Run your application on the cluster in the Deploy mode
-  dev          Run your application on the cluster in the Dev mode
+  dev          Run your application on the cluster in the Dev mode (attach, ide-config, list, push, stop)
+  exec         Execute a command in a container of the component running in the Dev mode
+  files        Inspect the files synchronized into the component (status)
   init         Init bootstraps a new project
   logs         Show logs of all containers of the component
   registry     List all components from the Devfile registry
@@ -56,6 +59,7 @@ Examples:
 	utilityCommands = `Utility Commands:
   analyze      Detect devfile to use based on files present in current directory
   completion   Add odo completion support to your development environment
+  export       Export the Devfile into files for other tools (compose, kubernetes, quadlet)
   preference   Modifies preference settings (add, remove, set, unset, view)
   version      Print the client version information
 
@@ -6,6 +6,8 @@ type Component struct {
 	DevfileData       *DevfileData      `json:"devfileData,omitempty"`
 	DevControlPlane   []DevControlPlane `json:"devControlPlane,omitempty"`
 	DevForwardedPorts []ForwardedPort   `json:"devForwardedPorts,omitempty"`
+	// DevCommands are the statuses of the run or debug commands executed by the Dev sessions
+	DevCommands []DevCommandStatus `json:"devCommands,omitempty"`
 	// RunningIn is the overall running mode map of the component;
 	// this is computing as a merge of RunningOn (all the different running modes
 	// for each platform the component is running on).
@@ -34,6 +36,31 @@ func (o ForwardedPort) GetPlatform() string {
 	return o.Platform
 }
 
+// ReverseForwardedPort is a local address made reachable from the containers of the component running on the cluster,
+// on a port of the network of the pod
+type ReverseForwardedPort struct {
+	// RemotePort is the port on which the containers of the component reach the local address
+	RemotePort   int    `json:"remotePort"`
+	LocalAddress string `json:"localAddress"`
+	LocalPort    int    `json:"localPort"`
+}
+
+// DevCommandStatus is the status of the process of a run or debug command executed by a Dev session
+type DevCommandStatus struct {
+	Platform string `json:"platform,omitempty"`
+	// Component is the name of the component the command belongs to, when several components are developed in the same session
+	Component string `json:"component,omitempty"`
+	Name      string `json:"name"`
+	// Status of the process: starting, running, stopped or errored
+	Status string `json:"status"`
+	// Restarts is the number of times the process has been restarted after exiting
+	Restarts int `json:"restarts"`
+}
+
+func (o DevCommandStatus) GetPlatform() string {
+	return o.Platform
+}
+
 type DevControlPlane struct {
 	Platform         string `json:"platform,omitempty"`
 	LocalPort        int    `json:"localPort"`
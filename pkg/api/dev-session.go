@@ -0,0 +1,20 @@
+package api
+
+// DevSession describes an `odo dev` session running in a directory
+type DevSession struct {
+	// PID is the ID of the odo process running the session
+	PID int `json:"pid"`
+	// Platform is the platform on which the session runs
+	Platform string `json:"platform"`
+	// APIServerPort is the local port on which the API server of the session is listening, if any
+	APIServerPort int `json:"apiServerPort,omitempty"`
+	// ForwardedPorts are the ports forwarded by the session
+	ForwardedPorts []ForwardedPort `json:"forwardedPorts"`
+	// LogFile is the file containing the output of the session, when the session runs in the background
+	LogFile string `json:"logFile,omitempty"`
+}
+
+// IsDetached returns true if the session runs in the background
+func (o DevSession) IsDetached() bool {
+	return o.LogFile != ""
+}
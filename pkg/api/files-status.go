@@ -0,0 +1,17 @@
+package api
+
+// FilesStatus describes the differences between the local files of a component and the files synchronized into its container.
+// Paths are relative to the component directory, and use slashes as separators.
+type FilesStatus struct {
+	// Added are the local files not present in the container
+	Added []string `json:"added"`
+	// Modified are the files whose content differs between the local directory and the container
+	Modified []string `json:"modified"`
+	// Deleted are the files deleted locally but still present in the container
+	Deleted []string `json:"deleted"`
+}
+
+// HasDrift returns true if the local files and the files in the container differ
+func (o FilesStatus) HasDrift() bool {
+	return len(o.Added) > 0 || len(o.Modified) > 0 || len(o.Deleted) > 0
+}
@@ -13,10 +13,18 @@ type ComponentGet200Response struct {
 
 	// Description of the component. This is the same as output of 'odo describe component -o json'
 	Component map[string]interface{} `json:"component,omitempty"`
+
+	// Statuses of the run or debug commands executed by the Dev sessions
+	DevCommands []DevCommandStatus `json:"devCommands,omitempty"`
 }
 
 // AssertComponentGet200ResponseRequired checks if the required fields are not zero-ed
 func AssertComponentGet200ResponseRequired(obj ComponentGet200Response) error {
+	for _, el := range obj.DevCommands {
+		if err := AssertDevCommandStatusRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,52 @@
+/*
+ * odo dev
+ *
+ * API interface for 'odo dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type DevCommandStatus struct {
+	Platform string `json:"platform,omitempty"`
+
+	// Name of the component the command belongs to, when several components are developed in the same session
+	Component string `json:"component,omitempty"`
+
+	Name string `json:"name"`
+
+	// Status of the process: starting, running, stopped or errored
+	Status string `json:"status"`
+
+	// Number of times the process has been restarted after exiting
+	Restarts int32 `json:"restarts,omitempty"`
+}
+
+// AssertDevCommandStatusRequired checks if the required fields are not zero-ed
+func AssertDevCommandStatusRequired(obj DevCommandStatus) error {
+	elements := map[string]interface{}{
+		"name":   obj.Name,
+		"status": obj.Status,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseDevCommandStatusRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DevCommandStatus (e.g. [][]DevCommandStatus), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDevCommandStatusRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDevCommandStatus, ok := obj.(DevCommandStatus)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDevCommandStatusRequired(aDevCommandStatus)
+	})
+}
@@ -33,6 +33,9 @@ type DefaultApiService struct {
 	podmanClient     podman.Client
 	stateClient      state.Client
 	preferenceClient preference.Client
+	// singleComponent is false when the Dev session develops several components,
+	// in which case the endpoints specific to a component are rejected
+	singleComponent bool
 
 	devfileState devstate.DevfileState
 }
@@ -45,6 +48,7 @@ func NewDefaultApiService(
 	podmanClient podman.Client,
 	stateClient state.Client,
 	preferenceClient preference.Client,
+	singleComponent bool,
 ) openapi.DefaultApiServicer {
 	return &DefaultApiService{
 		cancel:           cancel,
@@ -53,6 +57,7 @@ func NewDefaultApiService(
 		podmanClient:     podmanClient,
 		stateClient:      stateClient,
 		preferenceClient: preferenceClient,
+		singleComponent:  singleComponent,
 
 		devfileState: devstate.NewDevfileState(),
 	}
@@ -80,8 +85,18 @@ func (s *DefaultApiService) ComponentCommandPost(ctx context.Context, componentC
 	}
 }
 
+// severalComponentsResponse is the response of the endpoints specific to a component, when the Dev session develops several components
+func severalComponentsResponse() openapi.ImplResponse {
+	return openapi.Response(http.StatusBadRequest, openapi.GeneralError{
+		Message: "the Dev session develops several components, this endpoint is only available for a single component",
+	})
+}
+
 // ComponentGet -
 func (s *DefaultApiService) ComponentGet(ctx context.Context) (openapi.ImplResponse, error) {
+	if !s.singleComponent {
+		return severalComponentsResponse(), nil
+	}
 	value, _, err := describe.DescribeDevfileComponent(ctx, s.kubeClient, s.podmanClient, s.stateClient)
 	if err != nil {
 		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
@@ -109,6 +124,9 @@ func (s *DefaultApiService) InstanceGet(ctx context.Context) (openapi.ImplRespon
 }
 
 func (s *DefaultApiService) DevfileGet(ctx context.Context) (openapi.ImplResponse, error) {
+	if !s.singleComponent {
+		return severalComponentsResponse(), nil
+	}
 	devfilePath := odocontext.GetDevfilePath(ctx)
 	content, err := os.ReadFile(devfilePath)
 	if err != nil {
@@ -123,6 +141,9 @@ func (s *DefaultApiService) DevfileGet(ctx context.Context) (openapi.ImplRespons
 }
 
 func (s *DefaultApiService) DevfilePut(ctx context.Context, params openapi.DevfilePutRequest) (openapi.ImplResponse, error) {
+	if !s.singleComponent {
+		return severalComponentsResponse(), nil
+	}
 
 	tmpdir, err := func() (string, error) {
 		dir, err := os.MkdirTemp("", "odo")
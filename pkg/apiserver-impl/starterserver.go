@@ -35,6 +35,9 @@ type ApiServer struct {
 	PushWatcher <-chan struct{}
 }
 
+// StartServer starts the API server, which is shut down when ctx is cancelled.
+// devfilePath is empty when the Dev session develops several components: the endpoints specific to a component are then rejected,
+// and the other endpoints, like the ones requesting a push or stopping the session, apply to all the components.
 func StartServer(
 	ctx context.Context,
 	cancelFunc context.CancelFunc,
@@ -57,6 +60,7 @@ func StartServer(
 		podmanClient,
 		stateClient,
 		preferenceClient,
+		devfilePath != "",
 	)
 	defaultApiController := openapi.NewDefaultApiController(defaultApiService)
 	devstateApiService := NewDevstateApiService(
@@ -6,8 +6,10 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -22,6 +24,7 @@ import (
 // kubernetes: the kubernetes devfile component to be deployed
 // kubeClient: Kubernetes client to be used to deploy the resource
 // path: path to the context directory
+// loadedImages: the images loaded into a local cluster, whose pull policy is set to IfNotPresent
 func ApplyKubernetes(
 	mode string,
 	appName string,
@@ -30,6 +33,7 @@ func ApplyKubernetes(
 	kubernetes devfilev1.Component,
 	kubeClient kclient.ClientInterface,
 	path string,
+	loadedImages []string,
 ) error {
 	// TODO: Use GetK8sComponentAsUnstructured here and pass it to ValidateResourcesExistInK8sComponent
 	// Validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
@@ -38,20 +42,10 @@ func ApplyKubernetes(
 		return fmt.Errorf("%s: %w", kind, err)
 	}
 
-	// Get the most common labels that's applicable to all resources being deployed.
-	// Set the mode. Regardless of what Kubernetes resource we are deploying.
-	runtime := GetComponentRuntimeFromDevfileMetadata(devfile.Data.GetMetadata())
-	labels := odolabels.GetLabels(componentName, appName, runtime, mode, false)
-
-	klog.V(4).Infof("Injecting labels: %+v into k8s artifact", labels)
-
-	// Create the annotations
-	// Retrieve the component type from the devfile and also inject it into the list of annotations
-	annotations := make(map[string]string)
-	odolabels.SetProjectType(annotations, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
+	labels, annotations := getK8sLabelsAndAnnotations(mode, appName, componentName, devfile)
 
 	// Get the Kubernetes component
-	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
+	uList, err := RenderKubernetes(mode, appName, componentName, devfile, kubernetes, path, loadedImages)
 	if err != nil {
 		return err
 	}
@@ -65,3 +59,44 @@ func ApplyKubernetes(
 	}
 	return nil
 }
+
+// RenderKubernetes returns the resources of the Kubernetes or OpenShift component, as ApplyKubernetes would apply them,
+// with the labels and annotations of odo, but without applying them
+func RenderKubernetes(
+	mode string,
+	appName string,
+	componentName string,
+	devfile parser.DevfileObj,
+	kubernetes devfilev1.Component,
+	path string,
+	loadedImages []string,
+) ([]unstructured.Unstructured, error) {
+	labels, annotations := getK8sLabelsAndAnnotations(mode, appName, componentName, devfile)
+
+	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range uList {
+		image.SetPullPolicy(&uList[i], loadedImages)
+		uList[i].SetLabels(service.MergeMaps(uList[i].GetLabels(), labels))
+		uList[i].SetAnnotations(service.MergeMaps(uList[i].GetAnnotations(), annotations))
+	}
+	return uList, nil
+}
+
+// getK8sLabelsAndAnnotations returns the labels and annotations set by odo on the resources of the Kubernetes and OpenShift components
+func getK8sLabelsAndAnnotations(mode string, appName string, componentName string, devfile parser.DevfileObj) (map[string]string, map[string]string) {
+	// Get the most common labels that's applicable to all resources being deployed.
+	// Set the mode. Regardless of what Kubernetes resource we are deploying.
+	runtime := GetComponentRuntimeFromDevfileMetadata(devfile.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, runtime, mode, false)
+
+	klog.V(4).Infof("Injecting labels: %+v into k8s artifact", labels)
+
+	// Create the annotations
+	// Retrieve the component type from the devfile and also inject it into the list of annotations
+	annotations := make(map[string]string)
+	odolabels.SetProjectType(annotations, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
+	return labels, annotations
+}
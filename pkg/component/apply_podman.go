@@ -0,0 +1,479 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// ApplyKubernetesOnPodman contains the logic to run on Podman the workloads defined by the `apply` command.
+// The Deployments, StatefulSets and Pods of the Kubernetes component are translated into Pods played with Podman,
+// connected to a network shared by all the pods of the component:
+// the ConfigMaps and Secrets they reference (from any Kubernetes component of the Devfile) are resolved into environment variables,
+// the Services selecting them publish their ports on the local machine and are used as DNS names on the network,
+// and the PersistentVolumeClaims become Podman volumes.
+// A pod already running with the same definition is kept as is.
+// A pod with the same name deployed in the other mode, or not deployed by odo for the component, is not replaced, and an error is returned.
+// The names of the pods of the workloads are returned.
+// mode(Dev, Deploy): the mode in which the resources are deployed
+// appName: application name
+// devfile: the devfile object
+// kubernetes: the kubernetes devfile component to be deployed
+// podmanClient: Podman client to be used to play the pods
+// path: path to the context directory
+// localImages: the images built locally, whose pull policy is set to IfNotPresent
+func ApplyKubernetesOnPodman(
+	mode string,
+	appName string,
+	componentName string,
+	devfile parser.DevfileObj,
+	kubernetes devfilev1.Component,
+	podmanClient podman.Client,
+	path string,
+	localImages []string,
+) ([]string, error) {
+	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The resources referenced by the workloads can be defined in other Kubernetes components
+	allResources, err := getAllK8sResources(devfile, path)
+	if err != nil {
+		return nil, err
+	}
+
+	podmanCaps, err := podmanClient.GetCapabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := GetPodmanNetworkName(componentName, appName)
+	if err != nil {
+		return nil, err
+	}
+	err = podmanClient.NetworkCreate(network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the network %q on Podman: %w", network, err)
+	}
+
+	existingPods, err := podmanClient.PodLs()
+	if err != nil {
+		return nil, err
+	}
+
+	// The modes and the hashes of the definitions of the pods already deployed for the component, in any mode
+	pods, err := podmanClient.GetAllResourcesFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentAnyMode, false), "")
+	if err != nil {
+		return nil, err
+	}
+	deployedPods := make(map[string]deployedPod, len(pods))
+	for _, p := range pods {
+		deployedPods[p.GetName()] = deployedPod{
+			mode: odolabels.GetMode(p.GetLabels()),
+			hash: odolabels.GetSpecHash(p.GetLabels()),
+		}
+	}
+
+	componentRuntime := GetComponentRuntimeFromDevfileMetadata(devfile.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, componentRuntime, mode, false)
+
+	var podNames []string
+	for _, u := range uList {
+		pod, aliases, err := GetPodFromK8sResource(u, allResources)
+		if err != nil {
+			return nil, err
+		}
+		if pod == nil {
+			if !isResourceUsedByPodmanPods(u.GetKind()) {
+				log.Warningf("Kubernetes resources of kind %s are not supported on Podman. Skipping: %v.", u.GetKind(), u.GetName())
+			}
+			continue
+		}
+
+		log.Sectionf("Deploying Kubernetes Component on Podman: %s", u.GetName())
+
+		for k, v := range labels {
+			pod.Labels[k] = v
+		}
+		odolabels.SetProjectType(pod.Labels, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
+		image.SetContainersPullPolicy(pod.Spec.Containers, localImages)
+		if !podmanCaps.Cgroupv2 {
+			for i := range pod.Spec.Containers {
+				delete(pod.Spec.Containers[i].Resources.Limits, corev1.ResourceMemory)
+			}
+		}
+
+		hash, err := getPodSpecHash(pod, network, aliases)
+		if err != nil {
+			return nil, err
+		}
+		podNames = append(podNames, pod.GetName())
+		deployed, isDeployed := deployedPods[pod.GetName()]
+		if isDeployed && deployed.mode == mode && deployed.hash == hash {
+			klog.V(4).Infof("pod %q is already deployed as required", pod.GetName())
+			continue
+		}
+		odolabels.SetSpecHash(pod.Labels, hash)
+
+		// Replace the pod deployed previously in this mode, keeping its volumes
+		if existingPods[pod.GetName()] {
+			err = checkPodReplaceable(pod.GetName(), mode, deployed, isDeployed)
+			if err != nil {
+				return nil, err
+			}
+			klog.V(4).Infof("replacing existing pod %q", pod.GetName())
+			err = podmanClient.CleanupPodResources(pod, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = podmanClient.PlayKubeOnNetwork(pod, network, aliases)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the pod %q on Podman: %w", pod.GetName(), err)
+		}
+	}
+	return podNames, nil
+}
+
+// deployedPod is a pod deployed on Podman for the component
+type deployedPod struct {
+	mode string
+	hash string
+}
+
+// checkPodReplaceable returns an error if the existing pod, about to be replaced by a pod played in mode,
+// was deployed in another mode, or was not deployed by odo for the component (isDeployed is false)
+func checkPodReplaceable(name string, mode string, deployed deployedPod, isDeployed bool) error {
+	if !isDeployed {
+		return fmt.Errorf("the pod %q already exists on Podman and was not created by odo for this component, delete it before running the command again", name)
+	}
+	if deployed.mode != mode {
+		return fmt.Errorf("the pod %q is already running in %s mode on Podman, delete it with `odo delete component --running-in %s` before running the command in %s mode",
+			name, deployed.mode, strings.ToLower(deployed.mode), mode)
+	}
+	return nil
+}
+
+// GetPodmanNetworkName returns the name of the Podman network shared by the pods of the component
+func GetPodmanNetworkName(componentName string, appName string) (string, error) {
+	name, err := util.NamespaceKubernetesObject(componentName, appName)
+	if err != nil {
+		return "", err
+	}
+	return "odo-" + name, nil
+}
+
+// getPodSpecHash returns a hash of the definition of the pod and of its connection to the network
+func getPodSpecHash(pod *corev1.Pod, network string, aliases []string) (string, error) {
+	data, err := json.Marshal(struct {
+		Pod     *corev1.Pod
+		Network string
+		Aliases []string
+	}{pod, network, aliases})
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%x", h.Sum64()), nil
+}
+
+// getAllK8sResources returns the resources defined by all the Kubernetes and OpenShift components of the devfile
+func getAllK8sResources(devfile parser.DevfileObj, path string) ([]unstructured.Unstructured, error) {
+	components, err := libdevfile.GetK8sAndOcComponentsToPush(devfile, true)
+	if err != nil {
+		return nil, err
+	}
+	var result []unstructured.Unstructured
+	for _, c := range components {
+		uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, c.Name, path, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, uList...)
+	}
+	return result, nil
+}
+
+// isResourceUsedByPodmanPods returns true if the resources of this kind are not played by themselves,
+// but are used to define the pods played with Podman
+func isResourceUsedByPodmanPods(kind string) bool {
+	switch kind {
+	case "ConfigMap", "Secret", "Service", "PersistentVolumeClaim":
+		return true
+	}
+	return false
+}
+
+// GetPodFromK8sResource returns the pod to play with Podman to run the Deployment, the StatefulSet or the Pod defined by u,
+// or nil if u is not such a workload.
+// The ConfigMaps, Secrets and Services referencing the pod are searched into resources;
+// the names of the Services selecting the pod are returned, to be used as DNS aliases of the pod.
+func GetPodFromK8sResource(u unstructured.Unstructured, resources []unstructured.Unstructured) (*corev1.Pod, []string, error) {
+	var pod corev1.Pod
+	switch u.GetKind() {
+	case "Pod":
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &pod)
+		if err != nil {
+			return nil, nil, err
+		}
+		pod.Namespace = ""
+		pod.Status = corev1.PodStatus{}
+	case "Deployment":
+		var deployment appsv1.Deployment
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &deployment)
+		if err != nil {
+			return nil, nil, err
+		}
+		pod.SetName(deployment.GetName())
+		pod.SetLabels(deployment.Spec.Template.GetLabels())
+		pod.SetAnnotations(deployment.Spec.Template.GetAnnotations())
+		pod.Spec = deployment.Spec.Template.Spec
+	case "StatefulSet":
+		var statefulSet appsv1.StatefulSet
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &statefulSet)
+		if err != nil {
+			return nil, nil, err
+		}
+		pod.SetName(statefulSet.GetName())
+		pod.SetLabels(statefulSet.Spec.Template.GetLabels())
+		pod.SetAnnotations(statefulSet.Spec.Template.GetAnnotations())
+		pod.Spec = statefulSet.Spec.Template.Spec
+		// The claims are named as the ones of the first replica on a cluster
+		for _, claim := range statefulSet.Spec.VolumeClaimTemplates {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+				Name: claim.GetName(),
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: fmt.Sprintf("%s-%s-0", claim.GetName(), statefulSet.GetName()),
+					},
+				},
+			})
+		}
+	default:
+		return nil, nil, nil
+	}
+	pod.APIVersion, pod.Kind = corev1.SchemeGroupVersion.WithKind("Pod").ToAPIVersionAndKind()
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+
+	var (
+		configMaps = make(map[string]map[string]string)
+		secrets    = make(map[string]map[string]string)
+		services   []corev1.Service
+	)
+	for _, r := range resources {
+		switch r.GetKind() {
+		case "ConfigMap":
+			var configMap corev1.ConfigMap
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(r.UnstructuredContent(), &configMap); err != nil {
+				return nil, nil, err
+			}
+			configMaps[configMap.GetName()] = configMap.Data
+		case "Secret":
+			var secret corev1.Secret
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(r.UnstructuredContent(), &secret); err != nil {
+				return nil, nil, err
+			}
+			data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+			for k, v := range secret.Data {
+				data[k] = string(v)
+			}
+			for k, v := range secret.StringData {
+				data[k] = v
+			}
+			secrets[secret.GetName()] = data
+		case "Service":
+			var service corev1.Service
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(r.UnstructuredContent(), &service); err != nil {
+				return nil, nil, err
+			}
+			services = append(services, service)
+		}
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.ConfigMap != nil || volume.Secret != nil || volume.Projected != nil {
+			return nil, nil, fmt.Errorf("volume %q of pod %q: ConfigMap, Secret and projected volumes are not supported on Podman", volume.Name, pod.GetName())
+		}
+	}
+
+	for i := range pod.Spec.InitContainers {
+		err := resolveContainerEnv(&pod.Spec.InitContainers[i], configMaps, secrets)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for i := range pod.Spec.Containers {
+		err := resolveContainerEnv(&pod.Spec.Containers[i], configMaps, secrets)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var aliases []string
+	for _, service := range services {
+		if addServiceHostPorts(&pod, service) {
+			aliases = append(aliases, service.GetName())
+		}
+	}
+	return &pod, aliases, nil
+}
+
+// resolveContainerEnv replaces the environment variables of the container taking their values from ConfigMaps and Secrets
+// with the values found in configMaps and secrets
+func resolveContainerEnv(container *corev1.Container, configMaps map[string]map[string]string, secrets map[string]map[string]string) error {
+	var env []corev1.EnvVar
+	for _, from := range container.EnvFrom {
+		var (
+			data     map[string]string
+			found    bool
+			name     string
+			optional *bool
+		)
+		switch {
+		case from.ConfigMapRef != nil:
+			name, optional = from.ConfigMapRef.Name, from.ConfigMapRef.Optional
+			data, found = configMaps[name]
+		case from.SecretRef != nil:
+			name, optional = from.SecretRef.Name, from.SecretRef.Optional
+			data, found = secrets[name]
+		default:
+			continue
+		}
+		if !found {
+			if optional != nil && *optional {
+				continue
+			}
+			return fmt.Errorf("container %q: %q referenced by envFrom is not defined in the Devfile", container.Name, name)
+		}
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			env = append(env, corev1.EnvVar{Name: from.Prefix + k, Value: data[k]})
+		}
+	}
+	container.EnvFrom = nil
+
+	for _, e := range container.Env {
+		if e.ValueFrom == nil {
+			env = append(env, e)
+			continue
+		}
+		var (
+			data     map[string]string
+			found    bool
+			name     string
+			key      string
+			optional *bool
+		)
+		switch {
+		case e.ValueFrom.ConfigMapKeyRef != nil:
+			ref := e.ValueFrom.ConfigMapKeyRef
+			name, key, optional = ref.Name, ref.Key, ref.Optional
+			data, found = configMaps[name]
+		case e.ValueFrom.SecretKeyRef != nil:
+			ref := e.ValueFrom.SecretKeyRef
+			name, key, optional = ref.Name, ref.Key, ref.Optional
+			data, found = secrets[name]
+		default:
+			// Field and resource references are resolved by Podman
+			env = append(env, e)
+			continue
+		}
+		value, hasKey := data[key]
+		if !found || !hasKey {
+			if optional != nil && *optional {
+				continue
+			}
+			return fmt.Errorf("container %q: key %q of %q referenced by the environment variable %q is not defined in the Devfile", container.Name, key, name, e.Name)
+		}
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: value})
+	}
+	container.Env = env
+	return nil
+}
+
+// addServiceHostPorts publishes on the local machine the ports of the service, if the service selects the pod;
+// it returns true if the service selects the pod.
+// The ports of the service are used as host ports for the target ports of the containers.
+func addServiceHostPorts(pod *corev1.Pod, service corev1.Service) bool {
+	selector := service.Spec.Selector
+	if len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if pod.Labels[k] != v {
+			return false
+		}
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return true
+	}
+
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Protocol != "" && servicePort.Protocol != corev1.ProtocolTCP {
+			klog.V(4).Infof("port %d of service %q: only TCP ports are published on Podman", servicePort.Port, service.GetName())
+			continue
+		}
+		target := servicePort.TargetPort
+		if target.Type == intstr.Int && target.IntVal == 0 {
+			target = intstr.FromInt(int(servicePort.Port))
+		}
+		if !setHostPort(pod.Spec.Containers, target, servicePort.Port) {
+			if target.Type == intstr.String {
+				klog.V(4).Infof("port %q of service %q is not defined by any container of pod %q", target.StrVal, service.GetName(), pod.GetName())
+				continue
+			}
+			// The port is not declared by the containers, declare it on the first one
+			pod.Spec.Containers[0].Ports = append(pod.Spec.Containers[0].Ports, corev1.ContainerPort{
+				Name:          servicePort.Name,
+				ContainerPort: target.IntVal,
+				HostPort:      servicePort.Port,
+				HostIP:        "127.0.0.1",
+			})
+		}
+	}
+	return true
+}
+
+// setHostPort sets the host port of the container port matching target; it returns false if no container port matches
+func setHostPort(containers []corev1.Container, target intstr.IntOrString, hostPort int32) bool {
+	for i := range containers {
+		for j := range containers[i].Ports {
+			port := &containers[i].Ports[j]
+			if (target.Type == intstr.String && port.Name == target.StrVal) ||
+				(target.Type == intstr.Int && port.ContainerPort == target.IntVal) {
+				port.HostPort = hostPort
+				port.HostIP = "127.0.0.1"
+				return true
+			}
+		}
+	}
+	return false
+}
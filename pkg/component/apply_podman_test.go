@@ -0,0 +1,355 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+func toUnstructured(t *testing.T, manifest string) unstructured.Unstructured {
+	var u unstructured.Unstructured
+	if err := yaml.Unmarshal([]byte(manifest), &u.Object); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+const (
+	deploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: my-app
+  template:
+    metadata:
+      labels:
+        app: my-app
+    spec:
+      containers:
+      - name: main
+        image: my-image
+        ports:
+        - name: http
+          containerPort: 8080
+        env:
+        - name: FROM_CONFIGMAP
+          valueFrom:
+            configMapKeyRef:
+              name: my-config
+              key: key1
+        - name: FROM_SECRET
+          valueFrom:
+            secretKeyRef:
+              name: my-secret
+              key: password
+        - name: LITERAL
+          value: literal
+        envFrom:
+        - configMapRef:
+            name: my-config
+          prefix: CM_
+`
+	configMapManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key1: value1
+`
+	secretManifest = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+data:
+  password: c2VjcmV0
+`
+	serviceManifest = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-service
+spec:
+  selector:
+    app: my-app
+  ports:
+  - name: http
+    port: 80
+    targetPort: http
+  - name: metrics
+    port: 9090
+`
+)
+
+func TestGetPodFromK8sResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		resource    string
+		resources   []string
+		want        *corev1.Pod
+		wantAliases []string
+		wantErr     bool
+	}{
+		{
+			name:     "not a workload",
+			resource: configMapManifest,
+			want:     nil,
+		},
+		{
+			name:      "deployment with references to configmap, secret and service",
+			resource:  deploymentManifest,
+			resources: []string{configMapManifest, secretManifest, serviceManifest},
+			want: func() *corev1.Pod {
+				pod := corev1.Pod{}
+				pod.APIVersion, pod.Kind = "v1", "Pod"
+				pod.SetName("my-app")
+				pod.SetLabels(map[string]string{"app": "my-app"})
+				pod.Spec.Containers = []corev1.Container{
+					{
+						Name:  "main",
+						Image: "my-image",
+						Ports: []corev1.ContainerPort{
+							{
+								Name:          "http",
+								ContainerPort: 8080,
+								HostPort:      80,
+								HostIP:        "127.0.0.1",
+							},
+							{
+								Name:          "metrics",
+								ContainerPort: 9090,
+								HostPort:      9090,
+								HostIP:        "127.0.0.1",
+							},
+						},
+						Env: []corev1.EnvVar{
+							{Name: "CM_key1", Value: "value1"},
+							{Name: "FROM_CONFIGMAP", Value: "value1"},
+							{Name: "FROM_SECRET", Value: "secret"},
+							{Name: "LITERAL", Value: "literal"},
+						},
+					},
+				}
+				return &pod
+			}(),
+			wantAliases: []string{"my-service"},
+		},
+		{
+			name: "statefulset with volume claim templates",
+			resource: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+spec:
+  selector:
+    matchLabels:
+      app: db
+  template:
+    metadata:
+      labels:
+        app: db
+    spec:
+      containers:
+      - name: postgres
+        image: postgres
+        volumeMounts:
+        - name: data
+          mountPath: /var/lib/postgresql/data
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      accessModes: ["ReadWriteOnce"]
+`,
+			resources: []string{serviceManifest},
+			want: func() *corev1.Pod {
+				pod := corev1.Pod{}
+				pod.APIVersion, pod.Kind = "v1", "Pod"
+				pod.SetName("db")
+				pod.SetLabels(map[string]string{"app": "db"})
+				pod.Spec.Containers = []corev1.Container{
+					{
+						Name:  "postgres",
+						Image: "postgres",
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "data", MountPath: "/var/lib/postgresql/data"},
+						},
+					},
+				}
+				pod.Spec.Volumes = []corev1.Volume{
+					{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-0"},
+						},
+					},
+				}
+				return &pod
+			}(),
+		},
+		{
+			name:      "missing configmap",
+			resource:  deploymentManifest,
+			resources: []string{secretManifest},
+			wantErr:   true,
+		},
+		{
+			name: "configmap volume",
+			resource: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  containers:
+  - name: main
+    image: my-image
+  volumes:
+  - name: config
+    configMap:
+      name: my-config
+`,
+			resources: []string{configMapManifest},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resources []unstructured.Unstructured
+			for _, r := range tt.resources {
+				resources = append(resources, toUnstructured(t, r))
+			}
+			got, gotAliases, err := GetPodFromK8sResource(toUnstructured(t, tt.resource), resources)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPodFromK8sResource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetPodFromK8sResource() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantAliases, gotAliases); diff != "" {
+				t.Errorf("GetPodFromK8sResource() aliases mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyKubernetesOnPodman(t *testing.T) {
+	const podManifest = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  containers:
+  - name: main
+    image: my-image
+`
+	deployedLabels := func(mode string) map[string]string {
+		labels := odolabels.GetLabels("my-component", "app", "", mode, false)
+		odolabels.SetSpecHash(labels, "previous-hash")
+		return labels
+	}
+
+	tests := []struct {
+		name         string
+		existingPods map[string]bool
+		deployedPods []unstructured.Unstructured
+		wantReplace  bool
+		wantPlay     bool
+		wantErr      bool
+	}{
+		{
+			name:     "pod not existing",
+			wantPlay: true,
+		},
+		{
+			name:         "pod deployed previously in the same mode",
+			existingPods: map[string]bool{"my-pod": true},
+			deployedPods: []unstructured.Unstructured{podWithLabels("my-pod", deployedLabels(odolabels.ComponentDevMode))},
+			wantReplace:  true,
+			wantPlay:     true,
+		},
+		{
+			name:         "pod deployed in the other mode",
+			existingPods: map[string]bool{"my-pod": true},
+			deployedPods: []unstructured.Unstructured{podWithLabels("my-pod", deployedLabels(odolabels.ComponentDeployMode))},
+			wantErr:      true,
+		},
+		{
+			name:         "pod not created by odo for the component",
+			existingPods: map[string]bool{"my-pod": true},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			podmanClient := podman.NewMockClient(ctrl)
+			podmanClient.EXPECT().GetCapabilities().Return(podman.Capabilities{Cgroupv2: true}, nil)
+			podmanClient.EXPECT().NetworkCreate("odo-my-component-app").Return(nil)
+			podmanClient.EXPECT().PodLs().Return(tt.existingPods, nil)
+			podmanClient.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "").Return(tt.deployedPods, nil)
+			if tt.wantReplace {
+				podmanClient.EXPECT().CleanupPodResources(gomock.Any(), false).Return(nil)
+			}
+			if tt.wantPlay {
+				podmanClient.EXPECT().PlayKubeOnNetwork(gomock.Any(), "odo-my-component-app", gomock.Any()).Return(nil)
+			}
+
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+			if err != nil {
+				t.Fatal(err)
+			}
+			kubernetes := v1alpha2.Component{
+				Name: "my-kubernetes",
+				ComponentUnion: v1alpha2.ComponentUnion{
+					Kubernetes: &v1alpha2.KubernetesComponent{
+						K8sLikeComponent: v1alpha2.K8sLikeComponent{
+							K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+								Inlined: podManifest,
+							},
+						},
+					},
+				},
+			}
+			if err = devfileData.AddComponents([]v1alpha2.Component{kubernetes}); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ApplyKubernetesOnPodman(odolabels.ComponentDevMode, "app", "my-component", parser.DevfileObj{Data: devfileData}, kubernetes, podmanClient, "", nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyKubernetesOnPodman() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if diff := cmp.Diff([]string{"my-pod"}, got); diff != "" {
+					t.Errorf("ApplyKubernetesOnPodman() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func podWithLabels(name string, labels map[string]string) unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetName(name)
+	u.SetLabels(labels)
+	return u
+}
@@ -2,6 +2,7 @@ package component
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -19,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/podman"
@@ -92,7 +94,7 @@ func GatherName(contextDir string, devfileObj *parser.DevfileObj) (string, error
 // Log returns log from component
 func Log(platformClient platform.Client, componentName string, appName string, follow bool, command v1alpha2.Command) (io.ReadCloser, error) {
 
-	pod, err := platformClient.GetRunningPodFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, false))
+	pod, err := platformClient.GetRunningPodFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true))
 	if err != nil {
 		return nil, fmt.Errorf("a running component %s doesn't exist on the cluster: %w", componentName, err)
 	}
@@ -204,7 +206,7 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 	return components, nil
 }
 
-func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
+func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, dockerClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
 	var (
 		allComponents []api.ComponentAbstract
 	)
@@ -226,6 +228,15 @@ func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Clien
 		allComponents = append(allComponents, podmanComponents...)
 	}
 
+	// dockerClient is nil if a platform is selected or if docker is not accessible
+	if dockerClient != nil {
+		dockerComponents, err := dockerClient.ListAllComponents()
+		if err != nil {
+			return nil, "", err
+		}
+		allComponents = append(allComponents, dockerComponents...)
+	}
+
 	localComponent := api.ComponentAbstract{
 		Name:      componentName,
 		ManagedBy: "",
@@ -458,7 +469,7 @@ func getDevfileInfoFromList(list []unstructured.Unstructured) (parser.DevfileObj
 }
 
 // ListRoutesAndIngresses lists routes and ingresses created by a component;
-// it only returns the resources created with Deploy mode;
+// it returns the resources created with Deploy mode, and the ones exposing the endpoints in Dev mode with `odo dev --expose`;
 // it fetches resources from the cluster that match label and return.
 func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appName string) (ings []api.ConnectionData, routes []api.ConnectionData, err error) {
 	if client == nil {
@@ -472,7 +483,7 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 		return nil, nil, err
 	}
 	for _, ing := range k8sIngresses.Items {
-		if ownerReferences := ing.GetOwnerReferences(); ownerReferences != nil {
+		if ownerReferences := ing.GetOwnerReferences(); ownerReferences != nil && odolabels.GetExposedEndpoint(ing.GetLabels()) == "" {
 			klog.V(4).Infof("Skipping Ingress %q created/owned by another resource: %v", ing.GetName(), ownerReferences)
 			continue
 		}
@@ -515,7 +526,7 @@ func ListRoutesAndIngresses(client kclient.ClientInterface, componentName, appNa
 		return nil, nil, err
 	}
 	for _, u := range ocRoutes.Items {
-		if ownerReferences := u.GetOwnerReferences(); ownerReferences != nil {
+		if ownerReferences := u.GetOwnerReferences(); ownerReferences != nil && odolabels.GetExposedEndpoint(u.GetLabels()) == "" {
 			klog.V(4).Infof("Skipping Route %q created/owned by another resource: %v", u.GetName(), ownerReferences)
 			continue
 		}
@@ -542,3 +553,57 @@ func GetContainersNames(pod *corev1.Pod) []string {
 	}
 	return result
 }
+
+// GetRunningDevPod returns the running pod of the component in the Dev mode.
+// Only the pod running the containers of the Devfile is selected, not the pods of the Kubernetes components deployed in Dev mode.
+func GetRunningDevPod(platformClient platform.Client, componentName, appName string) (*corev1.Pod, error) {
+	pod, err := platformClient.GetRunningPodFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true))
+	if err != nil {
+		var notFoundErr *platform.PodNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, fmt.Errorf("no running Dev session found for the component %q. Please check the command 'odo dev' is running", componentName)
+		}
+		return nil, fmt.Errorf("unable to get the pod of the component %q: %w", componentName, err)
+	}
+	return pod, nil
+}
+
+// helperContainerPrefix is the prefix of the names of the helper containers added by odo to the pod of the component
+const helperContainerPrefix = "odo-helper-"
+
+// GetDevContainerName returns the container targeted by a command, among the containers of the pod:
+// the container passed with the --container flag, or the only container of the pod,
+// or the container of the default run command of the Devfile.
+// The helper containers added by odo are only returned when passed with the --container flag.
+func GetDevContainerName(containerFlag string, podContainers []string, devfileObj parser.DevfileObj) (string, error) {
+	if containerFlag != "" {
+		for _, c := range podContainers {
+			if c == containerFlag {
+				return c, nil
+			}
+		}
+		return "", fmt.Errorf("container %q not found in the pod of the component, available containers are: %s", containerFlag, strings.Join(podContainers, ", "))
+	}
+
+	var componentContainers []string
+	for _, c := range podContainers {
+		if !strings.HasPrefix(c, helperContainerPrefix) {
+			componentContainers = append(componentContainers, c)
+		}
+	}
+	podContainers = componentContainers
+
+	if len(podContainers) == 1 {
+		return podContainers[0], nil
+	}
+
+	runCmd, ok, err := libdevfile.GetCommand(devfileObj, "", v1alpha2.RunCommandGroupKind)
+	if err == nil && ok && runCmd.Exec != nil {
+		for _, c := range podContainers {
+			if c == runCmd.Exec.Component {
+				return c, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("the pod of the component has several containers (%s), use --container to select one", strings.Join(podContainers, ", "))
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	devfileCtx "github.com/devfile/library/v2/pkg/devfile/parser/context"
@@ -17,9 +18,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	v12 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -752,6 +755,52 @@ func TestListRoutesAndIngresses(t *testing.T) {
 			wantRoutes: nil,
 			wantErr:    false,
 		},
+		{
+			name: "list ingress exposing an endpoint in Dev mode, even if it has an owner reference",
+			args: args{
+				client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					exposedIng := ing.DeepCopy()
+					exposedLabels := labels.GetLabels(componentName, "app", "", labels.ComponentDevMode, true)
+					labels.AddExposedEndpoint(exposedLabels, "http")
+					exposedIng.SetLabels(exposedLabels)
+					exposedIng.SetOwnerReferences([]metav1.OwnerReference{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       componentName + "-app",
+						},
+					})
+					return mockKubeClient(ctrl, false, []v1.Ingress{*exposedIng}, nil)
+				},
+				componentName: componentName,
+			},
+			wantIngs:   []api.ConnectionData{ingConnectionData},
+			wantRoutes: nil,
+			wantErr:    false,
+		},
+		{
+			name: "list route exposing an endpoint in Dev mode, even if it has an owner reference",
+			args: args{client: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				exposedRoute := route.DeepCopy()
+				exposedLabels := labels.GetLabels(componentName, "app", "", labels.ComponentDevMode, true)
+				labels.AddExposedEndpoint(exposedLabels, "http")
+				exposedRoute.SetLabels(exposedLabels)
+				exposedRoute.SetOwnerReferences([]metav1.OwnerReference{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       componentName + "-app",
+					},
+				})
+				exposedRouteUnstructured, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(exposedRoute)
+				return mockKubeClient(ctrl, true, nil, exposedRouteUnstructured)
+			},
+				componentName: componentName,
+			},
+			wantIngs:   nil,
+			wantRoutes: []api.ConnectionData{routeConnectionData},
+			wantErr:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1091,3 +1140,160 @@ func TestGetDevfileInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDevContainerName(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		testingutil.GetFakeContainerComponent("tools"),
+		testingutil.GetFakeContainerComponent("runtime"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddCommands([]v1alpha2.Command{
+		{
+			Id: "run",
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					Component:   "runtime",
+					CommandLine: "npm start",
+					LabeledCommand: v1alpha2.LabeledCommand{
+						BaseCommand: v1alpha2.BaseCommand{
+							Group: &v1alpha2.CommandGroup{Kind: v1alpha2.RunCommandGroupKind},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{
+		Ctx:  devfileCtx.FakeContext(filesystem.NewFakeFs(), parser.OutputDevfileYamlPath),
+		Data: devfileData,
+	}
+
+	tests := []struct {
+		name          string
+		containerFlag string
+		podContainers []string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:          "container passed as flag",
+			containerFlag: "tools",
+			podContainers: []string{"tools", "runtime"},
+			want:          "tools",
+		},
+		{
+			name:          "container passed as flag not in pod",
+			containerFlag: "db",
+			podContainers: []string{"tools", "runtime"},
+			wantErr:       true,
+		},
+		{
+			name:          "single container",
+			podContainers: []string{"tools"},
+			want:          "tools",
+		},
+		{
+			name:          "container of the run command",
+			podContainers: []string{"tools", "runtime"},
+			want:          "runtime",
+		},
+		{
+			name:          "single container with a helper container",
+			podContainers: []string{"tools", "odo-helper-reverse-port-forwarding"},
+			want:          "tools",
+		},
+		{
+			name:          "helper container passed as flag",
+			containerFlag: "odo-helper-reverse-port-forwarding",
+			podContainers: []string{"tools", "odo-helper-reverse-port-forwarding"},
+			want:          "odo-helper-reverse-port-forwarding",
+		},
+		{
+			name:          "container of the run command not in pod",
+			podContainers: []string{"tools", "db"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDevContainerName(tt.containerFlag, tt.podContainers, devfileObj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDevContainerName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetDevContainerName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRunningDevPod(t *testing.T) {
+	devPod := corev1.Pod{}
+	devPod.SetName("my-comp-app")
+	devPod.SetLabels(labels.GetLabels("my-comp", "app", "", labels.ComponentDevMode, true))
+	// A pod of a Kubernetes component of the Devfile, deployed in Dev mode on Podman
+	workloadPod := corev1.Pod{}
+	workloadPod.SetName("postgres")
+	workloadPod.SetLabels(labels.GetLabels("my-comp", "app", "", labels.ComponentDevMode, false))
+	labels.SetSpecHash(workloadPod.GetLabels(), "hash")
+
+	tests := []struct {
+		name     string
+		pods     []corev1.Pod
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "pod of the component and pod of a Kubernetes component",
+			pods:     []corev1.Pod{workloadPod, devPod},
+			wantName: "my-comp-app",
+		},
+		{
+			name:    "only the pod of a Kubernetes component",
+			pods:    []corev1.Pod{workloadPod},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := platform.NewMockClient(ctrl)
+			client.EXPECT().GetRunningPodFromSelector(gomock.Any()).DoAndReturn(func(selector string) (*corev1.Pod, error) {
+				sel, err := k8slabels.Parse(selector)
+				if err != nil {
+					return nil, err
+				}
+				var matching []corev1.Pod
+				for _, pod := range tt.pods {
+					if sel.Matches(k8slabels.Set(pod.GetLabels())) {
+						matching = append(matching, pod)
+					}
+				}
+				switch len(matching) {
+				case 0:
+					return nil, &platform.PodNotFoundError{Selector: selector}
+				case 1:
+					return &matching[0], nil
+				}
+				return nil, errors.New("multiple Pods exist for the selector")
+			})
+
+			got, err := GetRunningDevPod(client, "my-comp", "app")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRunningDevPod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.GetName() != tt.wantName {
+				t.Errorf("GetRunningDevPod() = %q, want %q", got.GetName(), tt.wantName)
+			}
+		})
+	}
+}
@@ -192,7 +192,7 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfi
 	klog.V(4).Infof("Gathering information for component: %q", componentName)
 
 	klog.V(3).Infof("Checking component status for %q", componentName)
-	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, false)
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true)
 	pod, err := do.kubeClient.GetRunningPodFromSelector(selector)
 	if err != nil {
 		klog.V(1).Info("Component not found on the cluster.")
@@ -237,29 +237,49 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfi
 }
 
 func (do *DeleteComponentClient) ListPodmanResourcesToDelete(appName string, componentName string, mode string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error) {
-	if mode == odolabels.ComponentDeployMode {
-		return false, nil, nil
-	}
-
-	// Inner Loop
 	var podName string
 	podName, err = util.NamespaceKubernetesObject(componentName, appName)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to get the resource %q name for component %q; cause: %w", kclient.DeploymentKind, componentName, err)
 	}
 
-	allPods, err := do.podmanClient.PodLs()
-	if err != nil {
-		err = clierrors.NewWarning("failed to get pods on podman", err)
-		return false, nil, err
+	modes := []string{odolabels.ComponentDevMode, odolabels.ComponentDeployMode}
+	if mode != odolabels.ComponentAnyMode {
+		modes = []string{mode}
 	}
 
-	if _, isInnerLoopDeployed = allPods[podName]; isInnerLoopDeployed {
-		podDef, err := do.podmanClient.KubeGenerate(podName)
+	if mode != odolabels.ComponentDeployMode {
+		// Inner Loop
+		allPods, err := do.podmanClient.PodLs()
 		if err != nil {
+			err = clierrors.NewWarning("failed to get pods on podman", err)
 			return false, nil, err
 		}
-		pods = append(pods, podDef)
+
+		if _, isInnerLoopDeployed = allPods[podName]; isInnerLoopDeployed {
+			podDef, err := do.podmanClient.KubeGenerate(podName)
+			if err != nil {
+				return false, nil, err
+			}
+			pods = append(pods, podDef)
+		}
+	}
+
+	// Pods running the Kubernetes components
+	for _, m := range modes {
+		selector := odolabels.GetSelector(componentName, appName, m, false)
+		modePods, err := do.podmanClient.GetPodsMatchingSelector(selector)
+		if err != nil {
+			err = clierrors.NewWarning("failed to get pods on podman", err)
+			return isInnerLoopDeployed, pods, err
+		}
+		for i := range modePods.Items {
+			if modePods.Items[i].GetName() == podName {
+				// Inner Loop pod, already listed
+				continue
+			}
+			pods = append(pods, &modePods.Items[i])
+		}
 	}
 	return isInnerLoopDeployed, pods, nil
 }
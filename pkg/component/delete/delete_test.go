@@ -651,7 +651,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
 
-					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, false)
+					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, true)
 					client.EXPECT().GetRunningPodFromSelector(selector).Return(&corev1.Pod{}, &platform.PodNotFoundError{Selector: selector})
 					return client
 				},
@@ -668,7 +668,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
 
-					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, false)
+					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, true)
 					client.EXPECT().GetRunningPodFromSelector(selector).Return(nil, errors.New("some un-ignorable error"))
 					return client
 				},
@@ -685,7 +685,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
 
-					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, false)
+					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, true)
 					client.EXPECT().GetRunningPodFromSelector(selector).Return(odoTestingUtil.CreateFakePod(componentName, "mypod", "runtime"), nil)
 
 					cmd := []string{"/bin/sh", "-c", "cd /projects/nodejs-starter && (echo \"Hello World!\") 1>>/proc/1/fd/1 2>>/proc/1/fd/2"}
@@ -706,7 +706,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 				kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
 
-					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, false)
+					selector := odolabels.GetSelector(componentName, "app", odolabels.ComponentDevMode, true)
 					fakePod := odoTestingUtil.CreateFakePod(componentName, "mypod", "runtime")
 					// Expecting this method to be called twice because if the command execution fails, we try to get the pod logs by calling GetOnePodFromSelector again.
 					client.EXPECT().GetRunningPodFromSelector(selector).Return(fakePod, nil).Times(2)
@@ -773,6 +773,13 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 	podDef := corev1.Pod{}
 	podDef.SetName(podName)
 
+	devSelector := odolabels.GetSelector("a-component", "an-app", odolabels.ComponentDevMode, false)
+	deploySelector := odolabels.GetSelector("a-component", "an-app", odolabels.ComponentDeployMode, false)
+	devPodDef := corev1.Pod{}
+	devPodDef.SetName("a-database")
+	deployPodDef := corev1.Pod{}
+	deployPodDef.SetName("a-deployment")
+
 	tests := []struct {
 		name                    string
 		fields                  fields
@@ -811,6 +818,9 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
 					podmanCli.EXPECT().PodLs().Return(map[string]bool{}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(devSelector).Return(&corev1.PodList{}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{}, nil)
+
 					return podmanCli
 				},
 			},
@@ -828,6 +838,9 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
 					podmanCli.EXPECT().PodLs().Return(map[string]bool{"another-pod": true}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(devSelector).Return(&corev1.PodList{}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{}, nil)
+
 					return podmanCli
 				},
 			},
@@ -847,6 +860,9 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(devSelector).Return(&corev1.PodList{}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{}, nil)
+
 					return podmanCli
 				},
 			},
@@ -866,6 +882,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(devSelector).Return(&corev1.PodList{Items: []corev1.Pod{podDef, devPodDef}}, nil)
 					return podmanCli
 				},
 			},
@@ -876,7 +893,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			},
 			wantErr:                 false,
 			wantIsInnerLoopDeployed: true,
-			wantPods:                []*corev1.Pod{&podDef},
+			wantPods:                []*corev1.Pod{&podDef, &devPodDef},
 		},
 		{
 			name: "component's pod running on podman - deploy mode requested",
@@ -886,6 +903,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil).Times(0)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil).Times(0)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{}, nil)
 					return podmanCli
 				},
 			},
@@ -898,6 +916,45 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			wantIsInnerLoopDeployed: false,
 			wantPods:                nil,
 		},
+		{
+			name: "component's pods running on podman in dev and deploy modes",
+			fields: fields{
+				podmanClient: func(ctrl *gomock.Controller) podman.Client {
+					podmanCli := podman.NewMockClient(ctrl)
+					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
+
+					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(devSelector).Return(&corev1.PodList{Items: []corev1.Pod{podDef}}, nil)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{Items: []corev1.Pod{deployPodDef}}, nil)
+					return podmanCli
+				},
+			},
+			args: args{
+				appName:       "an-app",
+				componentName: "a-component",
+			},
+			wantErr:                 false,
+			wantIsInnerLoopDeployed: true,
+			wantPods:                []*corev1.Pod{&podDef, &deployPodDef},
+		},
+		{
+			name: "component's pod running on podman in deploy mode - deploy mode requested",
+			fields: fields{
+				podmanClient: func(ctrl *gomock.Controller) podman.Client {
+					podmanCli := podman.NewMockClient(ctrl)
+					podmanCli.EXPECT().GetPodsMatchingSelector(deploySelector).Return(&corev1.PodList{Items: []corev1.Pod{deployPodDef}}, nil)
+					return podmanCli
+				},
+			},
+			args: args{
+				appName:       "an-app",
+				componentName: "a-component",
+				mode:          odolabels.ComponentDeployMode,
+			},
+			wantErr:                 false,
+			wantIsInnerLoopDeployed: false,
+			wantPods:                []*corev1.Pod{&deployPodDef},
+		},
 		{
 			name: "kube generate fails",
 			fields: fields{
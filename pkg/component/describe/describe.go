@@ -13,6 +13,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
@@ -66,6 +67,11 @@ func DescribeDevfileComponent(
 			return api.Component{}, nil, podman.NewPodmanNotFoundError(nil)
 		}
 		kubeClient = nil
+	case commonflags.PlatformDocker:
+		if podmanClient == nil {
+			return api.Component{}, nil, docker.NewDockerNotFoundError(nil)
+		}
+		kubeClient = nil
 	}
 
 	// TODO(feloy) Pass PID with `--pid` flag
@@ -95,6 +101,12 @@ func DescribeDevfileComponent(
 	}
 	forwardedPorts := filterByPlatform(ctx, isPlatformFeatureEnabled, allFwdPorts)
 
+	allCommands, err := stateClient.GetCommandStatuses(ctx)
+	if err != nil {
+		return api.Component{}, nil, err
+	}
+	devCommands := filterByPlatform(ctx, true, allCommands)
+
 	runningOn, err := GetRunningOn(ctx, componentName, kubeClient, podmanClient)
 	if err != nil {
 		return api.Component{}, nil, err
@@ -115,6 +127,7 @@ func DescribeDevfileComponent(
 		DevfileData:       devfileData,
 		DevControlPlane:   devControlPlaneData,
 		DevForwardedPorts: forwardedPorts,
+		DevCommands:       devCommands,
 		RunningIn:         api.MergeRunningModes(runningOn),
 		RunningOn:         runningOn,
 		ManagedBy:         "odo",
@@ -165,6 +178,11 @@ func DescribeNamedComponent(
 			return api.Component{}, nil, podman.NewPodmanNotFoundError(nil)
 		}
 		kubeClient = nil
+	case commonflags.PlatformDocker:
+		if podmanClient == nil {
+			return api.Component{}, nil, docker.NewDockerNotFoundError(nil)
+		}
+		kubeClient = nil
 	}
 
 	runningOn, err := GetRunningOn(ctx, name, kubeClient, podmanClient)
@@ -220,7 +238,12 @@ func GetRunningOn(ctx context.Context, n string, kubeClient kclient.ClientInterf
 			runningOn[commonflags.PlatformCluster] = runningModesMap[kubeClient]
 		}
 		if podmanClient != nil && runningModesMap[podmanClient] != nil {
-			runningOn[commonflags.PlatformPodman] = runningModesMap[podmanClient]
+			// The Podman client accesses Docker when the platform is docker
+			podmanPlatform := commonflags.PlatformPodman
+			if fcontext.GetPlatform(ctx, "") == commonflags.PlatformDocker {
+				podmanPlatform = commonflags.PlatformDocker
+			}
+			runningOn[podmanPlatform] = runningModesMap[podmanClient]
 		}
 	}
 	return runningOn, nil
@@ -242,9 +265,9 @@ func filterByPlatform[T platformDependent](ctx context.Context, isFeatEnabled bo
 				result = append(result, p)
 			}
 		}
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		for _, p := range all {
-			if p.GetPlatform() == commonflags.PlatformPodman {
+			if p.GetPlatform() == plt {
 				result = append(result, p)
 			}
 		}
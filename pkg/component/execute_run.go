@@ -12,6 +12,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/port"
 	"github.com/redhat-developer/odo/pkg/remotecmd"
 	"github.com/redhat-developer/odo/pkg/task"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -19,11 +20,23 @@ import (
 
 const numberOfLinesToOutputLog = 100
 
-// ExecuteRunCommand executes a Devfile command in the specified pod
-// If componentExists, the previous instance of the command will be stopped before (if hotReloadCapable is not set)
-func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClient platform.Client, devfileCmd devfilev1.Command, componentExists bool, podName string, appName string, componentName string) error {
-	remoteProcessHandler := remotecmd.NewKubeExecProcessHandler(execClient)
+const (
+	// stopSignalAttribute is the attribute of a command defining the signal sent to its processes to stop them gracefully (TERM by default)
+	stopSignalAttribute = "dev.odo.stop.signal"
+	// stopGracePeriodAttribute is the attribute of a command defining the duration given to its processes to exit after receiving the stop signal,
+	// before they are killed (10s by default)
+	stopGracePeriodAttribute = "dev.odo.stop.gracePeriod"
+
+	// portsReleaseTimeout is the maximum duration to wait for the ports of the container to be released, after the command is stopped
+	portsReleaseTimeout = 10 * time.Second
+	// portsReleaseInterval is the interval at which the ports of the container are checked, after the command is stopped
+	portsReleaseInterval = 500 * time.Millisecond
+)
 
+// ExecuteRunCommand executes a Devfile command in the specified pod, using remoteProcessHandler to start and stop its process
+// If componentExists, the previous instance of the command will be stopped before (if hotReloadCapable is not set),
+// and the command is started again once the given ports of the container are released.
+func ExecuteRunCommand(ctx context.Context, execClient exec.Client, remoteProcessHandler remotecmd.RemoteProcessHandler, platformClient platform.Client, devfileCmd devfilev1.Command, ports []int, componentExists bool, podName string, appName string, componentName string) error {
 	statusHandlerFunc := func(s *log.Status) remotecmd.CommandOutputHandler {
 		return func(status remotecmd.RemoteProcessStatus, stdout []string, stderr []string, err error) {
 			switch status {
@@ -44,9 +57,13 @@ func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClie
 	spinner := log.NewStatus(log.GetStdout())
 
 	// if we need to restart, issue the remote process handler command to stop all running commands first.
-	// We do not need to restart Hot reload capable commands.
+	// We do not need to restart Hot reload capable commands, as long as their process is still running.
 	if componentExists {
-		if devfileCmd.Exec == nil || !util.SafeGetBool(devfileCmd.Exec.HotReloadCapable) {
+		hotReloading, err := isHotReloadCapableAndRunning(ctx, remoteProcessHandler, devfileCmd, podName)
+		if err != nil {
+			return err
+		}
+		if !hotReloading {
 			klog.V(2).Infof("restart required for command %s", devfileCmd.Id)
 
 			cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
@@ -59,11 +76,13 @@ func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClie
 				return err
 			}
 
+			waitForPortsRelease(ctx, execClient, devfileCmd, ports, podName)
+
 			if err = remoteProcessHandler.StartProcessForCommand(ctx, cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner)); err != nil {
 				return err
 			}
 		} else {
-			klog.V(2).Infof("command is hot-reload capable, not restarting %s", devfileCmd.Id)
+			klog.V(2).Infof("command is hot-reload capable and still running, not restarting %s", devfileCmd.Id)
 		}
 	} else {
 		cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
@@ -104,6 +123,23 @@ func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClie
 	return checkRemoteCommandStatus(ctx, execClient, platformClient, devfileCmd, podName, appName, componentName, fmt.Sprintf("Devfile command %q exited with an error status in %.0f second(s)", devfileCmd.Id, totalWaitTime))
 }
 
+// isHotReloadCapableAndRunning returns true if the command is hot-reload capable and its process is still running in the container.
+// Such a command is expected to handle the file changes on its own, and does not need to be restarted.
+func isHotReloadCapableAndRunning(ctx context.Context, remoteProcessHandler remotecmd.RemoteProcessHandler, devfileCmd devfilev1.Command, podName string) (bool, error) {
+	if devfileCmd.Exec == nil || !util.SafeGetBool(devfileCmd.Exec.HotReloadCapable) {
+		return false, nil
+	}
+	remoteProcess, err := remoteProcessHandler.GetProcessInfoForCommand(ctx, remotecmd.CommandDefinition{Id: devfileCmd.Id}, podName, devfileCmd.Exec.Component)
+	if err != nil {
+		return false, err
+	}
+	if remoteProcess.Status != remotecmd.Running {
+		klog.V(2).Infof("command %s is hot-reload capable but its process is not running (status: %s)", devfileCmd.Id, remoteProcess.Status)
+		return false, nil
+	}
+	return true, nil
+}
+
 // devfileCommandToRemoteCmdDefinition builds and returns a new remotecmd.CommandDefinition object from the specified devfileCmd.
 // An error is returned for non-exec Devfile commands.
 func devfileCommandToRemoteCmdDefinition(devfileCmd devfilev1.Command) (remotecmd.CommandDefinition, error) {
@@ -116,12 +152,79 @@ func devfileCommandToRemoteCmdDefinition(devfileCmd devfilev1.Command) (remotecm
 		envVars = append(envVars, remotecmd.CommandEnvVar{Key: e.Name, Value: e.Value})
 	}
 
-	return remotecmd.CommandDefinition{
+	cmdDef := remotecmd.CommandDefinition{
 		Id:         devfileCmd.Id,
 		WorkingDir: devfileCmd.Exec.WorkingDir,
 		EnvVars:    envVars,
 		CmdLine:    devfileCmd.Exec.CommandLine,
-	}, nil
+	}
+
+	var err error
+	if devfileCmd.Attributes.Exists(stopSignalAttribute) {
+		signal := devfileCmd.Attributes.GetString(stopSignalAttribute, &err)
+		if err != nil {
+			return remotecmd.CommandDefinition{}, fmt.Errorf("attribute %q of command %q must be a string: %w", stopSignalAttribute, devfileCmd.Id, err)
+		}
+		cmdDef.StopSignal, err = remotecmd.ParseSignal(signal)
+		if err != nil {
+			return remotecmd.CommandDefinition{}, fmt.Errorf("attribute %q of command %q: %w", stopSignalAttribute, devfileCmd.Id, err)
+		}
+	}
+	if devfileCmd.Attributes.Exists(stopGracePeriodAttribute) {
+		gracePeriod := devfileCmd.Attributes.GetString(stopGracePeriodAttribute, &err)
+		if err == nil {
+			cmdDef.StopGracePeriod, err = time.ParseDuration(gracePeriod)
+		}
+		if err != nil || cmdDef.StopGracePeriod <= 0 {
+			return remotecmd.CommandDefinition{}, fmt.Errorf("attribute %q of command %q must be a positive duration, such as \"30s\"", stopGracePeriodAttribute, devfileCmd.Id)
+		}
+	}
+	return cmdDef, nil
+}
+
+// waitForPortsRelease waits for the ports of the container of the command to be released, after its process has been stopped,
+// so that the command does not fail to listen on them when it is started again.
+// A warning is displayed if some ports are still in use after portsReleaseTimeout.
+func waitForPortsRelease(ctx context.Context, execClient exec.Client, devfileCmd devfilev1.Command, ports []int, podName string) {
+	if len(ports) == 0 {
+		return
+	}
+	deadline := time.Now().Add(portsReleaseTimeout)
+	for {
+		connections, err := port.GetListeningConnections(ctx, execClient, podName, devfileCmd.Exec.Component)
+		if err != nil {
+			klog.V(2).Infof("unable to check the ports in use in container %q: %v", devfileCmd.Exec.Component, err)
+			return
+		}
+		inUse := portsInUse(connections, ports)
+		if len(inUse) == 0 {
+			return
+		}
+		if !time.Now().Before(deadline) {
+			log.Warningf("Port(s) %v still in use after stopping the command %q, the application may fail to start", inUse, devfileCmd.Id)
+			return
+		}
+		klog.V(4).Infof("waiting for ports %v to be released", inUse)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(portsReleaseInterval):
+		}
+	}
+}
+
+// portsInUse returns the ports listened on by any of the connections
+func portsInUse(connections []port.Connection, ports []int) []int {
+	var result []int
+	for _, p := range ports {
+		for _, conn := range connections {
+			if conn.LocalPort == p {
+				result = append(result, p)
+				break
+			}
+		}
+	}
+	return result
 }
 
 // checkRemoteCommandStatus checks if the command is running .
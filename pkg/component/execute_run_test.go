@@ -0,0 +1,202 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
+)
+
+func Test_isHotReloadCapableAndRunning(t *testing.T) {
+	newCommand := func(hotReloadCapable *bool) v1alpha2.Command {
+		return v1alpha2.Command{
+			Id: "run",
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					CommandLine:      "npm run dev",
+					Component:        "runtime",
+					HotReloadCapable: hotReloadCapable,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		command    v1alpha2.Command
+		execClient func(ctrl *gomock.Controller) exec.Client
+		want       bool
+		wantErr    bool
+	}{
+		{
+			name:    "not hot-reload capable",
+			command: newCommand(nil),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				return exec.NewMockClient(ctrl)
+			},
+			want: false,
+		},
+		{
+			name:    "hot-reload capable explicitly disabled",
+			command: newCommand(pointer.Bool(false)),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				return exec.NewMockClient(ctrl)
+			},
+			want: false,
+		},
+		{
+			name:    "hot-reload capable and running",
+			command: newCommand(pointer.Bool(true)),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				return newProcessExecClient(ctrl, []string{"123"}, "0")
+			},
+			want: true,
+		},
+		{
+			name:    "hot-reload capable and exited",
+			command: newCommand(pointer.Bool(true)),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				return newProcessExecClient(ctrl, []string{"123", "1"}, "1")
+			},
+			want: false,
+		},
+		{
+			name:    "hot-reload capable and never started",
+			command: newCommand(pointer.Bool(true)),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				return newProcessExecClient(ctrl, nil, "")
+			},
+			want: false,
+		},
+		{
+			name:    "error getting the process status",
+			command: newCommand(pointer.Bool(true)),
+			execClient: func(ctrl *gomock.Controller) exec.Client {
+				execClient := exec.NewMockClient(ctrl)
+				execClient.EXPECT().ExecuteCommand(gomock.Any(), gomock.Any(), "a-pod", "runtime", false, nil, nil).
+					Return(nil, nil, errors.New("an error"))
+				return execClient
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			remoteProcessHandler := remotecmd.NewKubeExecProcessHandler(tt.execClient(ctrl))
+			got, err := isHotReloadCapableAndRunning(context.Background(), remoteProcessHandler, tt.command, "a-pod")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("isHotReloadCapableAndRunning() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("isHotReloadCapableAndRunning() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newProcessExecClient returns an exec client returning pidFileContent as content of the PID file of the command,
+// and killStatus as result of checking if the process is running
+func newProcessExecClient(ctrl *gomock.Controller, pidFileContent []string, killStatus string) exec.Client {
+	execClient := exec.NewMockClient(ctrl)
+	execClient.EXPECT().ExecuteCommand(gomock.Any(), gomock.Any(), "a-pod", "runtime", false, nil, nil).
+		DoAndReturn(func(_ context.Context, command []string, _, _ string, _ bool, _, _ interface{}) ([]string, []string, error) {
+			cmdLine := strings.Join(command, " ")
+			switch {
+			case strings.Contains(cmdLine, "cat "):
+				return pidFileContent, nil, nil
+			case strings.Contains(cmdLine, "kill -0"):
+				return []string{killStatus}, nil, nil
+			}
+			return nil, nil, errors.New("unexpected command: " + cmdLine)
+		}).AnyTimes()
+	return execClient
+}
+
+func Test_devfileCommandToRemoteCmdDefinition(t *testing.T) {
+	newCommand := func(attrs attributes.Attributes) v1alpha2.Command {
+		return v1alpha2.Command{
+			Id:         "run",
+			Attributes: attrs,
+			CommandUnion: v1alpha2.CommandUnion{
+				Exec: &v1alpha2.ExecCommand{
+					CommandLine: "npm start",
+					Component:   "runtime",
+					WorkingDir:  "/projects",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		command v1alpha2.Command
+		want    remotecmd.CommandDefinition
+		wantErr bool
+	}{
+		{
+			name:    "no stop attributes",
+			command: newCommand(nil),
+			want:    remotecmd.CommandDefinition{Id: "run", WorkingDir: "/projects", EnvVars: []remotecmd.CommandEnvVar{}, CmdLine: "npm start"},
+		},
+		{
+			name: "stop signal and grace period",
+			command: newCommand(attributes.Attributes{}.
+				PutString(stopSignalAttribute, "SIGINT").
+				PutString(stopGracePeriodAttribute, "30s")),
+			want: remotecmd.CommandDefinition{
+				Id:              "run",
+				WorkingDir:      "/projects",
+				EnvVars:         []remotecmd.CommandEnvVar{},
+				CmdLine:         "npm start",
+				StopSignal:      "INT",
+				StopGracePeriod: 30 * time.Second,
+			},
+		},
+		{
+			name:    "unsupported stop signal",
+			command: newCommand(attributes.Attributes{}.PutString(stopSignalAttribute, "STOP")),
+			wantErr: true,
+		},
+		{
+			name:    "invalid grace period",
+			command: newCommand(attributes.Attributes{}.PutString(stopGracePeriodAttribute, "30")),
+			wantErr: true,
+		},
+		{
+			name:    "negative grace period",
+			command: newCommand(attributes.Attributes{}.PutString(stopGracePeriodAttribute, "-1s")),
+			wantErr: true,
+		},
+		{
+			name: "not an exec command",
+			command: v1alpha2.Command{
+				Id:           "deploy",
+				CommandUnion: v1alpha2.CommandUnion{Apply: &v1alpha2.ApplyCommand{Component: "k8s"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := devfileCommandToRemoteCmdDefinition(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("devfileCommandToRemoteCmdDefinition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("devfileCommandToRemoteCmdDefinition() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/remotecmd"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
@@ -32,9 +33,11 @@ type runHandler struct {
 	containersRunning     []string
 	msg                   string
 	directRun             bool
+	remoteProcessHandler  remotecmd.RemoteProcessHandler
 
 	fs           filesystem.Filesystem
 	imageBackend image.Backend
+	localCluster *image.LocalCluster
 
 	devfile parser.DevfileObj
 	path    string
@@ -48,6 +51,12 @@ type HandlerOptions struct {
 	ContainersRunning []string
 	Msg               string
 	DirectRun         bool
+	// RemoteProcessHandler, if set, is used to start and stop the processes of the non-terminating commands.
+	// It allows to supervise these processes during the whole Dev session.
+	RemoteProcessHandler remotecmd.RemoteProcessHandler
+
+	// LocalCluster, if set, is the cluster running on the local machine into which the images are loaded, instead of being pushed
+	LocalCluster *image.LocalCluster
 
 	// For apply Kubernetes / Openshift
 	Devfile parser.DevfileObj
@@ -77,17 +86,29 @@ func NewRunHandler(
 		containersRunning:     options.ContainersRunning,
 		msg:                   options.Msg,
 		directRun:             options.DirectRun,
+		remoteProcessHandler:  options.RemoteProcessHandler,
 
 		fs:           fs,
 		imageBackend: imageBackend,
+		localCluster: options.LocalCluster,
 
 		devfile: options.Devfile,
 		path:    options.Path,
 	}
 }
 
+// GetLocalCluster returns the cluster running on the local machine targeted by the platform client, into which the images are loaded
+// instead of being pushed, or nil if the platform is not such a cluster
+func GetLocalCluster(ctx context.Context, platformClient platform.Client) *image.LocalCluster {
+	kubeClient, ok := platformClient.(kclient.ClientInterface)
+	if !ok || kubeClient == nil {
+		return nil
+	}
+	return image.SelectLocalCluster(ctx, kubeClient.GetConfig())
+}
+
 func (a *runHandler) ApplyImage(img devfilev1.Component) error {
-	return image.BuildPushSpecificImage(a.ctx, a.imageBackend, a.fs, img, envcontext.GetEnvConfig(a.ctx).PushImages)
+	return image.BuildPushSpecificImage(a.ctx, a.imageBackend, a.fs, img, envcontext.GetEnvConfig(a.ctx).PushImages, a.localCluster)
 }
 
 func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind v1alpha2.CommandGroupKind) error {
@@ -101,7 +122,19 @@ func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind v1alph
 	}
 	switch platform := a.platformClient.(type) {
 	case kclient.ClientInterface:
-		return ApplyKubernetes(mode, appName, componentName, a.devfile, kubernetes, platform, a.path)
+		loadedImages, err := image.GetLoadedImages(a.devfile, a.localCluster)
+		if err != nil {
+			return err
+		}
+		return ApplyKubernetes(mode, appName, componentName, a.devfile, kubernetes, platform, a.path, loadedImages)
+	case podman.Client:
+		// The images built locally are available to Podman
+		localImages, err := image.GetImageNames(a.devfile)
+		if err != nil {
+			return err
+		}
+		_, err = ApplyKubernetesOnPodman(mode, appName, componentName, a.devfile, kubernetes, platform, a.path, localImages)
+		return err
 	default:
 		klog.V(4).Info("apply kubernetes/Openshift commands are not implemented on podman")
 		log.Warningf("Apply Kubernetes/Openshift components are not supported on Podman. Skipping: %v.", kubernetes.Name)
@@ -119,7 +152,11 @@ func (a *runHandler) ExecuteNonTerminatingCommand(ctx context.Context, command d
 		appName       = odocontext.GetApplication(a.ctx)
 	)
 	if isContainerRunning(command.Exec.Component, a.containersRunning) {
-		return ExecuteRunCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName)
+		remoteProcessHandler := a.remoteProcessHandler
+		if remoteProcessHandler == nil {
+			remoteProcessHandler = remotecmd.NewKubeExecProcessHandler(a.execClient)
+		}
+		return ExecuteRunCommand(ctx, a.execClient, remoteProcessHandler, a.platformClient, command, a.getContainerPorts(command.Exec.Component), a.ComponentExists, a.podName, appName, componentName)
 	}
 	switch platform := a.platformClient.(type) {
 	case kclient.ClientInterface:
@@ -167,3 +204,21 @@ func isContainerRunning(container string, containers []string) bool {
 	}
 	return false
 }
+
+// getContainerPorts returns the target ports of the endpoints of the container component, including the debug ones.
+// The ports cannot be determined if the handler has been created without a Devfile.
+func (a *runHandler) getContainerPorts(container string) []int {
+	if a.devfile.Data == nil {
+		return nil
+	}
+	ceMapping, err := libdevfile.GetDevfileContainerEndpointMapping(a.devfile, true)
+	if err != nil {
+		klog.V(2).Infof("unable to get the endpoints of container %q: %v", container, err)
+		return nil
+	}
+	ports := make([]int, 0, len(ceMapping[container]))
+	for _, ep := range ceMapping[container] {
+		ports = append(ports, ep.TargetPort)
+	}
+	return ports
+}
@@ -395,7 +395,11 @@ func TestHandler(t *testing.T) {
 			},
 			platformClient: func(ctrl *gomock.Controller) platform.Client {
 				client := podman.NewMockClient(ctrl)
-				// Nothing, as this is not implemented on podman
+				// No workload is defined by the component, no pod is played
+				client.EXPECT().GetCapabilities().Return(podman.Capabilities{Cgroupv2: true}, nil).AnyTimes()
+				client.EXPECT().NetworkCreate("odo-componentName-app").Return(nil).AnyTimes()
+				client.EXPECT().PodLs().Return(map[string]bool{}, nil).AnyTimes()
+				client.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "").Return(nil, nil).AnyTimes()
 				return client
 			},
 			execClient: func(ctrl *gomock.Controller) exec.Client {
@@ -640,7 +644,11 @@ func TestHandler(t *testing.T) {
 			},
 			platformClient: func(ctrl *gomock.Controller) platform.Client {
 				client := podman.NewMockClient(ctrl)
-				// Nothing, as this is not implemented on podman
+				// No workload is defined by the component, no pod is played
+				client.EXPECT().GetCapabilities().Return(podman.Capabilities{Cgroupv2: true}, nil).AnyTimes()
+				client.EXPECT().NetworkCreate("odo-componentName-app").Return(nil).AnyTimes()
+				client.EXPECT().PodLs().Return(map[string]bool{}, nil).AnyTimes()
+				client.EXPECT().GetAllResourcesFromSelector(gomock.Any(), "").Return(nil, nil).AnyTimes()
 				return client
 			},
 			execClient: func(ctrl *gomock.Controller) exec.Client {
@@ -8,7 +8,9 @@ import (
 )
 
 type Configuration struct {
+	ContainerHost                 *string       `env:"CONTAINER_HOST,noinit"`
 	DockerCmd                     string        `env:"DOCKER_CMD,default=docker"`
+	DockerCmdInitTimeout          time.Duration `env:"DOCKER_CMD_INIT_TIMEOUT,default=1s"`
 	Globalodoconfig               *string       `env:"GLOBALODOCONFIG,noinit"`
 	OdoDebugTelemetryFile         *string       `env:"ODO_DEBUG_TELEMETRY_FILE,noinit"`
 	OdoDisableTelemetry           *bool         `env:"ODO_DISABLE_TELEMETRY,noinit"`
@@ -19,9 +21,11 @@ type Configuration struct {
 	TelemetryCaller               string        `env:"TELEMETRY_CALLER,default="`
 	OdoExperimentalMode           bool          `env:"ODO_EXPERIMENTAL_MODE,default=false"`
 	PushImages                    bool          `env:"ODO_PUSH_IMAGES,default=true"`
+	LoadImages                    bool          `env:"ODO_LOAD_IMAGES,default=true"`
 	OdoContainerBackendGlobalArgs []string      `env:"ODO_CONTAINER_BACKEND_GLOBAL_ARGS,noinit,delimiter=;"`
 	OdoImageBuildArgs             []string      `env:"ODO_IMAGE_BUILD_ARGS,noinit,delimiter=;"`
 	OdoContainerRunArgs           []string      `env:"ODO_CONTAINER_RUN_ARGS,noinit,delimiter=;"`
+	OdoSyncContentHash            bool          `env:"ODO_SYNC_CONTENT_HASH,default=false"`
 }
 
 // GetConfiguration initializes a Configuration for odo by using the system environment.
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
 )
@@ -14,8 +15,12 @@ func TestDefaultValues(t *testing.T) {
 
 	checkDefaultStringValue(t, "DockerCmd", cfg.DockerCmd, "docker")
 	checkDefaultStringValue(t, "PodmanCmd", cfg.PodmanCmd, "podman")
+	checkDefaultDurationValue(t, "DockerCmdInitTimeout", cfg.DockerCmdInitTimeout, time.Second)
+	checkDefaultDurationValue(t, "PodmanCmdInitTimeout", cfg.PodmanCmdInitTimeout, time.Second)
 	checkDefaultStringValue(t, "TelemetryCaller", cfg.TelemetryCaller, "")
 	checkDefaultBoolValue(t, "OdoExperimentalMode", cfg.OdoExperimentalMode, false)
+	checkDefaultBoolValue(t, "OdoSyncContentHash", cfg.OdoSyncContentHash, false)
+	checkDefaultBoolValue(t, "LoadImages", cfg.LoadImages, true)
 
 	// Use noinit to set non initialized value as nil instead of zero-value
 	checkNilString(t, "Globalodoconfig", cfg.Globalodoconfig)
@@ -23,6 +28,7 @@ func TestDefaultValues(t *testing.T) {
 	checkNilString(t, "OdoDebugTelemetryFile", cfg.OdoDebugTelemetryFile)
 	checkNilBool(t, "OdoDisableTelemetry", cfg.OdoDisableTelemetry)
 	checkNilString(t, "OdoTrackingConsent", cfg.OdoTrackingConsent)
+	checkNilString(t, "ContainerHost", cfg.ContainerHost)
 
 }
 
@@ -33,6 +39,12 @@ func checkDefaultStringValue(t *testing.T, fieldName string, field string, def s
 
 }
 
+func checkDefaultDurationValue(t *testing.T, fieldName string, field time.Duration, def time.Duration) {
+	if field != def {
+		t.Errorf("default value for %q should be %v but is %v", fieldName, def, field)
+	}
+}
+
 func checkDefaultBoolValue(t *testing.T, fieldName string, field bool, def bool) {
 	if field != def {
 		t.Errorf("default value for %q should be %v but is %v", fieldName, def, field)
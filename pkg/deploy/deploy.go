@@ -10,23 +10,24 @@ import (
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
-	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
 type DeployClient struct {
-	kubeClient            kclient.ClientInterface
+	// platformClient is the client of the platform on which the resources are deployed: a cluster, or Podman
+	platformClient        platform.Client
 	configAutomountClient configAutomount.Client
 	fs                    filesystem.Filesystem
 }
 
 var _ Client = (*DeployClient)(nil)
 
-func NewDeployClient(kubeClient kclient.ClientInterface, configAutomountClient configAutomount.Client, fs filesystem.Filesystem) *DeployClient {
+func NewDeployClient(platformClient platform.Client, configAutomountClient configAutomount.Client, fs filesystem.Filesystem) *DeployClient {
 	return &DeployClient{
-		kubeClient:            kubeClient,
+		platformClient:        platformClient,
 		configAutomountClient: configAutomountClient,
 		fs:                    fs,
 	}
@@ -39,38 +40,45 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		path        = filepath.Dir(devfilePath)
 	)
 
-	_, err := libdevfile.ValidateAndGetCommand(*devfileObj, "", v1alpha2.DeployCommandGroupKind)
-	if err != nil {
-		return err
-	}
-
 	handler := component.NewRunHandler(
 		ctx,
-		o.kubeClient,
+		o.platformClient,
 		nil,
 		o.configAutomountClient,
 		o.fs,
 		image.SelectBackend(ctx),
 		component.HandlerOptions{
-			Devfile: *devfileObj,
-			Path:    path,
+			Devfile:      *devfileObj,
+			Path:         path,
+			LocalCluster: component.GetLocalCluster(ctx, o.platformClient),
 		},
 	)
 
-	err = o.buildPushAutoImageComponents(handler, *devfileObj)
+	return RunDeployCommand(ctx, *devfileObj, handler)
+}
+
+// RunDeployCommand runs the deploy flow of the Devfile with the handler: the Image components and the Kubernetes and OpenShift components
+// not referenced by any command are applied first, then the default deploy command is executed.
+func RunDeployCommand(ctx context.Context, devfileObj parser.DevfileObj, handler libdevfile.Handler) error {
+	_, err := libdevfile.ValidateAndGetCommand(devfileObj, "", v1alpha2.DeployCommandGroupKind)
+	if err != nil {
+		return err
+	}
+
+	err = buildPushAutoImageComponents(handler, devfileObj)
 	if err != nil {
 		return err
 	}
 
-	err = o.applyAutoK8sOrOcComponents(handler, *devfileObj)
+	err = applyAutoK8sOrOcComponents(handler, devfileObj)
 	if err != nil {
 		return err
 	}
 
-	return libdevfile.Deploy(ctx, *devfileObj, handler)
+	return libdevfile.Deploy(ctx, devfileObj, handler)
 }
 
-func (o *DeployClient) buildPushAutoImageComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
+func buildPushAutoImageComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
 	components, err := libdevfile.GetImageComponentsToPushAutomatically(devfileObj)
 	if err != nil {
 		return err
@@ -85,7 +93,7 @@ func (o *DeployClient) buildPushAutoImageComponents(handler libdevfile.Handler,
 	return nil
 }
 
-func (o *DeployClient) applyAutoK8sOrOcComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
+func applyAutoK8sOrOcComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
 	components, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
 	if err != nil {
 		return err
@@ -1,13 +1,31 @@
 package common
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	gitignore "github.com/sabhiram/go-gitignore"
 )
 
-const _devPushPathAttributePrefix = "dev.odo.push.path:"
+const (
+	_devPushPathAttributePrefix = "dev.odo.push.path:"
+	_devPullPathsAttribute      = "dev.odo.pull.paths"
+	_devWatchTriggersAttribute  = "dev.odo.watch.triggers"
+)
+
+// WatchTrigger defines the action to take when some files change during odo dev
+type WatchTrigger struct {
+	// Paths are the patterns, using the .gitignore syntax, of the files relative to the project directory triggering the action
+	Paths []string `json:"paths"`
+	// Command is the name of the Devfile command to execute when a matching file changes, before restarting the run command
+	Command string `json:"command,omitempty"`
+	// SyncOnly indicates that the run command must not be restarted when only matching files change
+	SyncOnly bool `json:"syncOnly,omitempty"`
+}
 
 // GetSyncFilesFromAttributes gets the target files and folders along with their respective remote destination from the devfile.
 // It uses the "dev.odo.push.path:" attribute prefix, if any, in the specified command.
@@ -21,3 +39,123 @@ func GetSyncFilesFromAttributes(command v1alpha2.Command) map[string]string {
 	}
 	return syncMap
 }
+
+// GetPullPathsFromAttributes gets the paths, relative to the project directory, to pull from the container into the local directory.
+// It uses the "dev.odo.pull.paths" top-level attribute of the devfile, if any, containing a list of paths.
+func GetPullPathsFromAttributes(devfileObj parser.DevfileObj) ([]string, error) {
+	attrs, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		// top-level attributes are not supported by the schema version of the devfile
+		return nil, nil
+	}
+	if !attrs.Exists(_devPullPathsAttribute) {
+		return nil, nil
+	}
+	var paths []string
+	err = attrs.GetInto(_devPullPathsAttribute, &paths)
+	if err != nil {
+		return nil, fmt.Errorf("attribute %q must be a list of paths: %w", _devPullPathsAttribute, err)
+	}
+	return CleanPullPaths(paths)
+}
+
+// GetPullPaths returns the paths to pull from the container, defined either by flagPaths or by the devfile attribute
+func GetPullPaths(devfileObj parser.DevfileObj, flagPaths []string) ([]string, error) {
+	attributePaths, err := GetPullPathsFromAttributes(devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	return CleanPullPaths(append(append([]string{}, flagPaths...), attributePaths...))
+}
+
+// CleanPullPaths returns the paths cleaned and in slash format, without duplicates.
+// It returns an error if a path is absolute or outside of the project directory.
+func CleanPullPaths(paths []string) ([]string, error) {
+	var result []string
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		cleaned := filepath.ToSlash(filepath.Clean(p))
+		if filepath.IsAbs(p) || strings.HasPrefix(cleaned, "/") || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return nil, fmt.Errorf("path to pull %q must be relative to the project directory", p)
+		}
+		if seen[cleaned] {
+			continue
+		}
+		seen[cleaned] = true
+		result = append(result, cleaned)
+	}
+	return result, nil
+}
+
+// GetWatchTriggersFromAttributes gets the actions to take when files change during odo dev.
+// It uses the "dev.odo.watch.triggers" top-level attribute of the devfile, if any, containing a list of triggers.
+// It returns an error if a trigger has no path, has no action, or references a command not defined in the devfile.
+func GetWatchTriggersFromAttributes(devfileObj parser.DevfileObj) ([]WatchTrigger, error) {
+	attrs, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		// top-level attributes are not supported by the schema version of the devfile
+		return nil, nil
+	}
+	if !attrs.Exists(_devWatchTriggersAttribute) {
+		return nil, nil
+	}
+	var triggers []WatchTrigger
+	err = attrs.GetInto(_devWatchTriggersAttribute, &triggers)
+	if err != nil {
+		return nil, fmt.Errorf("attribute %q must be a list of triggers: %w", _devWatchTriggersAttribute, err)
+	}
+	for i, trigger := range triggers {
+		if len(trigger.Paths) == 0 {
+			return nil, fmt.Errorf("trigger #%d of attribute %q must define paths", i+1, _devWatchTriggersAttribute)
+		}
+		if trigger.Command == "" && !trigger.SyncOnly {
+			return nil, fmt.Errorf("trigger #%d of attribute %q must define a command or be sync only", i+1, _devWatchTriggersAttribute)
+		}
+		if trigger.Command == "" {
+			continue
+		}
+		commands, err := devfileObj.Data.GetCommands(parsercommon.DevfileOptions{
+			FilterByName: trigger.Command,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(commands) != 1 {
+			return nil, fmt.Errorf("command %q referenced by attribute %q not found in the devfile", trigger.Command, _devWatchTriggersAttribute)
+		}
+	}
+	return triggers, nil
+}
+
+// GetTriggeredCommands returns the names of the commands triggered by the changes of the files, relative to the project directory,
+// in the order of the triggers and without duplicates.
+// It also returns true if all the files match sync only triggers, meaning that the run command does not need to be restarted.
+func GetTriggeredCommands(triggers []WatchTrigger, files []string) ([]string, bool) {
+	if len(files) == 0 {
+		return nil, false
+	}
+	var commands []string
+	seen := make(map[string]bool)
+	syncOnly := make(map[string]bool)
+	for _, trigger := range triggers {
+		matcher := gitignore.CompileIgnoreLines(trigger.Paths...)
+		for _, file := range files {
+			if !matcher.MatchesPath(filepath.ToSlash(file)) {
+				continue
+			}
+			if trigger.SyncOnly {
+				syncOnly[file] = true
+			}
+			if trigger.Command != "" && !seen[trigger.Command] {
+				seen[trigger.Command] = true
+				commands = append(commands, trigger.Command)
+			}
+		}
+	}
+	for _, file := range files {
+		if !syncOnly[file] {
+			return commands, false
+		}
+	}
+	return commands, true
+}
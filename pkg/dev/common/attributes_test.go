@@ -5,6 +5,9 @@ import (
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/v2/pkg/devfile/parser/data/v2"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -89,3 +92,217 @@ func TestGetSyncFilesFromAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPullPathsFromAttributes(t *testing.T) {
+	tests := []struct {
+		name          string
+		schemaVersion string
+		attributes    map[string]interface{}
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:          "no attributes",
+			schemaVersion: string(data.APISchemaVersion220),
+		},
+		{
+			name:          "attributes not supported by schema version",
+			schemaVersion: string(data.APISchemaVersion200),
+		},
+		{
+			name:          "list of paths",
+			schemaVersion: string(data.APISchemaVersion220),
+			attributes: map[string]interface{}{
+				_devPullPathsAttribute: []string{"target/generated", "./api/openapi.json", "target/generated/"},
+			},
+			want: []string{"target/generated", "api/openapi.json"},
+		},
+		{
+			name:          "not a list",
+			schemaVersion: string(data.APISchemaVersion220),
+			attributes: map[string]interface{}{
+				_devPullPathsAttribute: "target/generated",
+			},
+			wantErr: true,
+		},
+		{
+			name:          "path outside of the project",
+			schemaVersion: string(data.APISchemaVersion220),
+			attributes: map[string]interface{}{
+				_devPullPathsAttribute: []string{"../generated"},
+			},
+			wantErr: true,
+		},
+		{
+			name:          "absolute path",
+			schemaVersion: string(data.APISchemaVersion220),
+			attributes: map[string]interface{}{
+				_devPullPathsAttribute: []string{"/tmp/generated"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(tt.schemaVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.attributes != nil {
+				devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}.FromMap(tt.attributes, &err)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := GetPullPathsFromAttributes(parser.DevfileObj{Data: devfileData})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPullPathsFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetPullPathsFromAttributes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetWatchTriggersFromAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		want       []WatchTrigger
+		wantErr    bool
+	}{
+		{
+			name: "no attributes",
+		},
+		{
+			name: "command and sync only triggers",
+			attributes: map[string]interface{}{
+				_devWatchTriggersAttribute: []interface{}{
+					map[string]interface{}{"paths": []string{"package.json"}, "command": "npm-install"},
+					map[string]interface{}{"paths": []string{"public/", "*.css"}, "syncOnly": true},
+				},
+			},
+			want: []WatchTrigger{
+				{Paths: []string{"package.json"}, Command: "npm-install"},
+				{Paths: []string{"public/", "*.css"}, SyncOnly: true},
+			},
+		},
+		{
+			name: "command not defined in the devfile",
+			attributes: map[string]interface{}{
+				_devWatchTriggersAttribute: []interface{}{
+					map[string]interface{}{"paths": []string{"*.proto"}, "command": "gen"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no paths",
+			attributes: map[string]interface{}{
+				_devWatchTriggersAttribute: []interface{}{
+					map[string]interface{}{"command": "npm-install"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no action",
+			attributes: map[string]interface{}{
+				_devWatchTriggersAttribute: []interface{}{
+					map[string]interface{}{"paths": []string{"package.json"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "not a list",
+			attributes: map[string]interface{}{
+				_devWatchTriggersAttribute: "package.json",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddCommands([]v1alpha2.Command{
+				{
+					Id: "npm-install",
+					CommandUnion: v1alpha2.CommandUnion{
+						Exec: &v1alpha2.ExecCommand{CommandLine: "npm install", Component: "runtime"},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.attributes != nil {
+				devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}.FromMap(tt.attributes, &err)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := GetWatchTriggersFromAttributes(parser.DevfileObj{Data: devfileData})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetWatchTriggersFromAttributes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetWatchTriggersFromAttributes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetTriggeredCommands(t *testing.T) {
+	triggers := []WatchTrigger{
+		{Paths: []string{"package.json"}, Command: "npm-install"},
+		{Paths: []string{"*.proto"}, Command: "gen"},
+		{Paths: []string{"public/", "*.css"}, SyncOnly: true},
+		{Paths: []string{"api/*.proto"}, Command: "gen"},
+	}
+	tests := []struct {
+		name         string
+		files        []string
+		wantCommands []string
+		wantSyncOnly bool
+	}{
+		{
+			name: "no file",
+		},
+		{
+			name:  "no trigger matching",
+			files: []string{"src/main.js"},
+		},
+		{
+			name:         "commands triggered once, in the order of the triggers",
+			files:        []string{"api/v1/service.proto", "package.json", "api/model.proto"},
+			wantCommands: []string{"npm-install", "gen"},
+		},
+		{
+			name:         "only sync only files",
+			files:        []string{"public/index.html", "src/style.css"},
+			wantSyncOnly: true,
+		},
+		{
+			name:  "sync only and other files",
+			files: []string{"public/index.html", "src/main.js"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCommands, gotSyncOnly := GetTriggeredCommands(triggers, tt.files)
+			if diff := cmp.Diff(tt.wantCommands, gotCommands); diff != "" {
+				t.Errorf("GetTriggeredCommands() commands mismatch (-want +got):\n%s", diff)
+			}
+			if gotSyncOnly != tt.wantSyncOnly {
+				t.Errorf("GetTriggeredCommands() syncOnly = %v, want %v", gotSyncOnly, tt.wantSyncOnly)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+// PullFiles pulls the files generated in the container of the pod mounting the sources, under the pull paths, into the local directory,
+// and returns the absolute paths of the pulled files.
+// getPod returns the pod of the component, or nil if the pod is not running yet.
+// checksums are the checksums of the files already handled, updated with the pulled files.
+func PullFiles(
+	ctx context.Context,
+	syncClient sync.Client,
+	options dev.StartOptions,
+	getPod func() (*corev1.Pod, error),
+	checksums map[string]string,
+) ([]string, error) {
+	var (
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		devfilePath   = odocontext.GetDevfilePath(ctx)
+		path          = filepath.Dir(devfilePath)
+		componentName = odocontext.GetComponentName(ctx)
+	)
+
+	pullPaths, err := GetPullPaths(*devfileObj, options.PullPaths)
+	if err != nil {
+		return nil, err
+	}
+	if len(pullPaths) == 0 {
+		return nil, nil
+	}
+
+	pod, err := getPod()
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil {
+		return nil, nil
+	}
+	containerName, syncFolder, err := GetFirstContainerWithSourceVolume(pod.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", pod.GetName(), err)
+	}
+
+	pulled, err := syncClient.PullFiles(ctx, sync.PullParameters{
+		Path:        path,
+		RemotePaths: pullPaths,
+		CompInfo: sync.ComponentInfo{
+			ComponentName: componentName,
+			ContainerName: containerName,
+			PodName:       pod.GetName(),
+			SyncFolder:    syncFolder,
+		},
+		RemoteChecksums: checksums,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pulled {
+		pulled[i] = filepath.Join(path, pulled[i])
+	}
+	return pulled, nil
+}
@@ -0,0 +1,119 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+func TestPullFiles(t *testing.T) {
+	path := filepath.Join("/", "tmp", "project")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mycmp-app"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "tools"},
+				{Name: "runtime", Env: []corev1.EnvVar{{Name: generator.EnvProjectsSrc, Value: "/projects"}}},
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		pullPaths  []string
+		getPod     func() (*corev1.Pod, error)
+		syncClient func(ctrl *gomock.Controller, checksums map[string]string) sync.Client
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name: "no pull paths",
+			getPod: func() (*corev1.Pod, error) {
+				t.Error("the pod should not be retrieved")
+				return pod, nil
+			},
+			syncClient: func(ctrl *gomock.Controller, checksums map[string]string) sync.Client {
+				return sync.NewMockClient(ctrl)
+			},
+		},
+		{
+			name:      "pod not running",
+			pullPaths: []string{"generated"},
+			getPod: func() (*corev1.Pod, error) {
+				return nil, nil
+			},
+			syncClient: func(ctrl *gomock.Controller, checksums map[string]string) sync.Client {
+				return sync.NewMockClient(ctrl)
+			},
+		},
+		{
+			name:      "error getting the pod",
+			pullPaths: []string{"generated"},
+			getPod: func() (*corev1.Pod, error) {
+				return nil, errors.New("an error")
+			},
+			syncClient: func(ctrl *gomock.Controller, checksums map[string]string) sync.Client {
+				return sync.NewMockClient(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "files pulled from the container mounting the sources",
+			pullPaths: []string{"generated", "./package-lock.json"},
+			getPod: func() (*corev1.Pod, error) {
+				return pod, nil
+			},
+			syncClient: func(ctrl *gomock.Controller, checksums map[string]string) sync.Client {
+				client := sync.NewMockClient(ctrl)
+				client.EXPECT().PullFiles(gomock.Any(), sync.PullParameters{
+					Path:        path,
+					RemotePaths: []string{"generated", "package-lock.json"},
+					CompInfo: sync.ComponentInfo{
+						ComponentName: "mycmp",
+						ContainerName: "runtime",
+						PodName:       "mycmp-app",
+						SyncFolder:    "/projects",
+					},
+					RemoteChecksums: checksums,
+				}).Return([]string{filepath.Join("generated", "api.go"), "package-lock.json"}, nil)
+				return client
+			},
+			want: []string{filepath.Join(path, "generated", "api.go"), filepath.Join(path, "package-lock.json")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			ctx = odocontext.WithDevfilePath(ctx, filepath.Join(path, "devfile.yaml"))
+			ctx = odocontext.WithComponentName(ctx, "mycmp")
+			ctx = odocontext.WithEffectiveDevfileObj(ctx, &parser.DevfileObj{Data: devfileData})
+			checksums := make(map[string]string)
+
+			got, err := PullFiles(ctx, tt.syncClient(ctrl, checksums), dev.StartOptions{PullPaths: tt.pullPaths}, tt.getPod, checksums)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PullFiles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PullFiles() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/port"
+)
+
+const (
+	// readinessTimeout is the maximum duration to wait for the application to respond
+	readinessTimeout = 1 * time.Minute
+	// readinessCheckInterval is the interval at which the readiness of the application is checked, once the Dev session is ready
+	readinessCheckInterval = 5 * time.Second
+)
+
+// ReadinessChecker waits for the application to respond on its forwarded endpoints, then keeps checking it,
+// to report when the application stops responding, and when it responds again.
+type ReadinessChecker struct {
+	// timeout is the maximum duration to wait for the application to respond
+	timeout time.Duration
+	// checkInterval is the interval at which the readiness is checked in the background
+	checkInterval time.Duration
+
+	mu sync.Mutex
+	// cancel stops the checks running in the background, if any
+	cancel context.CancelFunc
+}
+
+func NewReadinessChecker() *ReadinessChecker {
+	return &ReadinessChecker{
+		timeout:       readinessTimeout,
+		checkInterval: readinessCheckInterval,
+	}
+}
+
+// Stop stops checking the readiness of the application. It is called before the application is restarted.
+func (o *ReadinessChecker) Stop() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.cancel != nil {
+		o.cancel()
+		o.cancel = nil
+	}
+}
+
+// Check waits for the application to respond on the forwarded ports, as defined by the readiness attributes
+// of the endpoints of the Devfile. A warning is displayed if the application does not respond in time.
+// The readiness keeps being checked in the background, until Stop is called or ctx is cancelled.
+// An error is returned only if the readiness attributes are not valid.
+func (o *ReadinessChecker) Check(ctx context.Context, devfileObj parser.DevfileObj, debug bool, fwPorts []api.ForwardedPort, out io.Writer, errOut io.Writer) error {
+	o.Stop()
+
+	ceMapping, err := libdevfile.GetDevfileContainerEndpointMapping(devfileObj, debug)
+	if err != nil {
+		return err
+	}
+	probes, err := port.GetReadinessProbes(ceMapping, fwPorts)
+	if err != nil {
+		return err
+	}
+	if len(probes) == 0 {
+		return nil
+	}
+	if out == nil {
+		out = log.GetStdout()
+	}
+	if errOut == nil {
+		errOut = log.GetStderr()
+	}
+
+	spinner := log.Fspinnerf(out, "Waiting for the application to respond")
+	err = port.WaitForReadiness(ctx, probes, o.timeout)
+	spinner.End(err == nil)
+	ready := err == nil
+	if !ready {
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Fwarningf(errOut, "The application is not responding: %v", err)
+	}
+
+	checkCtx, cancel := context.WithCancel(ctx)
+	o.mu.Lock()
+	o.cancel = cancel
+	o.mu.Unlock()
+	go watchReadiness(checkCtx, probes, ready, o.checkInterval, out, errOut)
+	return nil
+}
+
+// watchReadiness checks the probes periodically, and reports the changes of the readiness of the application
+func watchReadiness(ctx context.Context, probes []port.ReadinessProbe, ready bool, interval time.Duration, out io.Writer, errOut io.Writer) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := port.CheckReadiness(ctx, probes)
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case err != nil && ready:
+			log.Fwarningf(errOut, "The application stopped responding: %v", err)
+		case err == nil && !ready:
+			log.Fsuccess(out, "The application is responding")
+		}
+		ready = err == nil
+	}
+}
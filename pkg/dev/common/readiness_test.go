@@ -0,0 +1,161 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+	"github.com/redhat-developer/odo/pkg/port"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use, as the readiness is checked in the background
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (o *syncBuffer) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.Write(p)
+}
+
+func (o *syncBuffer) String() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.String()
+}
+
+func getReadinessTestDevfileObj(t *testing.T, endpoints ...v1alpha2.Endpoint) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "runtime",
+			Endpoints: endpoints,
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
+
+// listen returns a listener on a free local port, and the port
+func listen(t *testing.T) (net.Listener, int) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l, l.Addr().(*net.TCPAddr).Port
+}
+
+func TestReadinessChecker_Check(t *testing.T) {
+	l, localPort := listen(t)
+	defer l.Close()
+	devfileObj := getReadinessTestDevfileObj(t, v1alpha2.Endpoint{Name: "http", TargetPort: 3000})
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: localPort, ContainerPort: 3000},
+	}
+
+	checker := NewReadinessChecker()
+	checker.timeout = time.Second
+	checker.checkInterval = 10 * time.Millisecond
+	defer checker.Stop()
+
+	var out, errOut syncBuffer
+	err := checker.Check(context.Background(), devfileObj, false, fwPorts, &out, &errOut)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := errOut.String(); got != "" {
+		t.Errorf("unexpected warning while the application responds: %q", got)
+	}
+
+	// The application stops responding
+	l.Close()
+	waitForOutput(t, &errOut, "The application stopped responding")
+
+	// The application responds again on the same port
+	l, err = net.Listen("tcp", "127.0.0.1:"+strconv.Itoa(localPort))
+	if err != nil {
+		t.Skipf("unable to listen again on port %d: %v", localPort, err)
+	}
+	defer l.Close()
+	waitForOutput(t, &out, "The application is responding")
+}
+
+func TestReadinessChecker_Check_notResponding(t *testing.T) {
+	l, localPort := listen(t)
+	l.Close()
+	devfileObj := getReadinessTestDevfileObj(t, v1alpha2.Endpoint{Name: "http", TargetPort: 3000})
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: localPort, ContainerPort: 3000},
+	}
+
+	checker := NewReadinessChecker()
+	checker.timeout = 100 * time.Millisecond
+	checker.checkInterval = time.Hour
+	defer checker.Stop()
+
+	var out, errOut syncBuffer
+	err := checker.Check(context.Background(), devfileObj, false, fwPorts, &out, &errOut)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := errOut.String(); !strings.Contains(got, "The application is not responding") {
+		t.Errorf("expected a warning, got %q", got)
+	}
+}
+
+func TestReadinessChecker_Check_noProbe(t *testing.T) {
+	checker := NewReadinessChecker()
+	var out, errOut syncBuffer
+	err := checker.Check(context.Background(), getReadinessTestDevfileObj(t), false, nil, &out, &errOut)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "" || errOut.String() != "" {
+		t.Errorf("nothing should be displayed without endpoint, got %q and %q", out.String(), errOut.String())
+	}
+}
+
+func TestReadinessChecker_Check_invalidAttributes(t *testing.T) {
+	devfileObj := getReadinessTestDevfileObj(t, v1alpha2.Endpoint{
+		Name:       "http",
+		TargetPort: 3000,
+		Attributes: attributes.Attributes{}.PutString(port.ReadinessStatusAttribute, "ok"),
+	})
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000},
+	}
+	err := NewReadinessChecker().Check(context.Background(), devfileObj, false, fwPorts, &syncBuffer{}, &syncBuffer{})
+	if err == nil {
+		t.Error("expected an error for invalid readiness attributes")
+	}
+}
+
+func waitForOutput(t *testing.T, buf *syncBuffer, want string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(buf.String(), want) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%q not displayed, got %q", want, buf.String())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -12,6 +12,8 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 func Run(
@@ -47,8 +49,51 @@ func Run(
 			DirectRun:         true,
 			Devfile:           *devfileObj,
 			Path:              devfilePath,
+			LocalCluster:      component.GetLocalCluster(ctx, platformClient),
 		},
 	)
 
 	return libdevfile.ExecuteCommandByName(ctx, *devfileObj, commandName, handler, false)
 }
+
+// ExecuteTriggeredCommands executes in the pod the Devfile commands triggered by the changed files, as defined in parameters' TriggeredCommands
+func ExecuteTriggeredCommands(
+	ctx context.Context,
+	parameters PushParameters,
+	pod *corev1.Pod,
+	platformClient platform.Client,
+	execClient exec.Client,
+	configAutomountClient configAutomount.Client,
+	filesystem filesystem.Filesystem,
+) error {
+	if len(parameters.TriggeredCommands) == 0 {
+		return nil
+	}
+	devfilePath := odocontext.GetDevfilePath(ctx)
+
+	handler := component.NewRunHandler(
+		ctx,
+		platformClient,
+		execClient,
+		configAutomountClient,
+		filesystem,
+		image.SelectBackend(ctx),
+		component.HandlerOptions{
+			PodName:           pod.Name,
+			ComponentExists:   true,
+			ContainersRunning: component.GetContainersNames(pod),
+			Msg:               "Executing command triggered by the changed files",
+			Devfile:           parameters.Devfile,
+			Path:              devfilePath,
+			LocalCluster:      component.GetLocalCluster(ctx, platformClient),
+		},
+	)
+
+	for _, commandName := range parameters.TriggeredCommands {
+		err := libdevfile.ExecuteCommandByName(ctx, parameters.Devfile, commandName, handler, false)
+		if err != nil {
+			return fmt.Errorf("unable to execute command %q triggered by the changed files: %w", commandName, err)
+		}
+	}
+	return nil
+}
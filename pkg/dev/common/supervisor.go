@@ -0,0 +1,52 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+// NewCommandSupervisor returns a handler starting the processes of the run and debug commands, and restarting them
+// according to the restart policy defined in options. The statuses of the commands are saved into the state file,
+// and their restarts are reported to options.ErrOut.
+func NewCommandSupervisor(ctx context.Context, execClient exec.Client, stateClient state.ComponentClient, options dev.StartOptions, newBackoff func() remotecmd.Backoff) remotecmd.RemoteProcessHandler {
+	errOut := options.ErrOut
+	if errOut == nil {
+		errOut = log.GetStderr()
+	}
+	policy := options.RestartPolicy
+	if policy == "" {
+		policy = remotecmd.RestartNever
+	}
+	return remotecmd.NewSupervisor(remotecmd.NewKubeExecProcessHandler(execClient), remotecmd.RestartOptions{
+		Policy:     policy,
+		MaxRetries: options.MaxRestarts,
+		NewBackoff: newBackoff,
+		OnStatusChange: func(info remotecmd.SupervisedProcessInfo) {
+			err := stateClient.SetCommandStatus(ctx, api.DevCommandStatus{
+				Name:     info.Id,
+				Status:   string(info.Status),
+				Restarts: info.Restarts,
+			})
+			if err != nil {
+				klog.V(2).Infof("unable to save the status of command %q to the state file: %v", info.Id, err)
+			}
+			switch {
+			case info.NextRestart > 0:
+				log.Fwarningf(errOut, "Devfile command %q exited with status %s, restarting it in %s",
+					info.Id, info.Status, info.NextRestart.Round(time.Millisecond))
+			case info.RetriesExhausted:
+				log.Fwarningf(errOut, "Devfile command %q exited with status %s after %d restart(s), not restarting it anymore",
+					info.Id, info.Status, info.Restarts)
+			}
+		},
+	})
+}
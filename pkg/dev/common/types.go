@@ -14,4 +14,6 @@ type PushParameters struct {
 	WatchDeletedFiles        []string // Optional: WatchDeletedFiles is the list of deleted files detected by odo watch. If empty or nil, odo will check .odo/odo-file-index.json to determine deleted files
 	Show                     bool     // Show tells whether the devfile command output should be shown on stdout
 	DevfileScanIndexForWatch bool     // DevfileScanIndexForWatch is true if watch's push should regenerate the index file during SyncFiles, false otherwise. See 'pkg/sync/adapter.go' for details
+	TriggeredCommands        []string // Optional: TriggeredCommands are the names of the Devfile commands to execute after the files are synced and before the run command is restarted, as defined by the "dev.odo.watch.triggers" attribute
+	SyncOnly                 bool     // SyncOnly is true if only files not requiring the run command to be restarted changed, as defined by the "dev.odo.watch.triggers" attribute
 }
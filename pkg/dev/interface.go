@@ -3,8 +3,10 @@ package dev
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
 )
 
 type StartOptions struct {
@@ -27,18 +29,49 @@ type StartOptions struct {
 	CustomForwardedPorts []api.ForwardedPort
 	// CustomAddress defines a custom local address for port forwarding; default value is 127.0.0.1
 	CustomAddress string
+	// ReverseForwardedPorts define the local addresses made reachable from the containers of the component, on ports of the pod.
+	// Applicable to the cluster only.
+	ReverseForwardedPorts []api.ReverseForwardedPort
 	// if WatchFiles is set, files changes will trigger a new sync to the container
 	WatchFiles bool
+	// If WatchPolling is set, the files are polled for changes instead of relying on filesystem notifications.
+	// Polling is also used when the files are on a network filesystem, or when filesystem notifications cannot be set for all the directories.
+	WatchPolling bool
+	// WatchPollInterval is the interval at which the files are polled for changes, when polling is used. Defaults to 1 second.
+	WatchPollInterval time.Duration
+	// WatchDebounceInterval is the duration to wait for other changes after a file change is detected, before syncing the files. Defaults to 100 milliseconds.
+	WatchDebounceInterval time.Duration
 	// IgnoreLocalhost indicates whether to proceed with port-forwarding regardless of any container ports being bound to the container loopback interface.
 	// Applicable to Podman only.
 	IgnoreLocalhost bool
 	// ForwardLocalhost is a flag indicating if we inject a side container that will make port-forwarding work with container apps listening on the loopback interface.
 	// Applicable to Podman only.
 	ForwardLocalhost bool
+	// If DeltaSync is true, only the changed blocks of large files already present in the container are synced.
+	DeltaSync bool
+	// PullPaths are the paths, relative to the project directory, to pull from the container into the local directory,
+	// in addition to the ones defined in the Devfile.
+	PullPaths []string
+	// PullInterval is the interval at which the container is checked for files to pull. Defaults to 2 seconds.
+	PullInterval time.Duration
+	// RestartPolicy defines when the process of the run or debug command is restarted after it exits. Defaults to never.
+	RestartPolicy remotecmd.RestartPolicy
+	// MaxRestarts is the maximum number of consecutive restarts of the run or debug command; 0 means no limit.
+	MaxRestarts int
+	// If Expose is true, an Ingress, or a Route on OpenShift, is created for each public endpoint. Applicable to the cluster only.
+	Expose bool
+	// ExposeDomain is the domain under which the hostnames of the exposed endpoints are generated.
+	// It is required for Ingresses; if empty, the hostnames of the Routes are generated by OpenShift.
+	ExposeDomain string
+	// If ExposeTLS is true, the exposed endpoints are served over TLS, with a generated self-signed certificate.
+	ExposeTLS bool
 	// Variables to override in the Devfile
 	Variables map[string]string
 	// PushWatcher is a channel that will emit an event when Pushing files to the component is requested
 	PushWatcher <-chan struct{}
+	// If SkipKeyWatcher is true, the keys pressed on the keyboard are not watched.
+	// It is used when several components are developed in the same session, the manual pushes being requested through PushWatcher.
+	SkipKeyWatcher bool
 
 	Out    io.Writer
 	ErrOut io.Writer
@@ -27,6 +27,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/portForward/kubeportforward"
 	"github.com/redhat-developer/odo/pkg/service"
 	storagepkg "github.com/redhat-developer/odo/pkg/storage"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -130,6 +131,13 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 		return false, err
 	}
 
+	if parameters.StartOptions.Expose {
+		err = o.exposeEndpoints(ctx, parameters, ownerReference)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	if updated {
 		klog.V(4).Infof("Deployment has been updated to generation %d. Waiting new event...\n", deployment.GetGeneration())
 		componentStatus.SetState(watch.StateWaitDeployment)
@@ -183,7 +191,7 @@ func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, fs filesys
 			continue
 		}
 
-		err = image.BuildPushSpecificImage(ctx, image.SelectBackend(ctx), fs, c, true)
+		err = image.BuildPushSpecificImage(ctx, image.SelectBackend(ctx), fs, c, true, component.GetLocalCluster(ctx, o.kubernetesClient))
 		if err != nil {
 			return err
 		}
@@ -287,6 +295,14 @@ func (o *DevClient) createOrUpdateComponent(
 		return nil, false, err
 	}
 
+	// The images loaded into a local cluster cannot be pulled from a registry
+	loadedImages, err := image.GetLoadedImages(parameters.Devfile, component.GetLocalCluster(ctx, o.kubernetesClient))
+	if err != nil {
+		return nil, false, err
+	}
+	image.SetContainersPullPolicy(containers, loadedImages)
+	image.SetContainersPullPolicy(initContainers, loadedImages)
+
 	// Returns the volumes to add to the PodTemplate and adds volumeMounts to the containers and initContainers
 	volumes, err := o.buildVolumes(ctx, parameters, containers, initContainers)
 	if err != nil {
@@ -294,6 +310,11 @@ func (o *DevClient) createOrUpdateComponent(
 	}
 	podTemplateSpec.Spec.Volumes = volumes
 
+	if len(parameters.StartOptions.ReverseForwardedPorts) != 0 {
+		// The helper container listens on the ports of the reverse port forwarding, in the network shared by the containers of the pod
+		podTemplateSpec.Spec.Containers = append(podTemplateSpec.Spec.Containers, kubeportforward.GetReverseHelperContainer())
+	}
+
 	selectorLabels := map[string]string{
 		"component": componentName,
 	}
@@ -582,8 +603,13 @@ func (o *DevClient) pushDevfileKubernetesComponents(
 	annotations := make(map[string]string)
 	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
 
+	loadedImages, err := image.GetLoadedImages(parameters.Devfile, component.GetLocalCluster(ctx, o.kubernetesClient))
+	if err != nil {
+		return nil, err
+	}
+
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
-	err = service.PushKubernetesResources(o.kubernetesClient, parameters.Devfile, k8sComponents, labels, annotations, path, mode, reference)
+	err = service.PushKubernetesResources(o.kubernetesClient, parameters.Devfile, k8sComponents, labels, annotations, path, mode, reference, loadedImages)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Kubernetes resources associated with the component: %w", err)
 	}
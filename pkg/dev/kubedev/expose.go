@@ -0,0 +1,312 @@
+package kubedev
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+var ingressGVK = networkingv1.SchemeGroupVersion.WithKind("Ingress")
+
+// exposedEndpoint is an endpoint of the Devfile exposed outside of the cluster with an Ingress or a Route
+type exposedEndpoint struct {
+	endpoint devfilev1.Endpoint
+	// name is the name of the Ingress or Route
+	name string
+	// host is the hostname of the Ingress or Route; it is empty when the hostname of a Route is generated by OpenShift
+	host string
+}
+
+// exposeEndpoints creates an Ingress, or a Route on OpenShift, for each public HTTP endpoint of the Devfile,
+// owned by the Dev deployment, and deletes the ones exposing endpoints which are no longer public.
+func (o *DevClient) exposeEndpoints(ctx context.Context, parameters common.PushParameters, ownerReference metav1.OwnerReference) error {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		namespace     = o.kubernetesClient.GetCurrentNamespace()
+		domain        = parameters.StartOptions.ExposeDomain
+	)
+
+	isOpenShift, err := o.kubernetesClient.IsProjectSupported()
+	if err != nil {
+		klog.V(4).Infof("unable to detect project support: %s", err)
+	}
+	if !isOpenShift && domain == "" {
+		return fmt.Errorf("--expose-domain is required to expose the endpoints with Ingresses, as the cluster is not an OpenShift cluster")
+	}
+
+	endpoints, err := getEndpointsToExpose(parameters.Devfile, componentName, namespace, domain)
+	if err != nil {
+		return err
+	}
+	serviceName, err := util.NamespaceKubernetesObjectWithTrim(componentName, appName, 63)
+	if err != nil {
+		return err
+	}
+
+	compRuntime := component.GetComponentRuntimeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, compRuntime, odolabels.ComponentDevMode, true)
+
+	var tlsSecret *corev1.Secret
+	if parameters.StartOptions.ExposeTLS && domain != "" && len(endpoints) != 0 {
+		tlsSecret, err = o.getOrCreateExposeTLSSecret(componentName, appName, domain, labels, ownerReference)
+		if err != nil {
+			return err
+		}
+	}
+
+	gvk := ingressGVK
+	if isOpenShift {
+		gvk = kclient.RouteGVK
+	}
+	toKeep := make(map[string]bool, len(endpoints))
+	var urls []string
+	for _, e := range endpoints {
+		resourceLabels := make(map[string]string, len(labels)+1)
+		for k, v := range labels {
+			resourceLabels[k] = v
+		}
+		odolabels.AddExposedEndpoint(resourceLabels, e.endpoint.Name)
+		objectMeta := metav1.ObjectMeta{
+			Name:            e.name,
+			Namespace:       namespace,
+			Labels:          resourceLabels,
+			OwnerReferences: []metav1.OwnerReference{ownerReference},
+		}
+
+		var u unstructured.Unstructured
+		if isOpenShift {
+			u, err = getExposeRoute(objectMeta, e, serviceName, parameters.StartOptions.ExposeTLS, tlsSecret)
+		} else {
+			u, err = getExposeIngress(objectMeta, e, serviceName, tlsSecret)
+		}
+		if err != nil {
+			return err
+		}
+		if _, err = o.kubernetesClient.PatchDynamicResource(u); err != nil {
+			return fmt.Errorf("unable to expose the endpoint %q: %w", e.endpoint.Name, err)
+		}
+		toKeep[e.name] = true
+
+		if e.host != "" {
+			scheme := "http"
+			if parameters.StartOptions.ExposeTLS {
+				scheme = "https"
+			}
+			urls = append(urls, fmt.Sprintf("%s://%s%s", scheme, e.host, getEndpointPath(e.endpoint)))
+		}
+	}
+
+	err = o.deleteExposeResources(gvk, odolabels.GetExposedEndpointsSelector(componentName, appName), toKeep)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(urls)
+	if strings.Join(urls, " ") != strings.Join(o.exposedURLs, " ") {
+		for _, url := range urls {
+			log.Infof("Endpoint exposed at %s", url)
+		}
+		o.exposedURLs = urls
+	}
+	return nil
+}
+
+// getEndpointsToExpose returns the public HTTP endpoints of the Devfile, with the names and hostnames of their Ingresses or Routes.
+// The hostnames are generated from the names of the endpoint, of the component and of the namespace, under domain;
+// they are left empty if domain is empty.
+func getEndpointsToExpose(devfileObj parser.DevfileObj, componentName, namespace, domain string) ([]exposedEndpoint, error) {
+	endpoints, err := libdevfile.GetEndpointsFromDevfile(devfileObj, []devfilev1.EndpointExposure{devfilev1.InternalEndpointExposure, devfilev1.NoneEndpointExposure})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []exposedEndpoint
+	for _, e := range endpoints {
+		if libdevfile.IsDebugEndpoint(e) {
+			continue
+		}
+		switch e.Protocol {
+		case "", devfilev1.HTTPEndpointProtocol, devfilev1.HTTPSEndpointProtocol, devfilev1.WSEndpointProtocol, devfilev1.WSSEndpointProtocol:
+		default:
+			klog.V(4).Infof("not exposing the endpoint %q with protocol %q", e.Name, e.Protocol)
+			continue
+		}
+		name, err := util.NamespaceKubernetesObjectWithTrim(componentName, e.Name, 63)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, exposedEndpoint{
+			endpoint: e,
+			name:     name,
+			host:     getExposedHost(e.Name, componentName, namespace, domain),
+		})
+	}
+	return result, nil
+}
+
+// getExposedHost returns the hostname of the endpoint, in the form <endpoint>-<component>-<namespace>.<domain>,
+// the first label being truncated to be a valid DNS label
+func getExposedHost(endpointName, componentName, namespace, domain string) string {
+	if domain == "" {
+		return ""
+	}
+	label := strings.ToLower(fmt.Sprintf("%s-%s-%s", endpointName, componentName, namespace))
+	label = strings.Trim(dfutil.TruncateString(label, validation.DNS1123LabelMaxLength), "-")
+	return label + "." + strings.TrimPrefix(domain, ".")
+}
+
+func getEndpointPath(endpoint devfilev1.Endpoint) string {
+	if endpoint.Path == "" {
+		return "/"
+	}
+	return "/" + strings.TrimPrefix(endpoint.Path, "/")
+}
+
+// getExposeIngress returns the Ingress exposing the endpoint through the service of the component
+func getExposeIngress(objectMeta metav1.ObjectMeta, e exposedEndpoint, serviceName string, tlsSecret *corev1.Secret) (unstructured.Unstructured, error) {
+	pathType := networkingv1.PathTypePrefix
+	ingress := networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: ingressGVK.Kind, APIVersion: ingressGVK.GroupVersion().String()},
+		ObjectMeta: objectMeta,
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: e.host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     getEndpointPath(e.endpoint),
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: serviceName,
+									Port: networkingv1.ServiceBackendPort{Number: int32(e.endpoint.TargetPort)},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if tlsSecret != nil {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{e.host},
+			SecretName: tlsSecret.Name,
+		}}
+	}
+	return toUnstructured(&ingress)
+}
+
+// getExposeRoute returns the Route exposing the endpoint through the service of the component.
+// With TLS, the Route is terminated at the edge, with the certificate of tlsSecret if any, or else the default certificate of the router.
+func getExposeRoute(objectMeta metav1.ObjectMeta, e exposedEndpoint, serviceName string, withTLS bool, tlsSecret *corev1.Secret) (unstructured.Unstructured, error) {
+	route := routev1.Route{
+		TypeMeta:   metav1.TypeMeta{Kind: kclient.RouteGVK.Kind, APIVersion: kclient.RouteGVK.GroupVersion().String()},
+		ObjectMeta: objectMeta,
+		Spec: routev1.RouteSpec{
+			Host: e.host,
+			Path: getEndpointPath(e.endpoint),
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: serviceName,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromInt(e.endpoint.TargetPort),
+			},
+		},
+	}
+	if withTLS {
+		route.Spec.TLS = &routev1.TLSConfig{
+			Termination:                   routev1.TLSTerminationEdge,
+			InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+		}
+		if tlsSecret != nil {
+			route.Spec.TLS.Certificate = string(tlsSecret.Data[corev1.TLSCertKey])
+			route.Spec.TLS.Key = string(tlsSecret.Data[corev1.TLSPrivateKeyKey])
+		}
+	}
+	return toUnstructured(&route)
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: content}, nil
+}
+
+// getOrCreateExposeTLSSecret returns the TLS secret containing the self-signed certificate of the exposed endpoints,
+// creating it if it does not exist yet, so that the same certificate is used during the whole session
+func (o *DevClient) getOrCreateExposeTLSSecret(componentName, appName, domain string, labels map[string]string, ownerReference metav1.OwnerReference) (*corev1.Secret, error) {
+	name, err := util.NamespaceKubernetesObjectWithTrim(componentName, appName+"-tls", 63)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := o.kubernetesClient.GetSecret(name, o.kubernetesClient.GetCurrentNamespace())
+	if err == nil {
+		return secret, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	cert, err := kclient.GenerateSelfSignedCertificate(strings.TrimPrefix(domain, "."))
+	if err != nil {
+		return nil, err
+	}
+	return o.kubernetesClient.CreateTLSSecret(cert.CertPem, cert.KeyPem, metav1.ObjectMeta{
+		Name:            name,
+		Labels:          labels,
+		OwnerReferences: []metav1.OwnerReference{ownerReference},
+	})
+}
+
+// deleteExposeResources deletes the Ingresses or Routes matching the selector, except the ones to keep
+func (o *DevClient) deleteExposeResources(gvk schema.GroupVersionKind, selector string, toKeep map[string]bool) error {
+	gvr, err := o.kubernetesClient.GetGVRFromGVK(gvk)
+	if err != nil {
+		return err
+	}
+	list, err := o.kubernetesClient.ListDynamicResources("", gvr, selector)
+	if err != nil {
+		return err
+	}
+	for _, u := range list.Items {
+		if toKeep[u.GetName()] {
+			continue
+		}
+		klog.V(3).Infof("Deleting %s %s exposing an endpoint which is no longer public", u.GetKind(), u.GetName())
+		err = o.kubernetesClient.DeleteDynamicResource(u.GetName(), gvr, false)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
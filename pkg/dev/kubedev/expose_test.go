@@ -0,0 +1,191 @@
+package kubedev
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfileParser "github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetExposedHost(t *testing.T) {
+	tests := []struct {
+		name          string
+		endpointName  string
+		componentName string
+		namespace     string
+		domain        string
+		want          string
+	}{
+		{
+			name:          "no domain",
+			endpointName:  "http",
+			componentName: "my-comp",
+			namespace:     "my-ns",
+			want:          "",
+		},
+		{
+			name:          "host generated from the endpoint, component and namespace",
+			endpointName:  "HTTP",
+			componentName: "my-comp",
+			namespace:     "my-ns",
+			domain:        ".apps.example.com",
+			want:          "http-my-comp-my-ns.apps.example.com",
+		},
+		{
+			name:          "first label truncated",
+			endpointName:  "http",
+			componentName: "a-very-long-component-name-for-which-the-label-is-truncated",
+			namespace:     "my-namespace",
+			domain:        "example.com",
+			want:          "http-a-very-long-component-name-for-which-the-label-is-truncate.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getExposedHost(tt.endpointName, tt.componentName, tt.namespace, tt.domain)
+			if got != tt.want {
+				t.Errorf("getExposedHost() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEndpointsToExpose(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{
+					Container: devfilev1.Container{Image: "nodejs"},
+					Endpoints: []devfilev1.Endpoint{
+						{Name: "http", TargetPort: 3000, Path: "api"},
+						{Name: "internal", TargetPort: 3001, Exposure: devfilev1.InternalEndpointExposure},
+						{Name: "debug", TargetPort: 5858},
+						{Name: "tcp", TargetPort: 6379, Protocol: devfilev1.TCPEndpointProtocol},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := getEndpointsToExpose(devfileParser.DevfileObj{Data: devfileData}, "my-comp", "my-ns", "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []exposedEndpoint{
+		{
+			endpoint: devfilev1.Endpoint{Name: "http", TargetPort: 3000, Path: "api"},
+			name:     "my-comp-http",
+			host:     "http-my-comp-my-ns.example.com",
+		},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(exposedEndpoint{})); diff != "" {
+		t.Errorf("getEndpointsToExpose() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetExposeIngress(t *testing.T) {
+	e := exposedEndpoint{
+		endpoint: devfilev1.Endpoint{Name: "http", TargetPort: 3000, Path: "api"},
+		name:     "my-comp-http",
+		host:     "http-my-comp-my-ns.example.com",
+	}
+	tlsSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-comp-app-tls"}}
+
+	u, err := getExposeIngress(metav1.ObjectMeta{Name: e.name}, e, "my-comp-app", tlsSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got networkingv1.Ingress
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Name != e.name || got.Kind != "Ingress" {
+		t.Errorf("unexpected Ingress %s %s", got.Kind, got.Name)
+	}
+	if len(got.Spec.Rules) != 1 || got.Spec.Rules[0].Host != e.host {
+		t.Fatalf("unexpected rules %v", got.Spec.Rules)
+	}
+	paths := got.Spec.Rules[0].HTTP.Paths
+	if len(paths) != 1 || paths[0].Path != "/api" || paths[0].Backend.Service.Name != "my-comp-app" || paths[0].Backend.Service.Port.Number != 3000 {
+		t.Errorf("unexpected paths %v", paths)
+	}
+	wantTLS := []networkingv1.IngressTLS{{Hosts: []string{e.host}, SecretName: tlsSecret.Name}}
+	if diff := cmp.Diff(wantTLS, got.Spec.TLS); diff != "" {
+		t.Errorf("getExposeIngress() TLS mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetExposeRoute(t *testing.T) {
+	e := exposedEndpoint{
+		endpoint: devfilev1.Endpoint{Name: "http", TargetPort: 3000},
+		name:     "my-comp-http",
+	}
+	tests := []struct {
+		name      string
+		withTLS   bool
+		tlsSecret *corev1.Secret
+		wantTLS   *routev1.TLSConfig
+	}{
+		{
+			name: "without TLS",
+		},
+		{
+			name:    "with TLS and the default certificate of the router",
+			withTLS: true,
+			wantTLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationEdge,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+		{
+			name:    "with TLS and a self-signed certificate",
+			withTLS: true,
+			tlsSecret: &corev1.Secret{
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("cert"),
+					corev1.TLSPrivateKeyKey: []byte("key"),
+				},
+			},
+			wantTLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationEdge,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+				Certificate:                   "cert",
+				Key:                           "key",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := getExposeRoute(metav1.ObjectMeta{Name: e.name}, e, "my-comp-app", tt.withTLS, tt.tlsSecret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got routev1.Route
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Spec.Host != "" || got.Spec.Path != "/" || got.Spec.To.Name != "my-comp-app" || got.Spec.Port.TargetPort.IntValue() != 3000 {
+				t.Errorf("unexpected spec %v", got.Spec)
+			}
+			if diff := cmp.Diff(tt.wantTLS, got.Spec.TLS); diff != "" {
+				t.Errorf("getExposeRoute() TLS mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
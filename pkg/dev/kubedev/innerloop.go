@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -69,6 +70,13 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 
 	var hasRunOrDebugCmd bool
 	innerLoopWithCommands := !parameters.StartOptions.SkipCommands
+	if innerLoopWithCommands && execRequired {
+		err = common.ExecuteTriggeredCommands(ctx, parameters, pod, o.kubernetesClient, o.execClient, o.configAutomountClient, o.filesystem)
+		if err != nil {
+			componentStatus.SetState(watch.StateReady)
+			return err
+		}
+	}
 	if innerLoopWithCommands {
 		var (
 			cmdKind = devfilev1.RunCommandGroupKind
@@ -103,10 +111,12 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 				o.filesystem,
 				image.SelectBackend(ctx),
 				component.HandlerOptions{
-					PodName:           pod.GetName(),
-					ContainersRunning: component.GetContainersNames(pod),
-					Devfile:           parameters.Devfile,
-					Path:              path,
+					PodName:              pod.GetName(),
+					ContainersRunning:    component.GetContainersNames(pod),
+					Devfile:              parameters.Devfile,
+					Path:                 path,
+					RemoteProcessHandler: o.processHandler,
+					LocalCluster:         component.GetLocalCluster(ctx, o.kubernetesClient),
 				},
 			)
 
@@ -131,7 +141,13 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		klog.V(4).Infof("running=%v, execRequired=%v",
 			running, execRequired)
 
-		if isComposite || !running || execRequired {
+		// The run command does not need to be restarted when only sync only files changed, as defined by the "dev.odo.watch.triggers" Devfile attribute
+		skipRestart := parameters.SyncOnly && (running || isComposite) && !podChanged && componentStatus.RunExecuted
+		if skipRestart {
+			klog.V(2).Infof("only sync only files changed, not restarting the %v command", cmdKind)
+		}
+
+		if (isComposite || !running || execRequired) && !skipRestart {
 			// Invoke the build command once (before calling libdevfile.ExecuteCommandByNameAndKind), as, if cmd is a composite command,
 			// the handler we pass will be called for each command in that composite command.
 			doExecuteBuildCommand := func() error {
@@ -175,6 +191,9 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 	if podChanged || o.portsChanged {
 		o.portForwardClient.StopPortForwarding(ctx, componentName)
 	}
+	if podChanged {
+		o.portForwardClient.StopReverseForwarding(ctx, componentName)
+	}
 
 	if innerLoopWithCommands && hasRunOrDebugCmd && len(o.portsToForward) != 0 {
 		// Check that the application is actually listening on the ports declared in the Devfile, so we are sure that port-forwarding will work
@@ -188,12 +207,29 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		}
 	}
 
-	err = o.portForwardClient.StartPortForwarding(ctx, parameters.Devfile, componentName, parameters.StartOptions.Debug, parameters.StartOptions.RandomPorts, log.GetStdout(), parameters.StartOptions.ErrOut, parameters.StartOptions.CustomForwardedPorts, parameters.StartOptions.CustomAddress)
+	// The forwarded ports are displayed once the application responds on them
+	fwOut := log.NewDeferredWriter(log.GetStdout())
+	defer func() {
+		_ = fwOut.Release()
+	}()
+	err = o.portForwardClient.StartPortForwarding(ctx, parameters.Devfile, componentName, parameters.StartOptions.Debug, parameters.StartOptions.RandomPorts, fwOut, parameters.StartOptions.ErrOut, parameters.StartOptions.CustomForwardedPorts, parameters.StartOptions.CustomAddress)
 	if err != nil {
 		return common.NewErrPortForward(err)
 	}
 	componentStatus.EndpointsForwarded = o.portForwardClient.GetForwardedPorts()
 
+	err = o.portForwardClient.StartReverseForwarding(ctx, componentName, parameters.StartOptions.ReverseForwardedPorts, fwOut, parameters.StartOptions.ErrOut)
+	if err != nil {
+		return common.NewErrPortForward(err)
+	}
+
+	if innerLoopWithCommands && hasRunOrDebugCmd {
+		err = o.readinessChecker.Check(ctx, parameters.Devfile, parameters.StartOptions.Debug, o.portForwardClient.GetLocalForwardedPorts(), log.GetStdout(), parameters.StartOptions.ErrOut)
+		if err != nil {
+			return err
+		}
+	}
+
 	componentStatus.SetState(watch.StateReady)
 	return nil
 }
@@ -247,6 +283,10 @@ func (o *DevClient) syncFiles(ctx context.Context, parameters common.PushParamet
 		SyncFolder:    syncFolder,
 	}
 
+	if podChanged || o.syncCompression == sync.CompressionUnknown {
+		o.syncCompression = o.syncClient.GetSupportedCompression(ctx, compInfo)
+	}
+
 	syncParams := sync.SyncParameters{
 		Path:                     path,
 		WatchFiles:               parameters.WatchFiles,
@@ -254,9 +294,12 @@ func (o *DevClient) syncFiles(ctx context.Context, parameters common.PushParamet
 		IgnoredFiles:             parameters.StartOptions.IgnorePaths,
 		DevfileScanIndexForWatch: parameters.DevfileScanIndexForWatch,
 
-		CompInfo:  compInfo,
-		ForcePush: !o.deploymentExists || podChanged,
-		Files:     syncFilesMap,
+		CompInfo:    compInfo,
+		ForcePush:   !o.deploymentExists || podChanged,
+		DeltaSync:   parameters.StartOptions.DeltaSync,
+		ContentHash: envcontext.GetEnvConfig(ctx).OdoSyncContentHash,
+		Compression: o.syncCompression,
+		Files:       syncFilesMap,
 	}
 
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
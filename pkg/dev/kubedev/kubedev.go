@@ -17,6 +17,8 @@ import (
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/watch"
@@ -35,13 +37,24 @@ type DevClient struct {
 	execClient            exec.Client
 	deleteClient          _delete.Client
 	configAutomountClient configAutomount.Client
+	stateClient           state.ComponentClient
 
+	// processHandler starts and supervises the processes of the run and debug commands during the session
+	processHandler remotecmd.RemoteProcessHandler
+	// readinessChecker checks that the application responds on its forwarded endpoints
+	readinessChecker *common.ReadinessChecker
 	// deploymentExists is true when the deployment is already created when calling createComponents
 	deploymentExists bool
 	// portsChanged is true of ports have changed since the last call to createComponents
 	portsChanged bool
 	// portsToForward lists the port to forward during inner loop (TODO move port forward to createComponents)
 	portsToForward map[string][]devfilev1.Endpoint
+	// syncCompression is the compression supported by the container the files are synced to, detected once per pod
+	syncCompression sync.Compression
+	// pulledChecksums are the checksums of the files already handled when pulling files from the container
+	pulledChecksums map[string]string
+	// exposedURLs are the URLs of the endpoints exposed with Ingresses or Routes, displayed when they change
+	exposedURLs []string
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -57,6 +70,7 @@ func NewDevClient(
 	execClient exec.Client,
 	deleteClient _delete.Client,
 	configAutomountClient configAutomount.Client,
+	stateClient state.ComponentClient,
 ) *DevClient {
 	return &DevClient{
 		kubernetesClient:      kubernetesClient,
@@ -69,6 +83,8 @@ func NewDevClient(
 		execClient:            execClient,
 		deleteClient:          deleteClient,
 		configAutomountClient: configAutomountClient,
+		stateClient:           stateClient,
+		readinessChecker:      common.NewReadinessChecker(),
 	}
 }
 
@@ -86,9 +102,14 @@ func (o *DevClient) Start(
 
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
+	o.processHandler = common.NewCommandSupervisor(ctx, o.execClient, o.stateClient, options, func() remotecmd.Backoff {
+		return watch.NewExpBackoff()
+	})
+
 	watchParameters := watch.WatchParameters{
 		StartOptions:        options,
 		DevfileWatchHandler: o.regenerateAdapterAndPush,
+		DevfilePullHandler:  o.pullFiles,
 		WatchCluster:        true,
 	}
 
@@ -97,6 +118,8 @@ func (o *DevClient) Start(
 
 // RegenerateAdapterAndPush get the new devfile and pushes the files to remote pod
 func (o *DevClient) regenerateAdapterAndPush(ctx context.Context, pushParams common.PushParameters, componentStatus *watch.ComponentStatus) error {
+	// The application can stop responding while it is updated
+	o.readinessChecker.Stop()
 
 	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(o.filesystem, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry(), true)
 	if err != nil {
@@ -0,0 +1,28 @@
+package kubedev
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// pullFiles pulls the files generated in the component container under the pull paths into the local directory,
+// and returns the absolute paths of the pulled files
+func (o *DevClient) pullFiles(ctx context.Context, options dev.StartOptions) ([]string, error) {
+	componentName := odocontext.GetComponentName(ctx)
+	if o.pulledChecksums == nil {
+		o.pulledChecksums = make(map[string]string)
+	}
+	return common.PullFiles(ctx, o.syncClient, options, func() (*corev1.Pod, error) {
+		pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get pod for component %s: %w", componentName, err)
+		}
+		return pod, nil
+	}, o.pulledChecksums)
+}
@@ -4,12 +4,50 @@ import (
 	"context"
 	"fmt"
 	"io"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/labels"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 )
 
 func (o *DevClient) CleanupResources(ctx context.Context, out io.Writer) error {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+	)
 	fmt.Printf("Cleaning up resources\n")
 	if o.deployedPod == nil {
 		return nil
 	}
-	return o.podmanClient.CleanupPodResources(o.deployedPod, true)
+	err := o.podmanClient.CleanupPodResources(o.deployedPod, true)
+	if err != nil {
+		return err
+	}
+
+	// Delete the pods running the Kubernetes components in Dev mode
+	pods, err := o.podmanClient.GetPodsMatchingSelector(labels.GetSelector(componentName, appName, labels.ComponentDevMode, false))
+	if err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		if pods.Items[i].GetName() == o.deployedPod.GetName() {
+			continue
+		}
+		err = o.podmanClient.CleanupPodResources(&pods.Items[i], true)
+		if err != nil {
+			return err
+		}
+	}
+
+	network, err := component.GetPodmanNetworkName(componentName, appName)
+	if err != nil {
+		return err
+	}
+	// The network is still used if the component is also running in Deploy mode
+	if err = o.podmanClient.NetworkRm(network); err != nil {
+		klog.V(4).Infof("unable to delete network %q: %v", network, err)
+	}
+	return nil
 }
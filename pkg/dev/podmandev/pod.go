@@ -18,7 +18,9 @@ import (
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/storage"
 	"github.com/redhat-developer/odo/pkg/util"
 
@@ -44,6 +46,33 @@ func (o *DevClient) createPodFromComponent(
 	usedPorts []int,
 	customAddress string,
 	devfileObj parser.DevfileObj,
+) (*corev1.Pod, []api.ForwardedPort, error) {
+	podmanCaps, err := o.podmanClient.GetCapabilities()
+	if err != nil {
+		return nil, nil, err
+	}
+	return CreatePodFromComponent(ctx, podmanCaps, debug, buildCommand, runCommand, debugCommand, withHelperContainer,
+		randomPorts, customForwardedPorts, usedPorts, customAddress, devfileObj, true)
+}
+
+// CreatePodFromComponent returns the Pod running the containers of the Devfile in Dev mode, for a Podman
+// with the given capabilities, and the ports of the containers forwarded to the local machine.
+// If overrideEntrypoints is true, the containers running the build, run or debug commands without any command or args
+// in the Devfile are kept running with `tail -f /dev/null`, as the commands are executed into the containers by odo.
+func CreatePodFromComponent(
+	ctx context.Context,
+	podmanCaps podman.Capabilities,
+	debug bool,
+	buildCommand string,
+	runCommand string,
+	debugCommand string,
+	withHelperContainer bool,
+	randomPorts bool,
+	customForwardedPorts []api.ForwardedPort,
+	usedPorts []int,
+	customAddress string,
+	devfileObj parser.DevfileObj,
+	overrideEntrypoints bool,
 ) (*corev1.Pod, []api.ForwardedPort, error) {
 	var (
 		appName       = odocontext.GetApplication(ctx)
@@ -56,11 +85,6 @@ func (o *DevClient) createPodFromComponent(
 		return nil, nil, err
 	}
 
-	podmanCaps, err := o.podmanClient.GetCapabilities()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	if !podmanCaps.Cgroupv2 {
 		for i := range podTemplate.Spec.Containers {
 			delete(podTemplate.Spec.Containers[i].Resources.Limits, corev1.ResourceMemory)
@@ -77,6 +101,12 @@ func (o *DevClient) createPodFromComponent(
 	if err != nil {
 		return nil, nil, err
 	}
+	// The pod is run by Podman, or by Docker when the platform is docker
+	if platform := fcontext.GetPlatform(ctx, commonflags.PlatformPodman); platform != commonflags.PlatformPodman {
+		for i := range fwPorts {
+			fwPorts[i].Platform = platform
+		}
+	}
 
 	utils.AddOdoProjectVolume(containers)
 	utils.AddOdoMandatoryVolume(containers)
@@ -120,9 +150,11 @@ func (o *DevClient) createPodFromComponent(
 		}
 	}
 
-	containers, err = utils.UpdateContainersEntrypointsIfNeeded(devfileObj, containers, buildCommand, runCommand, debugCommand)
-	if err != nil {
-		return nil, nil, err
+	if overrideEntrypoints {
+		containers, err = utils.UpdateContainersEntrypointsIfNeeded(devfileObj, containers, buildCommand, runCommand, debugCommand)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	containers = addHostPorts(withHelperContainer, containers, fwPorts, customAddress)
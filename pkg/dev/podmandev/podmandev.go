@@ -8,6 +8,7 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
 
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile"
@@ -19,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -35,11 +37,20 @@ type DevClient struct {
 	portForwardClient portForward.Client
 	syncClient        sync.Client
 	execClient        exec.Client
-	stateClient       state.Client
+	stateClient       state.ComponentClient
 	watchClient       watch.Client
 
+	// processHandler starts and supervises the processes of the run and debug commands during the session
+	processHandler remotecmd.RemoteProcessHandler
+	// readinessChecker checks that the application responds on its forwarded endpoints
+	readinessChecker *common.ReadinessChecker
+
 	deployedPod *corev1.Pod
 	usedPorts   []int
+	// syncCompression is the compression supported by the container the files are synced to, detected once per pod
+	syncCompression sync.Compression
+	// pulledChecksums are the checksums of the files already handled when pulling files from the container
+	pulledChecksums map[string]string
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -51,7 +62,7 @@ func NewDevClient(
 	portForwardClient portForward.Client,
 	syncClient sync.Client,
 	execClient exec.Client,
-	stateClient state.Client,
+	stateClient state.ComponentClient,
 	watchClient watch.Client,
 ) *DevClient {
 	return &DevClient{
@@ -63,6 +74,7 @@ func NewDevClient(
 		execClient:        execClient,
 		stateClient:       stateClient,
 		watchClient:       watchClient,
+		readinessChecker:  common.NewReadinessChecker(),
 	}
 }
 
@@ -80,9 +92,14 @@ func (o *DevClient) Start(
 
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
 
+	o.processHandler = common.NewCommandSupervisor(ctx, o.execClient, o.stateClient, options, func() remotecmd.Backoff {
+		return watch.NewExpBackoff()
+	})
+
 	watchParameters := watch.WatchParameters{
 		StartOptions:        options,
 		DevfileWatchHandler: o.watchHandler,
+		DevfilePullHandler:  o.pullFiles,
 		WatchCluster:        false,
 	}
 
@@ -90,7 +107,7 @@ func (o *DevClient) Start(
 }
 
 // syncFiles syncs the local source files in path into the pod's source volume
-func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod *corev1.Pod, path string) (bool, error) {
+func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod *corev1.Pod, path string, podChanged bool) (bool, error) {
 	var (
 		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
 		componentName = odocontext.GetComponentName(ctx)
@@ -134,6 +151,10 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		}
 	}
 
+	if podChanged || o.syncCompression == sync.CompressionUnknown {
+		o.syncCompression = o.syncClient.GetSupportedCompression(ctx, compInfo)
+	}
+
 	syncParams := sync.SyncParameters{
 		Path:                     path,
 		WatchFiles:               nil,
@@ -141,9 +162,13 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		IgnoredFiles:             options.IgnorePaths,
 		DevfileScanIndexForWatch: true,
 
-		CompInfo:  compInfo,
-		ForcePush: true,
-		Files:     syncFilesMap,
+		CompInfo: compInfo,
+		// All files are pushed again, unless delta sync is enabled and the files are still present in the pod
+		ForcePush:   !options.DeltaSync || podChanged,
+		DeltaSync:   options.DeltaSync,
+		ContentHash: envcontext.GetEnvConfig(ctx).OdoSyncContentHash,
+		Compression: o.syncCompression,
+		Files:       syncFilesMap,
 	}
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
 	if err != nil {
@@ -173,6 +198,8 @@ func (o *DevClient) checkVolumesFree(pod *corev1.Pod) error {
 }
 
 func (o *DevClient) watchHandler(ctx context.Context, pushParams common.PushParameters, componentStatus *watch.ComponentStatus) error {
+	// The application can stop responding while it is updated
+	o.readinessChecker.Stop()
 
 	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(o.fs, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry(), true)
 	if err != nil {
@@ -0,0 +1,21 @@
+package podmandev
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+)
+
+// pullFiles pulls the files generated in the component container under the pull paths into the local directory,
+// and returns the absolute paths of the pulled files
+func (o *DevClient) pullFiles(ctx context.Context, options dev.StartOptions) ([]string, error) {
+	if o.pulledChecksums == nil {
+		o.pulledChecksums = make(map[string]string)
+	}
+	return common.PullFiles(ctx, o.syncClient, options, func() (*corev1.Pod, error) {
+		return o.deployedPod, nil
+	}, o.pulledChecksums)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
+	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
@@ -42,21 +43,25 @@ func (o *DevClient) reconcile(
 		devfileObj    = parameters.Devfile
 	)
 
-	o.warnAboutK8sComponents(devfileObj)
-
 	err := o.buildPushAutoImageComponents(ctx, devfileObj)
 	if err != nil {
 		return err
 	}
 
+	err = o.applyK8sComponents(ctx, devfileObj, path)
+	if err != nil {
+		return err
+	}
+
 	pod, fwPorts, err := o.deployPod(ctx, options, devfileObj)
 	if err != nil {
 		return err
 	}
+	podChanged := pod != o.deployedPod
 	o.deployedPod = pod
 	componentStatus.SetState(watch.StateReady)
 
-	execRequired, err := o.syncFiles(ctx, options, pod, path)
+	execRequired, err := o.syncFiles(ctx, options, pod, path, podChanged)
 	if err != nil {
 		return err
 	}
@@ -86,8 +91,19 @@ func (o *DevClient) reconcile(
 
 	innerLoopWithCommands := !parameters.StartOptions.SkipCommands
 	var hasRunOrDebugCmd bool
+	if innerLoopWithCommands && execRequired {
+		err = common.ExecuteTriggeredCommands(ctx, parameters, pod, o.podmanClient, o.execClient, nil, o.fs)
+		if err != nil {
+			return err
+		}
+	}
 	if innerLoopWithCommands {
-		if execRequired {
+		// The run command does not need to be restarted when only sync only files changed, as defined by the "dev.odo.watch.triggers" Devfile attribute
+		skipRestart := parameters.SyncOnly && !podChanged && componentStatus.RunExecuted
+		if skipRestart {
+			klog.V(2).Infof("only sync only files changed, not restarting the run command")
+		}
+		if execRequired && !skipRestart {
 			doExecuteBuildCommand := func() error {
 				execHandler := component.NewRunHandler(
 					ctx,
@@ -132,11 +148,13 @@ func (o *DevClient) reconcile(
 					o.fs,
 					image.SelectBackend(ctx),
 
-					// TODO(feloy) set to deploy Kubernetes/Openshift components
 					component.HandlerOptions{
-						PodName:           pod.Name,
-						ComponentExists:   componentStatus.RunExecuted,
-						ContainersRunning: component.GetContainersNames(pod),
+						PodName:              pod.Name,
+						ComponentExists:      componentStatus.RunExecuted,
+						ContainersRunning:    component.GetContainersNames(pod),
+						RemoteProcessHandler: o.processHandler,
+						Devfile:              devfileObj,
+						Path:                 path,
 					},
 				)
 				err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
@@ -182,6 +200,14 @@ func (o *DevClient) reconcile(
 		}
 	} // else port-forwarding is done via the main container ports in the pod spec
 
+	// The forwarded ports are displayed once the application responds on them
+	if innerLoopWithCommands && hasRunOrDebugCmd {
+		err = o.readinessChecker.Check(ctx, devfileObj, options.Debug, fwPorts, options.Out, options.ErrOut)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, fwPort := range fwPorts {
 		s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
 		fmt.Fprintf(options.Out, " -  %s", log.SboldColor(color.FgGreen, s))
@@ -195,21 +221,60 @@ func (o *DevClient) reconcile(
 	return nil
 }
 
-// warnAboutApplyComponents prints a warning if the Devfile contains standalone K8s components (not referenced by any Apply commands). These resources are currently applied when running in the cluster mode, but not on Podman.
-func (o *DevClient) warnAboutK8sComponents(devfileObj parser.DevfileObj) {
-	var components []string
-	// get all standalone k8s components for a given commandGK
-	k8sComponents, _ := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
-
-	if len(k8sComponents) == 0 {
-		return
+// applyK8sComponents runs on Podman the workloads defined by the standalone Kubernetes and OpenShift components
+// (not referenced by any Apply commands), in pods connected to the network of the component.
+// The pods of the workloads not defined anymore by the Devfile are deleted.
+func (o *DevClient) applyK8sComponents(ctx context.Context, devfileObj parser.DevfileObj, path string) error {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+	)
+	k8sComponents, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
+	if err != nil {
+		return err
 	}
 
-	for _, comp := range k8sComponents {
-		components = append(components, comp.Name)
+	podNames := make(map[string]bool)
+	if len(k8sComponents) != 0 {
+		// The images built locally are available to Podman
+		localImages, err := image.GetImageNames(devfileObj)
+		if err != nil {
+			return err
+		}
+		for _, c := range k8sComponents {
+			names, err := component.ApplyKubernetesOnPodman(labels.ComponentDevMode, appName, componentName, devfileObj, c, o.podmanClient, path, localImages)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				podNames[name] = true
+			}
+		}
 	}
+	return o.deleteStaleK8sPods(appName, componentName, podNames)
+}
 
-	log.Warningf("Kubernetes components are not supported on Podman. Skipping: %v.", strings.Join(components, ", "))
+// deleteStaleK8sPods deletes the pods created in Dev mode from Kubernetes and OpenShift components, other than the pods in podNames.
+// The volumes of the pods are kept.
+func (o *DevClient) deleteStaleK8sPods(appName string, componentName string, podNames map[string]bool) error {
+	pods, err := o.podmanClient.GetAllResourcesFromSelector(labels.GetSelector(componentName, appName, labels.ComponentDevMode, false), "")
+	if err != nil {
+		return err
+	}
+	for _, p := range pods {
+		// Only the pods created from Kubernetes components have a spec hash
+		if labels.GetSpecHash(p.GetLabels()) == "" || podNames[p.GetName()] {
+			continue
+		}
+		klog.V(2).Infof("deleting pod %q, not defined anymore by the Kubernetes components of the Devfile", p.GetName())
+		var pod corev1.Pod
+		pod.SetName(p.GetName())
+		err = o.podmanClient.CleanupPodResources(&pod, false)
+		if err != nil {
+			return fmt.Errorf("unable to delete the pod %q: %w", p.GetName(), err)
+		}
+	}
+	return nil
 }
 
 func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj parser.DevfileObj) error {
@@ -219,7 +284,7 @@ func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj
 	}
 
 	for _, c := range components {
-		err = image.BuildPushSpecificImage(ctx, image.SelectBackend(ctx), o.fs, c, envcontext.GetEnvConfig(ctx).PushImages)
+		err = image.BuildPushSpecificImage(ctx, image.SelectBackend(ctx), o.fs, c, envcontext.GetEnvConfig(ctx).PushImages, nil)
 		if err != nil {
 			return err
 		}
@@ -270,7 +335,17 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		}
 	}
 
-	err = o.podmanClient.PlayKube(pod)
+	// The pod shares a network with the pods running the Kubernetes components, to reach them with the names of their Services
+	network, err := component.GetPodmanNetworkName(odocontext.GetComponentName(ctx), odocontext.GetApplication(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	err = o.podmanClient.NetworkCreate(network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = o.podmanClient.PlayKubeOnNetwork(pod, network, nil)
 	if err != nil {
 		// there are cases when pod is created even if there is an error with the pod def; for e.g. incorrect image
 		if podMap, _ := o.podmanClient.PodLs(); podMap[pod.Name] {
@@ -0,0 +1,47 @@
+package podmandev
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+func TestDevClient_deleteStaleK8sPods(t *testing.T) {
+	getPod := func(name string, hash string) unstructured.Unstructured {
+		podLabels := labels.GetLabels("mycmp", "app", "", labels.ComponentDevMode, hash == "")
+		if hash != "" {
+			labels.SetSpecHash(podLabels, hash)
+		}
+		var u unstructured.Unstructured
+		u.SetName(name)
+		u.SetLabels(podLabels)
+		return u
+	}
+
+	ctrl := gomock.NewController(t)
+	podmanClient := podman.NewMockClient(ctrl)
+	podmanClient.EXPECT().GetAllResourcesFromSelector(labels.GetSelector("mycmp", "app", labels.ComponentDevMode, false), "").
+		Return([]unstructured.Unstructured{
+			// The pod of the component, without spec hash, is kept
+			getPod("mycmp-app", ""),
+			getPod("postgres", "hash1"),
+			getPod("redis", "hash2"),
+		}, nil)
+	podmanClient.EXPECT().CleanupPodResources(gomock.Any(), false).DoAndReturn(func(pod *corev1.Pod, _ bool) error {
+		if pod.GetName() != "redis" {
+			t.Errorf("unexpected deletion of pod %q", pod.GetName())
+		}
+		return nil
+	})
+
+	o := &DevClient{podmanClient: podmanClient}
+	err := o.deleteStaleK8sPods("app", "mycmp", map[string]bool{"postgres": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -173,6 +173,63 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 	return nil
 }
 
+// Load an image into a local cluster, by saving it into an archive using a Docker compatible CLI,
+// and by loading this archive with the tool managing the cluster
+func (o *DockerCompatibleBackend) Load(image string, cluster LocalCluster) error {
+
+	// We use a "No Spin" since we are outputting to stdout / stderr
+	loadSpinner := log.SpinnerNoSpin(fmt.Sprintf("Loading image into the %s cluster", cluster))
+	defer loadSpinner.End(false)
+
+	archive, err := os.CreateTemp("", "odo_*.tar")
+	if err != nil {
+		return err
+	}
+	archivePath := archive.Name()
+	defer func() {
+		if e := os.Remove(archivePath); e != nil {
+			klog.V(3).Infof("could not remove temporary image archive at path %q: %v", archivePath, e)
+		}
+	}()
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+
+	// Set all output as italic when doing a load, then return to normal at the end
+	color.Set(color.Italic)
+	defer color.Unset()
+
+	saveCmd := make([]string, 0, len(o.globalExtraArgs)+5)
+	saveCmd = append(saveCmd, o.name)
+	saveCmd = append(saveCmd, o.globalExtraArgs...)
+	saveCmd = append(saveCmd, "save", "--output", archivePath, image)
+	err = runCommand(saveCmd)
+	if err != nil {
+		return err
+	}
+	err = runCommand(cluster.getLoadCommand(archivePath))
+	if err != nil {
+		return err
+	}
+
+	loadSpinner.End(true)
+	return nil
+}
+
+// runCommand runs the command, displaying its outputs
+func runCommand(shellCmd []string) error {
+	klog.V(4).Infof("Running command: %v", shellCmd)
+	cmd := exec.Command(shellCmd[0], shellCmd[1:]...)
+	cmd.Stdout = log.GetStdout()
+	cmd.Stderr = log.GetStderr()
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("error running %s command: %w", shellCmd[0], err)
+	}
+	return nil
+}
+
 // String return the name of the docker compatible CLI used
 func (o *DockerCompatibleBackend) String() string {
 	return o.name
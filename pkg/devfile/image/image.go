@@ -24,6 +24,8 @@ type Backend interface {
 	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error
 	// Push the image to its registry as defined in the devfile
 	Push(image string) error
+	// Load the image into a cluster running on the local machine, instead of pushing it to a registry
+	Load(image string, cluster LocalCluster) error
 	// Return the name of the backend
 	String() string
 }
@@ -56,7 +58,7 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 	}
 
 	for _, component := range components {
-		err = buildPushImage(backend, fs, component.Image, path, push)
+		err = buildPushImage(backend, fs, component.Image, path, push, nil)
 		if err != nil {
 			return err
 		}
@@ -65,8 +67,8 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 }
 
 // BuildPushSpecificImage build an image defined in the devfile present in devfilePath
-// If push is true, also push the image to its registry
-func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.Filesystem, component devfile.Component, push bool) error {
+// If push is true, also push the image to its registry, or load it into the cluster if cluster is not nil
+func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.Filesystem, component devfile.Component, push bool, cluster *LocalCluster) error {
 	var (
 		devfilePath = odocontext.GetDevfilePath(ctx)
 		path        = filepath.Dir(devfilePath)
@@ -78,19 +80,22 @@ func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.
 		//revive:enable:error-strings
 	}
 
-	return buildPushImage(backend, fs, component.Image, path, push)
+	return buildPushImage(backend, fs, component.Image, path, push, cluster)
 }
 
 // buildPushImage build an image using the provided backend
-// If push is true, also push the image to its registry
-func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
+// If push is true, also push the image to its registry, or load it into the cluster if cluster is not nil
+func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool, cluster *LocalCluster) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
 	var msg string
-	if push {
+	switch {
+	case push && cluster != nil:
+		msg = "Building & Loading Image: %s"
+	case push:
 		msg = "Building & Pushing Image: %s"
-	} else {
+	default:
 		msg = "Building Image: %s"
 	}
 	log.Sectionf(msg, image.ImageName)
@@ -98,6 +103,9 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 	if err != nil {
 		return err
 	}
+	if push && cluster != nil {
+		return backend.Load(image.ImageName, *cluster)
+	}
 	if push {
 		err = backend.Push(image.ImageName)
 		if err != nil {
@@ -21,11 +21,13 @@ func TestBuildPushImage(t *testing.T) {
 		devfilePath     string
 		image           *devfile.ImageComponent
 		push            bool
+		cluster         *LocalCluster
 		BuildReturns    error
 		PushReturns     error
 		wantErr         bool
 		wantBuildCalled bool
 		wantPushCalled  bool
+		wantLoadCalled  bool
 	}{
 		{
 			name:            "nil image and no push should return an error",
@@ -65,6 +67,34 @@ func TestBuildPushImage(t *testing.T) {
 			wantBuildCalled: true,
 			wantPushCalled:  true,
 		},
+		{
+			name: "image and push with a local cluster should call Build and Load",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "a name",
+				},
+			},
+			push:            true,
+			cluster:         &LocalCluster{Flavor: KindCluster, Name: "kind"},
+			wantErr:         false,
+			wantBuildCalled: true,
+			wantPushCalled:  false,
+			wantLoadCalled:  true,
+		},
+		{
+			name: "image and no push with a local cluster should call Build and not Load",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "a name",
+				},
+			},
+			push:            false,
+			cluster:         &LocalCluster{Flavor: KindCluster, Name: "kind"},
+			wantErr:         false,
+			wantBuildCalled: true,
+			wantPushCalled:  false,
+			wantLoadCalled:  false,
+		},
 		{
 			name: "Build returns err",
 			image: &devfile.ImageComponent{
@@ -109,7 +139,12 @@ func TestBuildPushImage(t *testing.T) {
 			} else {
 				backend.EXPECT().Push(nil).Times(0)
 			}
-			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push)
+			if tt.wantLoadCalled {
+				backend.EXPECT().Load(tt.image.ImageName, *tt.cluster).Return(nil).Times(1)
+			} else {
+				backend.EXPECT().Load(gomock.Any(), gomock.Any()).Times(0)
+			}
+			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push, tt.cluster)
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
@@ -0,0 +1,215 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+)
+
+// LocalClusterFlavor is the tool managing a cluster running on the local machine
+type LocalClusterFlavor string
+
+const (
+	KindCluster           LocalClusterFlavor = "kind"
+	MinikubeCluster       LocalClusterFlavor = "minikube"
+	K3dCluster            LocalClusterFlavor = "k3d"
+	DockerDesktopCluster  LocalClusterFlavor = "docker-desktop"
+	RancherDesktopCluster LocalClusterFlavor = "rancher-desktop"
+)
+
+// minikubeProvider is the provider set by minikube in the extensions of the contexts it creates
+const minikubeProvider = "minikube.sigs.k8s.io"
+
+// LocalCluster is a cluster running on the local machine, into which the images built locally can be loaded
+// instead of being pushed to a registry
+type LocalCluster struct {
+	Flavor LocalClusterFlavor
+	// Name of the cluster for the tool managing it (kind cluster, minikube profile or k3d cluster).
+	// It is empty for Docker Desktop and Rancher Desktop, managing a single cluster.
+	Name string
+}
+
+func (o LocalCluster) String() string {
+	if o.Name == "" {
+		return string(o.Flavor)
+	}
+	return fmt.Sprintf("%s %q", o.Flavor, o.Name)
+}
+
+// getLoadCommand returns the command loading the images of the archive into the cluster
+func (o LocalCluster) getLoadCommand(archive string) []string {
+	switch o.Flavor {
+	case KindCluster:
+		return []string{"kind", "load", "image-archive", archive, "--name", o.Name}
+	case MinikubeCluster:
+		return []string{"minikube", "image", "load", archive, "--profile", o.Name}
+	case K3dCluster:
+		return []string{"k3d", "image", "import", archive, "--cluster", o.Name}
+	case DockerDesktopCluster:
+		// The cluster of Docker Desktop uses the images of the Docker daemon
+		return []string{"docker", "load", "--input", archive}
+	case RancherDesktopCluster:
+		// The cluster of Rancher Desktop uses the images of the k8s.io namespace of containerd
+		return []string{"nerdctl", "--namespace", "k8s.io", "load", "--input", archive}
+	}
+	return nil
+}
+
+// SelectLocalCluster returns the local cluster targeted by the current context of kubeConfig,
+// or nil if the cluster is not running on the local machine, or if loading images is disabled with ODO_LOAD_IMAGES
+func SelectLocalCluster(ctx context.Context, kubeConfig clientcmd.ClientConfig) *LocalCluster {
+	if kubeConfig == nil || !envcontext.GetEnvConfig(ctx).LoadImages {
+		return nil
+	}
+	rawConfig, err := kubeConfig.RawConfig()
+	if err != nil {
+		klog.V(4).Infof("unable to read the kubeconfig to detect a local cluster: %v", err)
+		return nil
+	}
+	cluster := getLocalCluster(rawConfig)
+	if cluster != nil {
+		klog.V(4).Infof("the current context %q targets the local cluster %s", rawConfig.CurrentContext, cluster)
+	}
+	return cluster
+}
+
+// getLocalCluster detects the local cluster from the name of the current context of the kubeconfig, as defined by the tools creating them,
+// or, for minikube, from the extensions of the context
+func getLocalCluster(config clientcmdapi.Config) *LocalCluster {
+	contextName := config.CurrentContext
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok || kubeContext == nil {
+		return nil
+	}
+	switch {
+	case strings.HasPrefix(contextName, "kind-"):
+		return &LocalCluster{Flavor: KindCluster, Name: strings.TrimPrefix(contextName, "kind-")}
+	case strings.HasPrefix(contextName, "k3d-"):
+		return &LocalCluster{Flavor: K3dCluster, Name: strings.TrimPrefix(contextName, "k3d-")}
+	case contextName == "docker-desktop":
+		return &LocalCluster{Flavor: DockerDesktopCluster}
+	case contextName == "rancher-desktop":
+		return &LocalCluster{Flavor: RancherDesktopCluster}
+	case contextName == "minikube" || isMinikubeContext(kubeContext):
+		// minikube names the context after the profile
+		return &LocalCluster{Flavor: MinikubeCluster, Name: contextName}
+	}
+	return nil
+}
+
+func isMinikubeContext(kubeContext *clientcmdapi.Context) bool {
+	extension, ok := kubeContext.Extensions["context_info"].(*runtime.Unknown)
+	if !ok {
+		return false
+	}
+	var info struct {
+		Provider string `json:"provider"`
+	}
+	if err := json.Unmarshal(extension.Raw, &info); err != nil {
+		return false
+	}
+	return info.Provider == minikubeProvider
+}
+
+// GetLoadedImages returns the names of the images built from the Image components of the devfile,
+// when they are loaded into the local cluster; nil is returned if cluster is nil.
+func GetLoadedImages(devfileObj parser.DevfileObj, cluster *LocalCluster) ([]string, error) {
+	if cluster == nil {
+		return nil, nil
+	}
+	return GetImageNames(devfileObj)
+}
+
+// GetImageNames returns the names of the images built from the Image components of the devfile
+func GetImageNames(devfileObj parser.DevfileObj) ([]string, error) {
+	if devfileObj.Data == nil {
+		return nil, nil
+	}
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: devfile.ImageComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	images := make([]string, 0, len(components))
+	for _, component := range components {
+		if component.Image != nil {
+			images = append(images, component.Image.ImageName)
+		}
+	}
+	return images, nil
+}
+
+// SetContainersPullPolicy sets the pull policy of the containers using one of the loaded images to IfNotPresent,
+// as these images cannot be pulled from a registry
+func SetContainersPullPolicy(containers []corev1.Container, loadedImages []string) {
+	for i := range containers {
+		if isLoadedImage(containers[i].Image, loadedImages) {
+			containers[i].ImagePullPolicy = corev1.PullIfNotPresent
+		}
+	}
+}
+
+// SetPullPolicy sets the pull policy of the containers defined in the resource (at any level, to support Pods, workloads and CronJobs)
+// using one of the loaded images to IfNotPresent
+func SetPullPolicy(u *unstructured.Unstructured, loadedImages []string) {
+	if len(loadedImages) == 0 {
+		return
+	}
+	setPullPolicy(u.Object, loadedImages)
+}
+
+func setPullPolicy(object map[string]interface{}, loadedImages []string) {
+	for key, value := range object {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			setPullPolicy(value, loadedImages)
+		case []interface{}:
+			isContainersList := key == "containers" || key == "initContainers"
+			for _, item := range value {
+				item, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if image, ok := item["image"].(string); ok && isContainersList && isLoadedImage(image, loadedImages) {
+					item["imagePullPolicy"] = string(corev1.PullIfNotPresent)
+					continue
+				}
+				setPullPolicy(item, loadedImages)
+			}
+		}
+	}
+}
+
+// isLoadedImage returns true if image is one of the loaded images, an image without tag being an image with the latest tag
+func isLoadedImage(image string, loadedImages []string) bool {
+	for _, loaded := range loadedImages {
+		if withDefaultTag(image) == withDefaultTag(loaded) {
+			return true
+		}
+	}
+	return false
+}
+
+func withDefaultTag(image string) string {
+	if strings.Contains(image, "@") {
+		return image
+	}
+	if strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+		return image
+	}
+	return image + ":latest"
+}
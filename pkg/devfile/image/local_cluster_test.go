@@ -0,0 +1,176 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestGetLocalCluster(t *testing.T) {
+	tests := []struct {
+		name          string
+		contextName   string
+		kubeContext   *clientcmdapi.Context
+		wantCluster   *LocalCluster
+		wantNoContext bool
+	}{
+		{
+			name:        "kind cluster",
+			contextName: "kind-dev",
+			wantCluster: &LocalCluster{Flavor: KindCluster, Name: "dev"},
+		},
+		{
+			name:        "k3d cluster",
+			contextName: "k3d-k3s-default",
+			wantCluster: &LocalCluster{Flavor: K3dCluster, Name: "k3s-default"},
+		},
+		{
+			name:        "default minikube profile",
+			contextName: "minikube",
+			wantCluster: &LocalCluster{Flavor: MinikubeCluster, Name: "minikube"},
+		},
+		{
+			name:        "minikube profile detected from the context extension",
+			contextName: "my-profile",
+			kubeContext: &clientcmdapi.Context{
+				Cluster: "my-profile",
+				Extensions: map[string]runtime.Object{
+					"context_info": &runtime.Unknown{Raw: []byte(`{"provider":"minikube.sigs.k8s.io","version":"v1.30.1"}`)},
+				},
+			},
+			wantCluster: &LocalCluster{Flavor: MinikubeCluster, Name: "my-profile"},
+		},
+		{
+			name:        "Docker Desktop",
+			contextName: "docker-desktop",
+			wantCluster: &LocalCluster{Flavor: DockerDesktopCluster},
+		},
+		{
+			name:        "Rancher Desktop",
+			contextName: "rancher-desktop",
+			wantCluster: &LocalCluster{Flavor: RancherDesktopCluster},
+		},
+		{
+			name:        "remote cluster",
+			contextName: "default/api-cluster-example-com:6443/developer",
+		},
+		{
+			name:          "current context not defined",
+			contextName:   "kind-dev",
+			wantNoContext: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := clientcmdapi.Config{
+				CurrentContext: tt.contextName,
+				Contexts:       map[string]*clientcmdapi.Context{},
+			}
+			if !tt.wantNoContext {
+				kubeContext := tt.kubeContext
+				if kubeContext == nil {
+					kubeContext = &clientcmdapi.Context{Cluster: tt.contextName}
+				}
+				config.Contexts[tt.contextName] = kubeContext
+			}
+			got := getLocalCluster(config)
+			if diff := cmp.Diff(tt.wantCluster, got); diff != "" {
+				t.Errorf("getLocalCluster() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalCluster_getLoadCommand(t *testing.T) {
+	tests := []struct {
+		cluster LocalCluster
+		want    []string
+	}{
+		{
+			cluster: LocalCluster{Flavor: KindCluster, Name: "dev"},
+			want:    []string{"kind", "load", "image-archive", "/tmp/image.tar", "--name", "dev"},
+		},
+		{
+			cluster: LocalCluster{Flavor: MinikubeCluster, Name: "minikube"},
+			want:    []string{"minikube", "image", "load", "/tmp/image.tar", "--profile", "minikube"},
+		},
+		{
+			cluster: LocalCluster{Flavor: K3dCluster, Name: "k3s-default"},
+			want:    []string{"k3d", "image", "import", "/tmp/image.tar", "--cluster", "k3s-default"},
+		},
+		{
+			cluster: LocalCluster{Flavor: DockerDesktopCluster},
+			want:    []string{"docker", "load", "--input", "/tmp/image.tar"},
+		},
+		{
+			cluster: LocalCluster{Flavor: RancherDesktopCluster},
+			want:    []string{"nerdctl", "--namespace", "k8s.io", "load", "--input", "/tmp/image.tar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cluster.String(), func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.cluster.getLoadCommand("/tmp/image.tar")); diff != "" {
+				t.Errorf("getLoadCommand() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetPullPolicy(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"spec": map[string]interface{}{
+			"jobTemplate": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"initContainers": []interface{}{
+								map[string]interface{}{"name": "init", "image": "quay.io/me/init:v1"},
+							},
+							"containers": []interface{}{
+								map[string]interface{}{"name": "main", "image": "quay.io/me/app:latest", "imagePullPolicy": "Always"},
+								map[string]interface{}{"name": "sidecar", "image": "busybox"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+	SetPullPolicy(&u, []string{"quay.io/me/app", "quay.io/me/init:v1"})
+
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+	initContainers, _, _ := unstructured.NestedSlice(u.Object, "spec", "jobTemplate", "spec", "template", "spec", "initContainers")
+	for _, check := range []struct {
+		container interface{}
+		want      interface{}
+	}{
+		{container: initContainers[0], want: "IfNotPresent"},
+		{container: containers[0], want: "IfNotPresent"},
+		{container: containers[1], want: nil},
+	} {
+		container := check.container.(map[string]interface{})
+		if got := container["imagePullPolicy"]; got != check.want {
+			t.Errorf("pull policy of container %v should be %v but is %v", container["name"], check.want, got)
+		}
+	}
+}
+
+func TestSetContainersPullPolicy(t *testing.T) {
+	containers := []corev1.Container{
+		{Name: "runtime", Image: "localhost/my-app"},
+		{Name: "db", Image: "postgres:15"},
+	}
+	SetContainersPullPolicy(containers, []string{"localhost/my-app:latest"})
+	if containers[0].ImagePullPolicy != corev1.PullIfNotPresent {
+		t.Errorf("pull policy of container runtime should be %q but is %q", corev1.PullIfNotPresent, containers[0].ImagePullPolicy)
+	}
+	if containers[1].ImagePullPolicy != "" {
+		t.Errorf("pull policy of container db should not be set, but is %q", containers[1].ImagePullPolicy)
+	}
+}
@@ -49,6 +49,20 @@ func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), fs, image, devfilePath)
 }
 
+// Load mocks base method.
+func (m *MockBackend) Load(image string, cluster LocalCluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", image, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockBackendMockRecorder) Load(image, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockBackend)(nil).Load), image, cluster)
+}
+
 // Push mocks base method.
 func (m *MockBackend) Push(image string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,28 @@
+package docker
+
+import (
+	"encoding/json"
+
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// dockerInfo contains the fields used by odo from the result of the `docker info` command
+type dockerInfo struct {
+	CgroupVersion string `json:"CgroupVersion"`
+}
+
+func (o *DockerCli) GetCapabilities() (podman.Capabilities, error) {
+	var result podman.Capabilities
+	out, err := o.output("info", "--format", "{{json .}}")
+	if err != nil {
+		return podman.Capabilities{}, err
+	}
+	var info dockerInfo
+	if err = json.Unmarshal(out, &info); err != nil {
+		return podman.Capabilities{}, err
+	}
+	if info.CgroupVersion == "2" {
+		result.Cgroupv2 = true
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+func (o *DockerCli) ListAllComponents() ([]api.ComponentAbstract, error) {
+	pods, err := o.getPodsFromSelector("")
+	if err != nil {
+		return nil, err
+	}
+
+	var components []api.ComponentAbstract
+
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		labels := pod.GetLabels()
+
+		// Figure out the correct name to use
+		// if there is no instance label (app.kubernetes.io/instance),
+		// we SKIP the resource as it is not a component essential for Kubernetes.
+		name := odolabels.GetComponentName(labels)
+		if name == "" {
+			continue
+		}
+
+		// Get the component type (if there is any..)
+		componentType, err := odolabels.GetProjectType(labels, nil)
+		if err != nil || componentType == "" {
+			componentType = api.TypeUnknown
+		}
+
+		// Generate the appropriate "component" with all necessary information
+		component := api.ComponentAbstract{
+			Name:             name,
+			ManagedBy:        odolabels.GetManagedBy(labels),
+			Type:             componentType,
+			ManagedByVersion: odolabels.GetManagedByVersion(labels),
+			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
+			RunningOn: commonflags.PlatformDocker,
+			Platform:  commonflags.PlatformDocker,
+		}
+		components = podman.AppendComponent(components, component, odolabels.GetMode(labels))
+	}
+
+	return components, nil
+}
+
+func (o *DockerCli) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
+	podSelector := fmt.Sprintf("component=%s", componentName)
+	return o.GetRunningPodFromSelector(podSelector)
+}
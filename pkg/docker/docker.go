@@ -0,0 +1,326 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+const (
+	// infraImage is the image of the container holding the network namespace shared by the containers of a pod,
+	// and publishing the ports of the pod
+	infraImage = "registry.k8s.io/pause:3.9"
+
+	// podNameLabel is set on all the containers of a pod, with the name of the pod as value
+	podNameLabel = "odo.dev/docker-pod"
+	// infraLabel is set on the infra container of a pod
+	infraLabel = "odo.dev/docker-pod-infra"
+	// podSpecLabel is set on the infra container of a pod, with the definition of the pod as value
+	podSpecLabel = "odo.dev/docker-pod-spec"
+)
+
+// DockerCli runs the pods of the components with the docker client.
+// Docker has no notion of pod: a pod is emulated by an infra container publishing the ports of the pod,
+// and by a container for each container of the pod, named <pod>-<container> and sharing the network of the infra container.
+type DockerCli struct {
+	dockerCmd                   string
+	dockerCmdInitTimeout        time.Duration
+	containerRunGlobalExtraArgs []string
+	containerRunExtraArgs       []string
+}
+
+var _ podman.Client = (*DockerCli)(nil)
+var _ platform.Client = (*DockerCli)(nil)
+
+// NewDockerCli returns a new docker client, or nil if the docker command is not accessible in the system
+func NewDockerCli(ctx context.Context) (*DockerCli, error) {
+	// Check if docker is available in the system
+	cli := &DockerCli{
+		dockerCmd:                   envcontext.GetEnvConfig(ctx).DockerCmd,
+		dockerCmdInitTimeout:        envcontext.GetEnvConfig(ctx).DockerCmdInitTimeout,
+		containerRunGlobalExtraArgs: envcontext.GetEnvConfig(ctx).OdoContainerBackendGlobalArgs,
+		containerRunExtraArgs:       envcontext.GetEnvConfig(ctx).OdoContainerRunArgs,
+	}
+	version, err := cli.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version.Client == nil {
+		return nil, fmt.Errorf("executable %q not recognized as docker client", cli.dockerCmd)
+	}
+
+	return cli, nil
+}
+
+// PlayKube creates the infra container of the pod, then a container for each container of the pod
+func (o *DockerCli) PlayKube(pod *corev1.Pod) error {
+	return o.PlayKubeOnNetwork(pod, "", nil)
+}
+
+// PlayKubeOnNetwork creates the pod as PlayKube does, with its infra container connected to the network with the DNS aliases
+func (o *DockerCli) PlayKubeOnNetwork(pod *corev1.Pod, network string, aliases []string) error {
+	args, err := o.getInfraRunArgs(pod, network, aliases)
+	if err != nil {
+		return err
+	}
+	if err = o.run(args...); err != nil {
+		return err
+	}
+	for _, container := range pod.Spec.Containers {
+		if err = o.run(o.getContainerRunArgs(pod, container)...); err != nil {
+			return fmt.Errorf("unable to start container %q: %w", container.Name, err)
+		}
+	}
+	return nil
+}
+
+// KubeGenerate returns the definition of the pod, as recorded on its infra container
+func (o *DockerCli) KubeGenerate(name string) (*corev1.Pod, error) {
+	reports, err := o.inspectContainers([]string{name})
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("no pod with name %q", name)
+	}
+	return reports[0].getPod()
+}
+
+func (o *DockerCli) PodStop(podname string) error {
+	containers, err := o.getPodContainers(podname)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return fmt.Errorf("no pod with name %q", podname)
+	}
+	out, err := o.output(append([]string{"stop"}, containers...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Stopped containers %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) PodRm(podname string) error {
+	containers, err := o.getPodContainers(podname)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return fmt.Errorf("no pod with name %q", podname)
+	}
+	out, err := o.output(append([]string{"rm", "--force"}, containers...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted containers %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) PodLs() (map[string]bool, error) {
+	out, err := o.output("ps", "--all", "--filter", "label="+infraLabel, "--format", "{{.Names}}")
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(string(out)), nil
+}
+
+func (o *DockerCli) NetworkCreate(name string) error {
+	if _, err := o.output("network", "inspect", name); err == nil {
+		return nil
+	}
+	out, err := o.output("network", "create", name)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Created network %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) NetworkRm(name string) error {
+	out, err := o.output("network", "rm", name)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted network %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) VolumeRm(volumeName string) error {
+	out, err := o.output("volume", "rm", volumeName)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted volume %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) VolumeLs() (map[string]bool, error) {
+	out, err := o.output("volume", "ls", "--format", "{{.Name}}")
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(string(out)), nil
+}
+
+func (o *DockerCli) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	err := o.PodStop(pod.GetName())
+	if err != nil {
+		return err
+	}
+	err = o.PodRm(pod.GetName())
+	if err != nil {
+		return err
+	}
+
+	if !cleanupVolumes {
+		return nil
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		volumeName := volume.PersistentVolumeClaim.ClaimName
+		klog.V(3).Infof("deleting docker volume %q", volumeName)
+		err = o.VolumeRm(volumeName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getInfraRunArgs returns the arguments of the docker command creating the infra container of the pod.
+// The infra container publishes the host ports of all the containers of the pod,
+// and holds the labels and the definition of the pod.
+// When network is not empty, the infra container is connected to this network, with the DNS aliases.
+func (o *DockerCli) getInfraRunArgs(pod *corev1.Pod, network string, aliases []string) ([]string, error) {
+	spec, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"run", "--detach", "--name", pod.GetName(),
+		"--label", podNameLabel + "=" + pod.GetName(),
+		"--label", infraLabel + "=true",
+		"--label", podSpecLabel + "=" + string(spec),
+	}
+	for _, k := range sortedKeys(pod.GetLabels()) {
+		args = append(args, "--label", k+"="+pod.GetLabels()[k])
+	}
+	if network != "" {
+		args = append(args, "--network", network)
+		for _, alias := range aliases {
+			args = append(args, "--network-alias", alias)
+		}
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.HostPort == 0 {
+				continue
+			}
+			protocol := strings.ToLower(string(port.Protocol))
+			if protocol == "" {
+				protocol = "tcp"
+			}
+			publish := fmt.Sprintf("%d:%d/%s", port.HostPort, port.ContainerPort, protocol)
+			if port.HostIP != "" {
+				publish = port.HostIP + ":" + publish
+			}
+			args = append(args, "--publish", publish)
+		}
+	}
+	args = append(args, o.containerRunExtraArgs...)
+	args = append(args, infraImage)
+	return args, nil
+}
+
+// getContainerRunArgs returns the arguments of the docker command creating the container of the pod,
+// sharing the network of the infra container
+func (o *DockerCli) getContainerRunArgs(pod *corev1.Pod, container corev1.Container) []string {
+	args := []string{"run", "--detach",
+		"--name", pod.GetName() + "-" + container.Name,
+		"--network", "container:" + pod.GetName(),
+		"--label", podNameLabel + "=" + pod.GetName(),
+	}
+	for _, env := range container.Env {
+		args = append(args, "--env", env.Name+"="+env.Value)
+	}
+	claims := map[string]string{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	for _, mount := range container.VolumeMounts {
+		source, ok := claims[mount.Name]
+		if !ok {
+			klog.V(4).Infof("volume %q mounted in container %q is not a persistent volume, ignored", mount.Name, container.Name)
+			continue
+		}
+		args = append(args, "--volume", source+":"+mount.MountPath)
+	}
+	if container.WorkingDir != "" {
+		args = append(args, "--workdir", container.WorkingDir)
+	}
+	if memory, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+		args = append(args, "--memory", fmt.Sprintf("%d", memory.Value()))
+	}
+	if cpu, ok := container.Resources.Limits[corev1.ResourceCPU]; ok {
+		args = append(args, "--cpus", cpu.AsDec().String())
+	}
+
+	cmdArgs := container.Args
+	if len(container.Command) > 0 {
+		args = append(args, "--entrypoint", container.Command[0])
+		cmdArgs = append(append([]string{}, container.Command[1:]...), container.Args...)
+	}
+	args = append(args, o.containerRunExtraArgs...)
+	args = append(args, container.Image)
+	args = append(args, cmdArgs...)
+	return args
+}
+
+// getPodContainers returns the names of all the containers of the pod
+func (o *DockerCli) getPodContainers(podname string) ([]string, error) {
+	out, err := o.output("ps", "--all", "--filter", "label="+podNameLabel+"="+podname, "--format", "{{.Names}}")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+func (o *DockerCli) run(args ...string) error {
+	out, err := o.output(args...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Created container %s", string(out))
+	return nil
+}
+
+// output executes the docker command with the given arguments, preceded by the global arguments, and returns its standard output
+func (o *DockerCli) output(args ...string) ([]byte, error) {
+	cmd := exec.Command(o.dockerCmd, append(append([]string{}, o.containerRunGlobalExtraArgs...), args...)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return nil, err
+	}
+	return out, nil
+}
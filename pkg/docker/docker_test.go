@@ -0,0 +1,281 @@
+package docker
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// writeFakeDocker writes a docker.fake.sh script into a temporary directory used as working directory
+func writeFakeDocker(t *testing.T, script string) {
+	originWd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(originWd)
+	})
+	err = os.Chdir(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile("docker.fake.sh", []byte(script), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDockerCli_PodLs(t *testing.T) {
+	tests := []struct {
+		name      string
+		dockerCmd string
+		script    string
+		want      map[string]bool
+		wantErr   bool
+	}{
+		{
+			name:      "command fails",
+			dockerCmd: "false",
+			wantErr:   true,
+		},
+		{
+			name:      "command works, returns nothing",
+			dockerCmd: "true",
+			want:      map[string]bool{},
+		},
+		{
+			name:      "command works, returns the infra containers",
+			dockerCmd: "./docker.fake.sh",
+			script: `#!/bin/sh
+case "$*" in
+	"ps --all --filter label=odo.dev/docker-pod-infra --format {{.Names}}")
+		echo name1
+		echo name2
+		;;
+esac`,
+			want: map[string]bool{
+				"name1": true,
+				"name2": true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.script != "" {
+				writeFakeDocker(t, tt.script)
+			}
+			o := &DockerCli{
+				dockerCmd: tt.dockerCmd,
+			}
+			got, err := o.PodLs()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DockerCli.PodLs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DockerCli.PodLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDockerCli_KubeGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		podName   string
+		script    string
+		wantName  string
+		wantPhase corev1.PodPhase
+		wantErr   bool
+	}{
+		{
+			name:    "pod not found",
+			podName: "pod-not-found",
+			script: `#!/bin/sh
+case "$*" in
+	"container inspect pod-not-found")
+		exit 1
+		;;
+esac`,
+			wantErr: true,
+		},
+		{
+			name:    "container is not an infra container",
+			podName: "my-pod-runtime",
+			script: `#!/bin/sh
+case "$*" in
+	"container inspect my-pod-runtime")
+		echo '[{"Name":"/my-pod-runtime","State":{"Status":"running"},"Config":{"Labels":{"odo.dev/docker-pod":"my-pod"}}}]'
+		;;
+esac`,
+			wantErr: true,
+		},
+		{
+			name:    "command works, returns pod",
+			podName: "my-pod",
+			script: `#!/bin/sh
+case "$*" in
+	"container inspect my-pod")
+		echo '[{"Name":"/my-pod","State":{"Status":"running"},"Config":{"Labels":{"odo.dev/docker-pod-spec":"{\"metadata\":{\"name\":\"my-pod\"}}"}}}]'
+		;;
+esac`,
+			wantName:  "my-pod",
+			wantPhase: corev1.PodRunning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFakeDocker(t, tt.script)
+			o := &DockerCli{
+				dockerCmd: "./docker.fake.sh",
+			}
+			got, err := o.KubeGenerate(tt.podName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DockerCli.KubeGenerate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetName() != tt.wantName {
+				t.Errorf("pod name should be %q but is %q", tt.wantName, got.GetName())
+			}
+			if got.Status.Phase != tt.wantPhase {
+				t.Errorf("pod phase should be %q but is %q", tt.wantPhase, got.Status.Phase)
+			}
+		})
+	}
+}
+
+func TestDockerCli_getRunArgs(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-pod",
+			Labels: map[string]string{
+				"component": "my-comp",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:       "runtime",
+					Image:      "nodejs",
+					Command:    []string{"tail"},
+					Args:       []string{"-f", "/dev/null"},
+					Env:        []corev1.EnvVar{{Name: "PROJECTS_ROOT", Value: "/projects"}},
+					WorkingDir: "/projects",
+					Ports: []corev1.ContainerPort{
+						{ContainerPort: 3000, HostPort: 20001, HostIP: "127.0.0.1"},
+						{ContainerPort: 5858},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "odo-projects", MountPath: "/projects"},
+						{Name: "config", MountPath: "/config"},
+					},
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "odo-projects",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "odo-projects-my-comp-app"},
+					},
+				},
+				{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+	o := &DockerCli{
+		containerRunExtraArgs: []string{"--pull=newer"},
+	}
+
+	infraArgs, err := o.getInfraRunArgs(pod, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The label with the definition of the pod is checked separately
+	if len(infraArgs) < 10 || infraArgs[8] != "--label" {
+		t.Fatalf("unexpected infra args %v", infraArgs)
+	}
+	spec := infraArgs[9]
+	infraArgs = append(infraArgs[:8], infraArgs[10:]...)
+	wantInfra := []string{"run", "--detach", "--name", "my-pod",
+		"--label", "odo.dev/docker-pod=my-pod",
+		"--label", "odo.dev/docker-pod-infra=true",
+		"--label", "component=my-comp",
+		"--publish", "127.0.0.1:20001:3000/tcp",
+		"--pull=newer",
+		"registry.k8s.io/pause:3.9",
+	}
+	if diff := cmp.Diff(wantInfra, infraArgs); diff != "" {
+		t.Errorf("getInfraRunArgs() mismatch (-want +got):\n%s", diff)
+	}
+	recorded, err := containerInspectReport{
+		Config: struct{ Labels map[string]string }{
+			Labels: map[string]string{podSpecLabel: spec[len(podSpecLabel)+1:]},
+		},
+	}.getPod()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(pod.Spec, recorded.Spec) {
+		t.Errorf("recorded pod spec is %v, want %v", recorded.Spec, pod.Spec)
+	}
+
+	wantContainer := []string{"run", "--detach",
+		"--name", "my-pod-runtime",
+		"--network", "container:my-pod",
+		"--label", "odo.dev/docker-pod=my-pod",
+		"--env", "PROJECTS_ROOT=/projects",
+		"--volume", "odo-projects-my-comp-app:/projects",
+		"--workdir", "/projects",
+		"--memory", "1073741824",
+		"--cpus", "0.500",
+		"--entrypoint", "tail",
+		"--pull=newer",
+		"nodejs", "-f", "/dev/null",
+	}
+	if diff := cmp.Diff(wantContainer, o.getContainerRunArgs(pod, pod.Spec.Containers[0])); diff != "" {
+		t.Errorf("getContainerRunArgs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	got, err := parseVersion([]byte(`{"Client":{"Version":"24.0.5","ApiVersion":"1.43","GitCommit":"ced0996","GoVersion":"go1.20.6","Os":"linux","Arch":"amd64","BuildTime":"Tue Jul 25 00:00:00 2023"},"Server":{"Version":"24.0.5"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := podman.SystemVersionReport{
+		Client: &podman.Version{
+			APIVersion: "1.43",
+			Version:    "24.0.5",
+			GoVersion:  "go1.20.6",
+			GitCommit:  "ced0996",
+			BuiltTime:  "Tue Jul 25 00:00:00 2023",
+			OsArch:     "linux/amd64",
+			Os:         "linux",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseVersion() mismatch (-want +got):\n%s", diff)
+	}
+}
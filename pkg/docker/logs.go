@@ -0,0 +1,58 @@
+package docker
+
+import (
+	"io"
+	"os/exec"
+	"sync"
+
+	"k8s.io/klog"
+)
+
+// GetPodLogs returns the logs of the specified pod container.
+// All logs for all containers part of the pod are returned if an empty string is provided as container name.
+func (o *DockerCli) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+	containers := []string{containerName}
+	if containerName == "" {
+		pod, err := o.KubeGenerate(podName)
+		if err != nil {
+			return nil, err
+		}
+		containers = nil
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+	}
+
+	// The logs of all the containers are written to the same pipe, closed when all the commands are terminated
+	out, writer := io.Pipe()
+	var wg sync.WaitGroup
+	for _, container := range containers {
+		args := []string{"logs"}
+		if followLog {
+			args = append(args, "--follow")
+		}
+		args = append(args, podName+"-"+container)
+
+		cmd := exec.Command(o.dockerCmd, append(append([]string{}, o.containerRunGlobalExtraArgs...), args...)...)
+		klog.V(3).Infof("executing %v", cmd.Args)
+		// docker logs outputs the logs in stdout && stderr, as written by the container (when kubectl outputs all logs on stdout)
+		cmd.Stdout = writer
+		cmd.Stderr = writer
+		if err := cmd.Start(); err != nil {
+			_ = writer.CloseWithError(err)
+			return nil, err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cmd.Wait(); err != nil {
+				klog.V(4).Infof("error getting logs from docker: %s", err)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		_ = writer.Close()
+	}()
+	return out, nil
+}
@@ -0,0 +1,185 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// containerInspectReport contains the fields used by odo from the result of the `docker inspect` command
+type containerInspectReport struct {
+	Name  string
+	State struct {
+		Status string
+	}
+	Config struct {
+		Labels map[string]string
+	}
+}
+
+// getPod returns the pod recorded on the infra container, with its status
+func (o containerInspectReport) getPod() (*corev1.Pod, error) {
+	spec, ok := o.Config.Labels[podSpecLabel]
+	if !ok {
+		return nil, fmt.Errorf("container %q is not the infra container of a pod", strings.TrimPrefix(o.Name, "/"))
+	}
+	var pod corev1.Pod
+	if err := json.Unmarshal([]byte(spec), &pod); err != nil {
+		return nil, err
+	}
+	pod.Status.Phase = getPodPhase(o.State.Status)
+	return &pod, nil
+}
+
+// getPodPhase returns the phase of the pod from the status of its infra container (running, exited, ...)
+func getPodPhase(status string) corev1.PodPhase {
+	if status == "" {
+		return corev1.PodUnknown
+	}
+	return corev1.PodPhase(strings.ToUpper(status[:1]) + status[1:])
+}
+
+// GetPodsMatchingSelector returns all pods matching the given label selector.
+func (o *DockerCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	var result corev1.PodList
+	for _, pod := range pods {
+		result.Items = append(result.Items, *pod)
+	}
+	return &result, nil
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *DockerCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []unstructured.Unstructured
+	for _, pod := range pods {
+		u := unstructured.Unstructured{}
+		u.SetName(pod.GetName())
+		u.SetLabels(pod.GetLabels())
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+func (o *DockerCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	// As with Podman, we return the pods, as there is no resource containing PodSpec
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *DockerCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	numPods := len(pods)
+	if numPods == 0 {
+		return nil, &platform.PodNotFoundError{Selector: selector}
+	} else if numPods > 1 {
+		return nil, fmt.Errorf("multiple Pods exist for the selector: %v. Only one must be present", selector)
+	}
+
+	pod := pods[0]
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil, fmt.Errorf("a pod exists but is not in Running state. Current status=%v", pod.Status.Phase)
+	}
+	return pod, nil
+}
+
+// getPodsFromSelector returns the pods whose infra container matches all the labels of the selector
+func (o *DockerCli) getPodsFromSelector(selector string) ([]*corev1.Pod, error) {
+	args := []string{"ps", "--all", "--format", "{{.Names}}", "--filter", "label=" + infraLabel}
+	if selector != "" {
+		for _, s := range strings.Split(selector, ",") {
+			args = append(args, "--filter", "label="+s)
+		}
+	}
+	out, err := o.output(args...)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := o.inspectContainers(strings.Fields(string(out)))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*corev1.Pod
+	for _, report := range reports {
+		pod, err := report.getPod()
+		if err != nil {
+			klog.V(4).Infof("ignoring container: %v", err)
+			continue
+		}
+		klog.V(5).Infof("\npod name: %s", pod.GetName())
+		klog.V(5).Infof("labels:")
+		for k, v := range pod.GetLabels() {
+			klog.V(5).Infof(" - %s: %s", k, v)
+		}
+		result = append(result, pod)
+	}
+	return result, nil
+}
+
+func (o *DockerCli) inspectContainers(names []string) ([]containerInspectReport, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	out, err := o.output(append([]string{"container", "inspect"}, names...)...)
+	if err != nil {
+		return nil, err
+	}
+	var result []containerInspectReport
+	if err = json.Unmarshal(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (o *DockerCli) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	args := append(append([]string{}, o.containerRunGlobalExtraArgs...), "ps", "--quiet", "--filter", "label="+podNameLabel)
+	return podman.NewPodWatcher(func() ([]string, error) {
+		cmd := exec.Command(o.dockerCmd, args...)
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, err
+		}
+		var containers []string
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			containers = append(containers, scanner.Text())
+		}
+		return containers, nil
+	}), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
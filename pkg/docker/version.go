@@ -0,0 +1,98 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// dockerVersion contains the fields used by odo from the result of the `docker version` command
+type dockerVersion struct {
+	Client *struct {
+		Version    string
+		APIVersion string `json:"ApiVersion"`
+		GitCommit  string
+		GoVersion  string
+		Os         string
+		Arch       string
+		BuildTime  string
+	} `json:",omitempty"`
+}
+
+// Version returns the version of the Docker client.
+// The command fails if the Docker daemon cannot be reached.
+func (o *DockerCli) Version(ctx context.Context) (podman.SystemVersionReport, error) {
+	// As for Podman, Version is used at the very beginning of odo, when resolving and injecting dependencies,
+	// and is expected to return in a timely manner (hence this configurable timeout).
+
+	cmd := exec.CommandContext(ctx, o.dockerCmd, "version", "--format", "{{json .}}")
+	klog.V(3).Infof("executing %v", cmd.Args)
+
+	outbuf, errbuf := new(bytes.Buffer), new(bytes.Buffer)
+	cmd.Stdout, cmd.Stderr = outbuf, errbuf
+
+	err := cmd.Start()
+	if err != nil {
+		return podman.SystemVersionReport{}, err
+	}
+
+	// Use a channel to signal completion so we can use a select statement
+	done := make(chan error)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case <-time.After(o.dockerCmdInitTimeout):
+		err = cmd.Process.Kill()
+		if err != nil {
+			klog.V(3).Infof("unable to kill docker version process: %s", err)
+		}
+		timeoutErr := fmt.Errorf("timeout (%s) while waiting for Docker version", o.dockerCmdInitTimeout.Round(time.Second).String())
+		klog.V(3).Infof(timeoutErr.Error())
+		return podman.SystemVersionReport{}, timeoutErr
+
+	case err = <-done:
+		if err != nil {
+			klog.V(3).Infof("Non-zero exit code for docker version: %v", err)
+
+			stderr := errbuf.String()
+			if len(stderr) > 0 {
+				klog.V(3).Infof("docker version stderr: %v", stderr)
+				err = fmt.Errorf("%w: %s", err, stderr)
+			}
+
+			return podman.SystemVersionReport{}, err
+		}
+	}
+
+	return parseVersion(outbuf.Bytes())
+}
+
+func parseVersion(out []byte) (podman.SystemVersionReport, error) {
+	var version dockerVersion
+	err := json.Unmarshal(out, &version)
+	if err != nil {
+		klog.V(3).Infof("unable to decode output: %v", err)
+		return podman.SystemVersionReport{}, err
+	}
+	if version.Client == nil {
+		return podman.SystemVersionReport{}, nil
+	}
+	return podman.SystemVersionReport{
+		Client: &podman.Version{
+			APIVersion: version.Client.APIVersion,
+			Version:    version.Client.Version,
+			GoVersion:  version.Client.GoVersion,
+			GitCommit:  version.Client.GitCommit,
+			BuiltTime:  version.Client.BuildTime,
+			OsArch:     version.Client.Os + "/" + version.Client.Arch,
+			Os:         version.Client.Os,
+		},
+	}, nil
+}
@@ -0,0 +1,166 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	yaml3 "gopkg.in/yaml.v3"
+
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/storage"
+)
+
+// ComposeFileName is the name of the Docker Compose file written by Compose
+const ComposeFileName = "docker-compose.yaml"
+
+// See https://github.com/compose-spec/compose-spec/blob/master/spec.md
+type composeFile struct {
+	Name     string                    `yaml:"name"`
+	Services map[string]composeService `yaml:"services"`
+	Volumes  map[string]struct{}       `yaml:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image       string            `yaml:"image"`
+	Build       *composeBuild     `yaml:"build,omitempty"`
+	Entrypoint  []string          `yaml:"entrypoint,omitempty"`
+	Command     []string          `yaml:"command,omitempty"`
+	Environment map[string]string `yaml:"environment,omitempty"`
+	Ports       []string          `yaml:"ports,omitempty"`
+	NetworkMode string            `yaml:"network_mode,omitempty"`
+	DependsOn   []string          `yaml:"depends_on,omitempty"`
+	Volumes     []string          `yaml:"volumes,omitempty"`
+	MemLimit    int64             `yaml:"mem_limit,omitempty"`
+	Cpus        string            `yaml:"cpus,omitempty"`
+}
+
+type composeBuild struct {
+	Context    string            `yaml:"context"`
+	Dockerfile string            `yaml:"dockerfile"`
+	Args       map[string]string `yaml:"args,omitempty"`
+}
+
+// Compose returns the content of a Docker Compose file running the containers of the Devfile as odo dev does on Podman.
+// Each container is a service, sharing the network of the service of the first container, as the containers of a Pod do.
+// The sources of the component are mounted from the working directory, and the relative paths are relative to outputDir,
+// the directory into which the file is written.
+func Compose(ctx context.Context, devfileObj parser.DevfileObj, outputDir string) ([]byte, error) {
+	workingDir := odocontext.GetWorkingDirectory(ctx)
+
+	pod, err := getDevPod(ctx, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	builds, err := getImageBuilds(ctx, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	claims := getClaimNames(pod)
+
+	file := composeFile{
+		Name:     pod.GetName(),
+		Services: make(map[string]composeService, len(pod.Spec.Containers)),
+	}
+	first := pod.Spec.Containers[0].Name
+	for i, container := range pod.Spec.Containers {
+		service := composeService{
+			Image:      container.Image,
+			Entrypoint: escapeCompose(container.Command),
+			Command:    escapeCompose(container.Args),
+		}
+
+		if build, ok := builds[container.Image]; ok {
+			service.Build = &composeBuild{
+				Context:    getRelativePath(outputDir, build.context),
+				Dockerfile: filepath.ToSlash(build.dockerfile),
+				Args:       build.args,
+			}
+			if dockerfile, relErr := filepath.Rel(build.context, build.dockerfile); relErr == nil {
+				service.Build.Dockerfile = filepath.ToSlash(dockerfile)
+			}
+		}
+
+		for _, env := range getEnv(container) {
+			if service.Environment == nil {
+				service.Environment = make(map[string]string)
+			}
+			service.Environment[env.Name] = strings.ReplaceAll(env.Value, "$", "$$")
+		}
+
+		// The ports of all the containers are published by the service owning the network
+		if i == 0 {
+			service.Ports = getPublishedPorts(pod)
+		} else {
+			service.NetworkMode = "service:" + first
+			service.DependsOn = []string{first}
+		}
+
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == storage.OdoSourceVolume {
+				service.Volumes = append(service.Volumes, getRelativePath(outputDir, workingDir)+":"+mount.MountPath)
+				continue
+			}
+			claim, ok := claims[mount.Name]
+			if !ok {
+				return nil, fmt.Errorf("volume %q of container %q not found", mount.Name, container.Name)
+			}
+			if file.Volumes == nil {
+				file.Volumes = make(map[string]struct{})
+			}
+			file.Volumes[claim] = struct{}{}
+			service.Volumes = append(service.Volumes, claim+":"+mount.MountPath)
+		}
+
+		if memory := container.Resources.Limits.Memory(); !memory.IsZero() {
+			service.MemLimit = memory.Value()
+		}
+		if cpu := container.Resources.Limits.Cpu(); !cpu.IsZero() {
+			service.Cpus = strconv.FormatFloat(float64(cpu.MilliValue())/1000, 'f', -1, 64)
+		}
+
+		file.Services[container.Name] = service
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Generated by odo from the Devfile of the component %s\n", odocontext.GetComponentName(ctx))
+	encoder := yaml3.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(file); err != nil {
+		return nil, err
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// escapeCompose escapes the $ characters, which would be interpolated by Compose
+func escapeCompose(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, strings.ReplaceAll(value, "$", "$$"))
+	}
+	return result
+}
+
+// getRelativePath returns the path of target relative to base, in the format expected by Compose for bind mounts,
+// or target if it cannot be made relative to base
+func getRelativePath(base, target string) string {
+	rel, err := filepath.Rel(base, target)
+	if err != nil {
+		return filepath.ToSlash(target)
+	}
+	rel = filepath.ToSlash(rel)
+	if rel != "." && rel != ".." && !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return rel
+}
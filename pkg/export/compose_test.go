@@ -0,0 +1,101 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		name      string
+		outputDir string
+		want      string
+	}{
+		{
+			name:      "written into the directory of the component",
+			outputDir: "/tmp/dir",
+			want: `# Generated by odo from the Devfile of the component mycmp
+name: mycmp-app
+services:
+  db:
+    image: postgres
+    entrypoint:
+      - docker-entrypoint.sh
+    command:
+      - postgres
+    network_mode: service:runtime
+    depends_on:
+      - runtime
+  runtime:
+    image: myimage
+    build:
+      context: .
+      dockerfile: docker/Dockerfile
+      args:
+        VERSION: "1.0"
+    environment:
+      GREETING: hello $$USER
+      PROJECT_SOURCE: /projects
+      PROJECTS_ROOT: /projects
+    ports:
+      - 127.0.0.1:8080:8080
+      - 127.0.0.1:5432:5432
+    volumes:
+      - .:/projects
+      - cache-mycmp-app:/cache
+    mem_limit: 536870912
+volumes:
+  cache-mycmp-app: {}
+`,
+		},
+		{
+			name:      "written into another directory",
+			outputDir: "/tmp/out",
+			want: `# Generated by odo from the Devfile of the component mycmp
+name: mycmp-app
+services:
+  db:
+    image: postgres
+    entrypoint:
+      - docker-entrypoint.sh
+    command:
+      - postgres
+    network_mode: service:runtime
+    depends_on:
+      - runtime
+  runtime:
+    image: myimage
+    build:
+      context: ../dir
+      dockerfile: docker/Dockerfile
+      args:
+        VERSION: "1.0"
+    environment:
+      GREETING: hello $$USER
+      PROJECT_SOURCE: /projects
+      PROJECTS_ROOT: /projects
+    ports:
+      - 127.0.0.1:8080:8080
+      - 127.0.0.1:5432:5432
+    volumes:
+      - ../dir:/projects
+      - cache-mycmp-app:/cache
+    mem_limit: 536870912
+volumes:
+  cache-mycmp-app: {}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compose(getTestContext(), getTestDevfileObj(t), tt.outputDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Compose() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Package export renders the Devfile into files understood by tools other than odo
+package export
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev/podmandev"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/storage"
+)
+
+// imageBuild is the build of an Image component of the Devfile
+type imageBuild struct {
+	// Absolute path of the build context
+	context string
+	// Absolute path of the Dockerfile
+	dockerfile string
+	// Build arguments passed with --build-arg
+	args map[string]string
+}
+
+// getDevPod returns the Pod run by odo dev on Podman for the Devfile,
+// with the endpoints of the containers bound to the same ports on the local machine.
+// As the commands of the Devfile are not executed into the containers by odo, the containers run their own entrypoints,
+// and the volume of the data used internally by odo is not mounted.
+func getDevPod(ctx context.Context, devfileObj parser.DevfileObj) (*corev1.Pod, error) {
+	containerComponents, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ports []api.ForwardedPort
+	for container, endpoints := range libdevfile.GetContainerEndpointMapping(containerComponents, false) {
+		for _, ep := range endpoints {
+			ports = append(ports, api.ForwardedPort{
+				ContainerName: container,
+				ContainerPort: ep.TargetPort,
+				LocalPort:     ep.TargetPort,
+			})
+		}
+	}
+	// The resource limits of the containers are kept, as the target system is expected to use cgroups v2
+	pod, _, err := podmandev.CreatePodFromComponent(ctx, podman.Capabilities{Cgroupv2: true},
+		false, "", "", "", false, false, ports, nil, "", devfileObj, false)
+	if err != nil {
+		return nil, err
+	}
+	removeVolume(pod, storage.SharedDataVolumeName)
+	if len(pod.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("no containers found in pod %q", pod.GetName())
+	}
+	return pod, nil
+}
+
+// removeVolume removes the volume from the pod, and its mounts from the containers of the pod
+func removeVolume(pod *corev1.Pod, name string) {
+	volumes := make([]corev1.Volume, 0, len(pod.Spec.Volumes))
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name != name {
+			volumes = append(volumes, volume)
+		}
+	}
+	pod.Spec.Volumes = volumes
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		mounts := make([]corev1.VolumeMount, 0, len(container.VolumeMounts))
+		for _, mount := range container.VolumeMounts {
+			if mount.Name != name {
+				mounts = append(mounts, mount)
+			}
+		}
+		container.VolumeMounts = mounts
+	}
+}
+
+// getImageBuilds returns the builds of the Image components of the Devfile building an image from a local Dockerfile,
+// indexed by the name of the image
+func getImageBuilds(ctx context.Context, devfileObj parser.DevfileObj) (map[string]imageBuild, error) {
+	var (
+		workingDir = odocontext.GetWorkingDirectory(ctx)
+		devfileDir = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ImageComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]imageBuild, len(components))
+	for _, component := range components {
+		image := component.Image
+		if image.Dockerfile == nil {
+			continue
+		}
+		uri := strings.ToLower(image.Dockerfile.Uri)
+		if strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
+			log.Warningf("The Dockerfile of the Image component %q is not a local file. Skipping the build of the image %s.", component.Name, image.ImageName)
+			continue
+		}
+		build := imageBuild{
+			context:    image.Dockerfile.BuildContext,
+			dockerfile: image.Dockerfile.Uri,
+		}
+		// As when building the image with odo, the build context is relative to the working directory, defaulting to the directory of the Devfile,
+		// and the Dockerfile is relative to the directory of the Devfile
+		if build.context == "" {
+			build.context = devfileDir
+		} else if !filepath.IsAbs(build.context) {
+			build.context = filepath.Join(workingDir, build.context)
+		}
+		if !filepath.IsAbs(build.dockerfile) {
+			build.dockerfile = filepath.Join(devfileDir, build.dockerfile)
+		}
+		build.args, err = getBuildArgs(image.Dockerfile.Args)
+		if err != nil {
+			return nil, fmt.Errorf("image component %q: %w", component.Name, err)
+		}
+		result[image.ImageName] = build
+	}
+	return result, nil
+}
+
+// getBuildArgs returns the build arguments passed with --build-arg in the arguments of the build command
+func getBuildArgs(args []string) (map[string]string, error) {
+	var result map[string]string
+	for i := 0; i < len(args); i++ {
+		var arg string
+		switch {
+		case args[i] == "--build-arg" && i+1 < len(args):
+			i++
+			arg = args[i]
+		case strings.HasPrefix(args[i], "--build-arg="):
+			arg = strings.TrimPrefix(args[i], "--build-arg=")
+		default:
+			return nil, fmt.Errorf("unsupported build argument %q, only --build-arg is supported", args[i])
+		}
+		if result == nil {
+			result = make(map[string]string)
+		}
+		key, value, _ := strings.Cut(arg, "=")
+		result[key] = value
+	}
+	return result, nil
+}
+
+// getEnv returns the environment variables of the container, sorted by name
+func getEnv(container corev1.Container) []corev1.EnvVar {
+	env := make(map[string]string, len(container.Env))
+	for _, e := range container.Env {
+		env[e.Name] = e.Value
+	}
+	result := make([]corev1.EnvVar, 0, len(env))
+	for name, value := range env {
+		result = append(result, corev1.EnvVar{Name: name, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// getClaimNames returns the names of the volumes of the pod, indexed by the name of the pod volume
+func getClaimNames(pod *corev1.Pod) map[string]string {
+	result := make(map[string]string, len(pod.Spec.Volumes))
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			result[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	return result
+}
+
+// getPublishedPorts returns the ports of all the containers of the pod bound to the local machine,
+// in the format of the --publish flag
+func getPublishedPorts(pod *corev1.Pod) []string {
+	var result []string
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.HostPort == 0 {
+				continue
+			}
+			p := fmt.Sprintf("%d:%d", port.HostPort, port.ContainerPort)
+			if port.HostIP != "" {
+				p = port.HostIP + ":" + p
+			}
+			if port.Protocol == corev1.ProtocolUDP {
+				p += "/udp"
+			}
+			result = append(result, p)
+		}
+	}
+	return result
+}
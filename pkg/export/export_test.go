@@ -0,0 +1,140 @@
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+
+	"k8s.io/utils/pointer"
+)
+
+func getTestContext() context.Context {
+	ctx := context.Background()
+	ctx = odocontext.WithApplication(ctx, "app")
+	ctx = odocontext.WithComponentName(ctx, "mycmp")
+	ctx = odocontext.WithWorkingDirectory(ctx, "/tmp/dir")
+	ctx = odocontext.WithDevfilePath(ctx, "/tmp/dir/devfile.yaml")
+	return ctx
+}
+
+func getTestDevfileObj(t *testing.T) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name: "runtime",
+			Container: v1alpha2.Container{
+				Image:        "myimage",
+				MemoryLimit:  "512Mi",
+				MountSources: pointer.Bool(true),
+				VolumeMounts: []v1alpha2.VolumeMount{{Name: "cache", Path: "/cache"}},
+				Env:          []v1alpha2.EnvVar{{Name: "GREETING", Value: "hello $USER"}},
+			},
+			Endpoints: []v1alpha2.Endpoint{
+				{Name: "http", TargetPort: 8080},
+				{Name: "debug", TargetPort: 5858},
+			},
+		}),
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name: "db",
+			Container: v1alpha2.Container{
+				Image:        "postgres",
+				Command:      []string{"docker-entrypoint.sh"},
+				Args:         []string{"postgres"},
+				MountSources: pointer.Bool(false),
+			},
+			Endpoints: []v1alpha2.Endpoint{
+				{Name: "postgres", TargetPort: 5432},
+			},
+		}),
+		generator.GetVolumeComponent(generator.VolumeComponentParams{
+			Name: "cache",
+		}),
+		generator.GetImageComponent(generator.ImageComponentParams{
+			Name: "build",
+			Image: v1alpha2.Image{
+				ImageName: "myimage",
+				ImageUnion: v1alpha2.ImageUnion{
+					Dockerfile: &v1alpha2.DockerfileImage{
+						DockerfileSrc: v1alpha2.DockerfileSrc{
+							Uri: "docker/Dockerfile",
+						},
+						Dockerfile: v1alpha2.Dockerfile{
+							Args: []string{"--build-arg", "VERSION=1.0"},
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The commands run into the runtime container, which has no command of its own
+	err = devfileData.AddCommands([]v1alpha2.Command{
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Id:          "build",
+			Component:   "runtime",
+			CommandLine: "make build",
+			Kind:        v1alpha2.BuildCommandGroupKind,
+			IsDefault:   pointer.Bool(true),
+		}),
+		generator.GetExecCommand(generator.ExecCommandParams{
+			Id:          "run",
+			Component:   "runtime",
+			CommandLine: "make run",
+			Kind:        v1alpha2.RunCommandGroupKind,
+			IsDefault:   pointer.Bool(true),
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{
+		Data: devfileData,
+	}
+}
+
+func Test_getBuildArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "no arguments",
+		},
+		{
+			name: "build arguments in both formats",
+			args: []string{"--build-arg", "A=a", "--build-arg=B=b=c", "--build-arg", "C"},
+			want: map[string]string{"A": "a", "B": "b=c", "C": ""},
+		},
+		{
+			name:    "unsupported argument",
+			args:    []string{"--build-arg", "A=a", "--no-cache"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getBuildArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getBuildArgs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getBuildArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/deploy"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+)
+
+// KubernetesFormat is the format into which the Kubernetes manifests are exported
+type KubernetesFormat string
+
+const (
+	// YAMLFormat exports a file for each manifest
+	YAMLFormat KubernetesFormat = "yaml"
+	// KustomizeFormat exports a kustomize base, with a file for each manifest
+	KustomizeFormat KubernetesFormat = "kustomize"
+	// HelmFormat exports a Helm chart, whose values are the variables of the Devfile
+	HelmFormat KubernetesFormat = "helm"
+)
+
+// KubernetesFormats are the supported formats for the Kubernetes manifests
+var KubernetesFormats = []KubernetesFormat{YAMLFormat, KustomizeFormat, HelmFormat}
+
+// helmPlaceholderRegexp matches the placeholders set as values of the variables of the Devfile when rendering the templates of a Helm chart.
+// The placeholders are valid relative image names, so that the image names are still computed when variables are used in image names,
+// and are closed by a delimiter, so that a placeholder followed by digits is not read as another placeholder.
+var helmPlaceholderRegexp = regexp.MustCompile(`odovar([0-9]+)end`)
+
+// helmScalarPlaceholderRegexp matches the placeholders being a whole YAML scalar, as the value of a key or an item of a list.
+// The value of the chart is quoted there, so that a value such as "8080" is still rendered as a string.
+var helmScalarPlaceholderRegexp = regexp.MustCompile(`(?m)((?:^[ \t]*(?:- )+)|: )odovar([0-9]+)end$`)
+
+// Kubernetes returns the Kubernetes manifests created by odo deploy, indexed by file name, in the given format.
+// The deploy command is run in render-only mode: the Kubernetes and OpenShift components it applies are rendered with the labels of odo,
+// the images are neither built nor pushed, and the commands executed in containers are skipped.
+// imageRegistry is the registry prefixing the relative image names, used when the Devfile is parsed again to render the templates of a Helm chart.
+func Kubernetes(ctx context.Context, devfileObj parser.DevfileObj, format KubernetesFormat, imageRegistry string) (map[string][]byte, error) {
+	switch format {
+	case YAMLFormat, KustomizeFormat:
+		resources, err := renderDeployCommand(ctx, devfileObj)
+		if err != nil {
+			return nil, err
+		}
+		files, err := getManifestFiles(resources, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		if format == KustomizeFormat {
+			files["kustomization.yaml"], err = getKustomization(files)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return files, nil
+	case HelmFormat:
+		return getHelmChart(ctx, devfileObj, imageRegistry)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// renderHandler is a libdevfile.Handler rendering the resources of the Kubernetes and OpenShift components, instead of applying them
+type renderHandler struct {
+	ctx     context.Context
+	devfile parser.DevfileObj
+	path    string
+
+	resources []unstructured.Unstructured
+}
+
+var _ libdevfile.Handler = (*renderHandler)(nil)
+
+func (o *renderHandler) ApplyImage(image v1alpha2.Component) error {
+	klog.V(4).Infof("not building the image %q of component %q", image.Image.ImageName, image.Name)
+	return nil
+}
+
+func (o *renderHandler) ApplyKubernetes(kubernetes v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	var (
+		appName       = odocontext.GetApplication(o.ctx)
+		componentName = odocontext.GetComponentName(o.ctx)
+	)
+	resources, err := component.RenderKubernetes(odolabels.ComponentDeployMode, appName, componentName, o.devfile, kubernetes, o.path, nil)
+	if err != nil {
+		return err
+	}
+	o.resources = append(o.resources, resources...)
+	return nil
+}
+
+func (o *renderHandler) ApplyOpenShift(openshift v1alpha2.Component, kind v1alpha2.CommandGroupKind) error {
+	return o.ApplyKubernetes(openshift, kind)
+}
+
+func (o *renderHandler) ExecuteNonTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	log.Warningf("The command %q is executed in a container and cannot be exported. Skipping it.", command.Id)
+	return nil
+}
+
+func (o *renderHandler) ExecuteTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	log.Warningf("The command %q is executed in a container and cannot be exported. Skipping it.", command.Id)
+	return nil
+}
+
+// renderDeployCommand returns the resources applied by the deploy command of the Devfile.
+// A resource applied several times is returned once, with its last definition.
+func renderDeployCommand(ctx context.Context, devfileObj parser.DevfileObj) ([]unstructured.Unstructured, error) {
+	handler := renderHandler{
+		ctx:     ctx,
+		devfile: devfileObj,
+		path:    filepath.Dir(odocontext.GetDevfilePath(ctx)),
+	}
+	err := deploy.RunDeployCommand(ctx, devfileObj, &handler)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []unstructured.Unstructured
+	indexes := make(map[string]int)
+	for _, u := range handler.resources {
+		key := strings.Join([]string{u.GetAPIVersion(), u.GetKind(), u.GetNamespace(), u.GetName()}, "/")
+		if i, found := indexes[key]; found {
+			result[i] = u
+			continue
+		}
+		indexes[key] = len(result)
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// getManifestFiles returns the manifests of the resources, indexed by file name, in dir.
+// The contents of the manifests are transformed by replace, if not nil.
+func getManifestFiles(resources []unstructured.Unstructured, dir string, replace func(string) string) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(resources))
+	for _, u := range resources {
+		content, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		if replace != nil {
+			content = []byte(replace(string(content)))
+		}
+		base := strings.ToLower(u.GetKind() + "-" + u.GetName())
+		name := filepath.Join(dir, base+".yaml")
+		for i := 2; result[name] != nil; i++ {
+			name = filepath.Join(dir, base+"-"+strconv.Itoa(i)+".yaml")
+		}
+		result[name] = content
+	}
+	return result, nil
+}
+
+// getKustomization returns the content of the kustomization.yaml file, referencing the files of the manifests
+func getKustomization(files map[string][]byte) ([]byte, error) {
+	resources := make([]string, 0, len(files))
+	for name := range files {
+		resources = append(resources, filepath.ToSlash(name))
+	}
+	sort.Strings(resources)
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+}
+
+// getHelmChart returns the files of a Helm chart deploying the resources applied by the deploy command of the Devfile.
+// The values of the chart are the variables of the Devfile: the templates are rendered from the Devfile parsed again,
+// with placeholders as values of the variables, which are replaced with references to the values of the chart.
+func getHelmChart(ctx context.Context, devfileObj parser.DevfileObj, imageRegistry string) (map[string][]byte, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		variables     = devfileObj.Data.GetDevfileWorkspaceSpec().Variables
+	)
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	placeholderObj, err := devfile.ParseAndValidateFromFileWithVariables(odocontext.GetDevfilePath(ctx), getHelmPlaceholders(names), imageRegistry, true)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := renderDeployCommand(ctx, placeholderObj)
+	if err != nil {
+		return nil, err
+	}
+	files, err := getManifestFiles(resources, "templates", func(content string) string {
+		return replaceHelmPlaceholders(content, names)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	version := devfileObj.Data.GetMetadata().Version
+	if version == "" {
+		version = "0.1.0"
+	}
+	files["Chart.yaml"], err = yaml.Marshal(map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        componentName,
+		"description": fmt.Sprintf("Resources of the component %s, generated by odo from the Devfile", componentName),
+		"type":        "application",
+		"version":     version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files["values.yaml"], err = yaml.Marshal(variables)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// getHelmPlaceholders returns the placeholders of the variables, indexed by the names of the variables
+func getHelmPlaceholders(names []string) map[string]string {
+	result := make(map[string]string, len(names))
+	for i, name := range names {
+		result[name] = "odovar" + strconv.Itoa(i) + "end"
+	}
+	return result
+}
+
+// replaceHelmPlaceholders replaces the placeholders of the variables in content with the references to the values of the chart.
+// The values replacing a whole YAML scalar are quoted.
+func replaceHelmPlaceholders(content string, names []string) string {
+	content = helmScalarPlaceholderRegexp.ReplaceAllStringFunc(content, func(match string) string {
+		submatches := helmScalarPlaceholderRegexp.FindStringSubmatch(match)
+		i, err := strconv.Atoi(submatches[2])
+		if err != nil || i >= len(names) {
+			return match
+		}
+		return submatches[1] + getHelmValueReference(names[i], true)
+	})
+	return helmPlaceholderRegexp.ReplaceAllStringFunc(content, func(placeholder string) string {
+		i, err := strconv.Atoi(helmPlaceholderRegexp.FindStringSubmatch(placeholder)[1])
+		if err != nil || i >= len(names) {
+			return placeholder
+		}
+		return getHelmValueReference(names[i], false)
+	})
+}
+
+var helmValueNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// getHelmValueReference returns the reference to the value in a Helm template, piped to the quote function if quote is true
+func getHelmValueReference(name string, quote bool) string {
+	value := fmt.Sprintf("index .Values %q", name)
+	if helmValueNameRegexp.MatchString(name) {
+		value = ".Values." + name
+	}
+	if quote {
+		value += " | quote"
+	}
+	return "{{ " + value + " }}"
+}
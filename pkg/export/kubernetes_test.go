@@ -0,0 +1,292 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+)
+
+func getTestResource(kind, name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func Test_getManifestFiles(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		getTestResource("Service", "my-svc"),
+		getTestResource("Service", "My-Svc"),
+		getTestResource("ConfigMap", "my-config"),
+	}
+	files, err := getManifestFiles(resources, "templates", func(content string) string {
+		return strings.ReplaceAll(content, "my-config", "{{ .Values.NAME }}")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"templates/service-my-svc.yaml":      "apiVersion: v1\nkind: Service\nmetadata:\n  name: my-svc\n",
+		"templates/service-my-svc-2.yaml":    "apiVersion: v1\nkind: Service\nmetadata:\n  name: My-Svc\n",
+		"templates/configmap-my-config.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Values.NAME }}\n",
+	}
+	got := make(map[string]string, len(files))
+	for name, content := range files {
+		got[name] = string(content)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getManifestFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getKustomization(t *testing.T) {
+	got, err := getKustomization(map[string][]byte{
+		"service-my-svc.yaml":       nil,
+		"deployment-my-deploy.yaml": nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- deployment-my-deploy.yaml
+- service-my-svc.yaml
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("getKustomization() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getHelmValueReference(t *testing.T) {
+	tests := []struct {
+		name  string
+		quote bool
+		want  string
+	}{
+		{name: "CONTAINER_IMAGE", want: "{{ .Values.CONTAINER_IMAGE }}"},
+		{name: "_name1", want: "{{ .Values._name1 }}"},
+		{name: "image-name", want: `{{ index .Values "image-name" }}`},
+		{name: "1st", want: `{{ index .Values "1st" }}`},
+		{name: "PORT", quote: true, want: "{{ .Values.PORT | quote }}"},
+		{name: "port-number", quote: true, want: `{{ index .Values "port-number" | quote }}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getHelmValueReference(tt.name, tt.quote); got != tt.want {
+				t.Errorf("getHelmValueReference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func getTestKubernetesComponent(name string, manifest string) v1alpha2.Component {
+	return generator.GetKubernetesComponent(generator.KubernetesComponentParams{
+		Name: name,
+		Kubernetes: &v1alpha2.KubernetesComponent{
+			K8sLikeComponent: v1alpha2.K8sLikeComponent{
+				K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+					Inlined: manifest,
+				},
+			},
+		},
+	})
+}
+
+func Test_renderDeployCommand(t *testing.T) {
+	const deploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        image: %s
+`
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		getTestKubernetesComponent("deploy-v1", fmt.Sprintf(deploymentManifest, "my-image:v1")),
+		getTestKubernetesComponent("deploy-v2", fmt.Sprintf(deploymentManifest, "my-image:v2")),
+		getTestKubernetesComponent("service", `
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-svc
+spec:
+  selector:
+    app: my-app
+`),
+		generator.GetContainerComponent(generator.ContainerComponentParams{
+			Name:      "runtime",
+			Container: v1alpha2.Container{Image: "my-tools"},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddCommands([]v1alpha2.Command{
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "apply-v1", Component: "deploy-v1"}),
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "apply-v2", Component: "deploy-v2"}),
+		generator.GetApplyCommand(generator.ApplyCommandParams{Id: "apply-service", Component: "service"}),
+		generator.GetExecCommand(generator.ExecCommandParams{Id: "migrate", Component: "runtime", CommandLine: "make migrate"}),
+		generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:        "deploy",
+			Commands:  []string{"apply-v1", "apply-service", "migrate", "apply-v2"},
+			Kind:      v1alpha2.DeployCommandGroupKind,
+			IsDefault: pointer.Bool(true),
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := renderDeployCommand(getTestContext(), parser.DevfileObj{Data: devfileData})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The Deployment applied twice is rendered once, with its last definition, and the exec command is skipped
+	var got []string
+	for _, u := range resources {
+		if mode := odolabels.GetMode(u.GetLabels()); mode != odolabels.ComponentDeployMode {
+			t.Errorf("mode of %s %q = %q, want %q", u.GetKind(), u.GetName(), mode, odolabels.ComponentDeployMode)
+		}
+		desc := u.GetKind() + "/" + u.GetName()
+		if containers, found, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers"); found {
+			desc += " " + containers[0].(map[string]interface{})["image"].(string)
+		}
+		got = append(got, desc)
+	}
+	want := []string{"Deployment/my-app my-image:v2", "Service/my-svc"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("renderDeployCommand() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_replaceHelmPlaceholders(t *testing.T) {
+	// More than 10 variables, so that a placeholder followed by a digit could be read as another placeholder
+	names := make([]string, 12)
+	for i := range names {
+		names[i] = fmt.Sprintf("VAR_%02d", i)
+	}
+	placeholders := getHelmPlaceholders(names)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "variable followed by a digit",
+			content: "image: " + placeholders["VAR_01"] + "1",
+			want:    "image: {{ .Values.VAR_01 }}1",
+		},
+		{
+			name:    "variables in an image name",
+			content: "image: quay.io/" + placeholders["VAR_11"] + "/app:" + placeholders["VAR_00"],
+			want:    "image: quay.io/{{ .Values.VAR_11 }}/app:{{ .Values.VAR_00 }}",
+		},
+		{
+			name:    "unknown placeholder",
+			content: "name: odovar12end",
+			want:    "name: odovar12end",
+		},
+		{
+			name:    "variable as the value of a key",
+			content: "- name: PORT\n  value: " + placeholders["VAR_03"] + "\n",
+			want:    "- name: PORT\n  value: {{ .Values.VAR_03 | quote }}\n",
+		},
+		{
+			name:    "variable as an item of a list",
+			content: "args:\n- " + placeholders["VAR_04"] + "\n- - " + placeholders["VAR_05"] + "\n",
+			want:    "args:\n- {{ .Values.VAR_04 | quote }}\n- - {{ .Values.VAR_05 | quote }}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replaceHelmPlaceholders(tt.content, names); got != tt.want {
+				t.Errorf("replaceHelmPlaceholders() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getHelmChartTemplate_envValue(t *testing.T) {
+	// The Devfile defines the variable PORT: "8080", used in the manifest as env value: "{{PORT}}"
+	names := []string{"PORT"}
+	deployment := getTestResource("Deployment", "my-app")
+	err := unstructured.SetNestedSlice(deployment.Object, []interface{}{
+		map[string]interface{}{
+			"name":  "PORT",
+			"value": getHelmPlaceholders(names)["PORT"],
+		},
+	}, "spec", "env")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := getManifestFiles([]unstructured.Unstructured{deployment}, "templates", func(content string) string {
+		return replaceHelmPlaceholders(content, names)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := string(files[filepath.Join("templates", "deployment-my-app.yaml")])
+	if !strings.Contains(content, "value: {{ .Values.PORT | quote }}") {
+		t.Fatalf("value of the env var not quoted in template:\n%s", content)
+	}
+
+	// Render the template as Helm does, with the quote function of Helm
+	tmpl, err := template.New("deployment").Funcs(template.FuncMap{
+		"quote": func(v interface{}) string {
+			return strconv.Quote(fmt.Sprint(v))
+		},
+	}).Parse(content)
+	if err != nil {
+		t.Fatalf("unable to parse template: %v", err)
+	}
+	var rendered bytes.Buffer
+	err = tmpl.Execute(&rendered, map[string]interface{}{
+		"Values": map[string]interface{}{"PORT": "8080"},
+	})
+	if err != nil {
+		t.Fatalf("unable to render template: %v", err)
+	}
+	var got unstructured.Unstructured
+	err = yaml.Unmarshal(rendered.Bytes(), &got.Object)
+	if err != nil {
+		t.Fatalf("unable to unmarshal rendered template: %v", err)
+	}
+	env, _, _ := unstructured.NestedSlice(got.Object, "spec", "env")
+	want := []interface{}{
+		map[string]interface{}{
+			"name":  "PORT",
+			"value": "8080",
+		},
+	}
+	if diff := cmp.Diff(want, env); diff != "" {
+		t.Errorf("rendered env mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/storage"
+)
+
+// Quadlet returns the Podman Quadlet units running the containers of the Devfile as odo dev does on Podman, indexed by file name:
+// a .container unit for each container, a .volume unit for each volume, and a .network unit.
+// The first container is connected to the network and publishes the ports of all the containers;
+// the other containers share its network, as the containers of a Pod do.
+// See https://docs.podman.io/en/latest/markdown/podman-systemd.unit.5.html
+func Quadlet(ctx context.Context, devfileObj parser.DevfileObj) (map[string][]byte, error) {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		workingDir    = odocontext.GetWorkingDirectory(ctx)
+	)
+
+	pod, err := getDevPod(ctx, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	claims := getClaimNames(pod)
+
+	network, err := component.GetPodmanNetworkName(componentName, appName)
+	if err != nil {
+		return nil, err
+	}
+	networkFile := pod.GetName() + ".network"
+	result := make(map[string][]byte)
+
+	var networkUnit quadletUnit
+	networkUnit.section("Unit")
+	networkUnit.set("Description", fmt.Sprintf("Network of the component %s", componentName))
+	networkUnit.section("Network")
+	networkUnit.set("NetworkName", network)
+	result[networkFile] = networkUnit.bytes()
+
+	getContainerName := func(container string) string {
+		return pod.GetName() + "-" + container
+	}
+	first := getContainerName(pod.Spec.Containers[0].Name)
+	for i, container := range pod.Spec.Containers {
+		var unit quadletUnit
+		unit.section("Unit")
+		unit.set("Description", fmt.Sprintf("Container %s of the component %s", container.Name, componentName))
+		if i != 0 {
+			unit.set("Requires", first+".service")
+			unit.set("After", first+".service")
+		}
+
+		unit.section("Container")
+		unit.set("ContainerName", getContainerName(container.Name))
+		unit.set("Image", container.Image)
+
+		// The Exec key only sets the arguments of the entrypoint
+		exec := container.Args
+		if len(container.Command) != 0 {
+			unit.set("Entrypoint", container.Command[0])
+			exec = append(append([]string{}, container.Command[1:]...), container.Args...)
+		}
+		if len(exec) != 0 {
+			quoted := make([]string, 0, len(exec))
+			for _, arg := range exec {
+				quoted = append(quoted, quoteSystemd(arg))
+			}
+			unit.set("Exec", strings.Join(quoted, " "))
+		}
+
+		for _, env := range getEnv(container) {
+			unit.set("Environment", quoteSystemd(env.Name+"="+env.Value))
+		}
+
+		if i == 0 {
+			unit.set("Network", networkFile)
+			for _, port := range getPublishedPorts(pod) {
+				unit.set("PublishPort", port)
+			}
+		} else {
+			unit.set("Network", "container:"+first)
+		}
+
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == storage.OdoSourceVolume {
+				unit.set("Volume", quoteSystemd(workingDir+":"+mount.MountPath))
+				continue
+			}
+			claim, ok := claims[mount.Name]
+			if !ok {
+				return nil, fmt.Errorf("volume %q of container %q not found", mount.Name, container.Name)
+			}
+			volumeFile := claim + ".volume"
+			if _, found := result[volumeFile]; !found {
+				var volumeUnit quadletUnit
+				volumeUnit.section("Volume")
+				volumeUnit.set("VolumeName", claim)
+				result[volumeFile] = volumeUnit.bytes()
+			}
+			unit.set("Volume", quoteSystemd(volumeFile+":"+mount.MountPath))
+		}
+
+		if memory := container.Resources.Limits.Memory(); !memory.IsZero() {
+			unit.set("PodmanArgs", "--memory="+strconv.FormatInt(memory.Value(), 10))
+		}
+		if cpu := container.Resources.Limits.Cpu(); !cpu.IsZero() {
+			unit.set("PodmanArgs", "--cpus="+strconv.FormatFloat(float64(cpu.MilliValue())/1000, 'f', -1, 64))
+		}
+
+		unit.section("Install")
+		unit.set("WantedBy", "default.target")
+
+		result[getContainerName(container.Name)+".container"] = unit.bytes()
+	}
+	return result, nil
+}
+
+// quadletUnit is the content of a systemd unit file, whose sections and keys are written in order
+type quadletUnit struct {
+	buf bytes.Buffer
+}
+
+func (o *quadletUnit) section(name string) {
+	if o.buf.Len() != 0 {
+		o.buf.WriteString("\n")
+	}
+	fmt.Fprintf(&o.buf, "[%s]\n", name)
+}
+
+func (o *quadletUnit) set(key, value string) {
+	// The % characters are specifiers for systemd
+	fmt.Fprintf(&o.buf, "%s=%s\n", key, strings.ReplaceAll(value, "%", "%%"))
+}
+
+func (o *quadletUnit) bytes() []byte {
+	return o.buf.Bytes()
+}
+
+// quoteSystemd quotes the value, if needed, following the quoting rules of systemd
+func quoteSystemd(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\"'\\") {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(value) + `"`
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQuadlet(t *testing.T) {
+	want := map[string]string{
+		"mycmp-app.network": `[Unit]
+Description=Network of the component mycmp
+
+[Network]
+NetworkName=odo-mycmp-app
+`,
+		"cache-mycmp-app.volume": `[Volume]
+VolumeName=cache-mycmp-app
+`,
+		"mycmp-app-runtime.container": `[Unit]
+Description=Container runtime of the component mycmp
+
+[Container]
+ContainerName=mycmp-app-runtime
+Image=myimage
+Environment="GREETING=hello $USER"
+Environment=PROJECTS_ROOT=/projects
+Environment=PROJECT_SOURCE=/projects
+Network=mycmp-app.network
+PublishPort=127.0.0.1:8080:8080
+PublishPort=127.0.0.1:5432:5432
+Volume=/tmp/dir:/projects
+Volume=cache-mycmp-app.volume:/cache
+PodmanArgs=--memory=536870912
+
+[Install]
+WantedBy=default.target
+`,
+		"mycmp-app-db.container": `[Unit]
+Description=Container db of the component mycmp
+Requires=mycmp-app-runtime.service
+After=mycmp-app-runtime.service
+
+[Container]
+ContainerName=mycmp-app-db
+Image=postgres
+Entrypoint=docker-entrypoint.sh
+Exec=postgres
+Network=container:mycmp-app-runtime
+
+[Install]
+WantedBy=default.target
+`,
+	}
+
+	units, err := Quadlet(getTestContext(), getTestDevfileObj(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string, len(units))
+	for name, content := range units {
+		got[name] = string(content)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Quadlet() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_quoteSystemd(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "simple", want: "simple"},
+		{value: "", want: `""`},
+		{value: "with space", want: `"with space"`},
+		{value: `with "quotes" and \`, want: `"with \"quotes\" and \\"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := quoteSystemd(tt.value); got != tt.want {
+				t.Errorf("quoteSystemd() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
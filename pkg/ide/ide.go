@@ -0,0 +1,101 @@
+// Package ide generates the configurations used by the IDEs to attach their debugger to the application
+// running in the Dev mode with `odo dev --debug`
+package ide
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/devfile"
+)
+
+// Debugger is the debugger listening in the container, depending on the language of the application
+type Debugger string
+
+const (
+	// DebuggerJava is the Java Debug Wire Protocol (JDWP) agent of the JVM
+	DebuggerJava Debugger = "java"
+	// DebuggerNode is the inspector of Node.js
+	DebuggerNode Debugger = "node"
+	// DebuggerPython is debugpy
+	DebuggerPython Debugger = "python"
+	// DebuggerGo is Delve, in headless mode
+	DebuggerGo Debugger = "go"
+)
+
+// IDE is an IDE for which configurations are generated
+type IDE string
+
+const (
+	VSCode    IDE = "vscode"
+	JetBrains IDE = "jetbrains"
+)
+
+// SupportedIDEs lists the IDEs for which configurations are generated
+var SupportedIDEs = []IDE{VSCode, JetBrains}
+
+// debuggersByLanguage associates the languages and project types of the Devfile metadata, in lower case, with their debuggers
+var debuggersByLanguage = map[string]Debugger{
+	"java":        DebuggerJava,
+	"kotlin":      DebuggerJava,
+	"quarkus":     DebuggerJava,
+	"springboot":  DebuggerJava,
+	"spring":      DebuggerJava,
+	"vertx":       DebuggerJava,
+	"micronaut":   DebuggerJava,
+	"wildfly":     DebuggerJava,
+	"openliberty": DebuggerJava,
+	"javascript":  DebuggerNode,
+	"typescript":  DebuggerNode,
+	"node":        DebuggerNode,
+	"nodejs":      DebuggerNode,
+	"node.js":     DebuggerNode,
+	"express":     DebuggerNode,
+	"nextjs":      DebuggerNode,
+	"nuxt.js":     DebuggerNode,
+	"python":      DebuggerPython,
+	"django":      DebuggerPython,
+	"flask":       DebuggerPython,
+	"fastapi":     DebuggerPython,
+	"go":          DebuggerGo,
+	"golang":      DebuggerGo,
+}
+
+// ParseDebugger returns the debugger of the language, as written in the Devfile metadata or passed by the user
+func ParseDebugger(language string) (Debugger, error) {
+	if debugger, ok := debuggersByLanguage[strings.ToLower(strings.TrimSpace(language))]; ok {
+		return debugger, nil
+	}
+	return "", fmt.Errorf("unsupported language %q, supported languages are: %s", language, strings.Join(supportedLanguages(), ", "))
+}
+
+// DetectDebugger returns the debugger of the application, based on the language, or else the project type, of the Devfile metadata
+func DetectDebugger(metadata devfile.DevfileMetadata) (Debugger, error) {
+	for _, value := range []string{metadata.Language, metadata.ProjectType} {
+		if debugger, err := ParseDebugger(value); err == nil {
+			return debugger, nil
+		}
+	}
+	return "", fmt.Errorf("unable to detect the debugger from the language %q and project type %q of the Devfile, use --language to set it", metadata.Language, metadata.ProjectType)
+}
+
+func supportedLanguages() []string {
+	result := make([]string, 0, len(debuggersByLanguage))
+	for language := range debuggersByLanguage {
+		result = append(result, language)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Target is a debug port forwarded by odo dev, to which the debugger of the IDE attaches
+type Target struct {
+	// Name is the name of the configuration
+	Name string
+	// Host and Port are the local address of the forwarded debug port
+	Host string
+	Port int
+	// RemoteRoot is the directory into which the sources are synchronized in the container, used to map the remote sources to the local ones
+	RemoteRoot string
+}
@@ -0,0 +1,53 @@
+package ide
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/devfile"
+)
+
+func TestDetectDebugger(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata devfile.DevfileMetadata
+		want     Debugger
+		wantErr  bool
+	}{
+		{
+			name:     "language",
+			metadata: devfile.DevfileMetadata{Language: "Java", ProjectType: "Quarkus"},
+			want:     DebuggerJava,
+		},
+		{
+			name:     "project type if language is unknown",
+			metadata: devfile.DevfileMetadata{Language: "TS", ProjectType: "Node.js"},
+			want:     DebuggerNode,
+		},
+		{
+			name:     "Python",
+			metadata: devfile.DevfileMetadata{Language: "Python", ProjectType: "Django"},
+			want:     DebuggerPython,
+		},
+		{
+			name:     "Go",
+			metadata: devfile.DevfileMetadata{Language: "Go", ProjectType: "Go"},
+			want:     DebuggerGo,
+		},
+		{
+			name:     "unsupported",
+			metadata: devfile.DevfileMetadata{Language: ".NET", ProjectType: "dotnet"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectDebugger(tt.metadata)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DetectDebugger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectDebugger() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ide
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+)
+
+// JetBrainsRunDirectory is the directory containing the run configurations shared with the project, relative to the project
+const JetBrainsRunDirectory = ".run"
+
+// JetBrainsRunConfiguration is a run configuration of the JetBrains IDEs, stored in its own file
+type JetBrainsRunConfiguration struct {
+	// File is the path of the file of the run configuration, relative to the project
+	File    string
+	Content []byte
+}
+
+var unsafeFileCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// GetJetBrainsConfigurations returns the run configurations attaching the debugger of the JetBrains IDEs
+// (IntelliJ IDEA, WebStorm or GoLand, depending on the language) to the targets
+func GetJetBrainsConfigurations(debugger Debugger, targets []Target) ([]JetBrainsRunConfiguration, error) {
+	result := make([]JetBrainsRunConfiguration, 0, len(targets))
+	for _, target := range targets {
+		configuration, err := getJetBrainsConfiguration(debugger, target)
+		if err != nil {
+			return nil, err
+		}
+		var content bytes.Buffer
+		content.WriteString(`<component name="ProjectRunConfigurationManager">` + "\n")
+		content.WriteString(configuration)
+		content.WriteString("</component>\n")
+		result = append(result, JetBrainsRunConfiguration{
+			File:    path.Join(JetBrainsRunDirectory, unsafeFileCharacters.ReplaceAllString(target.Name, "_")+".run.xml"),
+			Content: content.Bytes(),
+		})
+	}
+	return result, nil
+}
+
+func getJetBrainsConfiguration(debugger Debugger, target Target) (string, error) {
+	var (
+		name = escapeXML(target.Name)
+		host = escapeXML(target.Host)
+		port = strconv.Itoa(target.Port)
+	)
+	switch debugger {
+	case DebuggerJava:
+		return fmt.Sprintf(`  <configuration default="false" name="%[1]s" type="Remote">
+    <option name="USE_SOCKET_TRANSPORT" value="true" />
+    <option name="SERVER_MODE" value="false" />
+    <option name="SHMEM_ADDRESS" />
+    <option name="HOST" value="%[2]s" />
+    <option name="PORT" value="%[3]s" />
+    <option name="AUTO_RESTART" value="true" />
+    <RunnerSettings RunnerId="Debug">
+      <option name="DEBUG_PORT" value="%[3]s" />
+      <option name="LOCAL" value="false" />
+    </RunnerSettings>
+    <method v="2" />
+  </configuration>
+`, name, host, port), nil
+	case DebuggerNode:
+		return fmt.Sprintf(`  <configuration default="false" name="%[1]s" type="ChromiumRemoteDebugType" factoryName="Chromium Remote" host="%[2]s" port="%[3]s" restartOnDisconnect="true">
+    <mappings>
+      <mapping url="%[4]s" local-file="$PROJECT_DIR$" />
+    </mappings>
+    <method v="2" />
+  </configuration>
+`, name, host, port, escapeXML(target.RemoteRoot)), nil
+	case DebuggerGo:
+		return fmt.Sprintf(`  <configuration default="false" name="%[1]s" type="GoRemoteDebugConfigurationType" factoryName="Go Remote" host="%[2]s" port="%[3]s">
+    <option name="disconnectOption" value="LEAVE" />
+    <disabled>false</disabled>
+    <method v="2" />
+  </configuration>
+`, name, host, port), nil
+	case DebuggerPython:
+		// The remote debug configurations of PyCharm wait for the application to connect to the IDE with pydevd,
+		// and cannot attach to a debugpy server listening in the container
+		return "", fmt.Errorf("attaching to debugpy is not supported by the JetBrains IDEs, use --ide %s", VSCode)
+	default:
+		return "", fmt.Errorf("unsupported debugger %q", debugger)
+	}
+}
+
+func escapeXML(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package ide
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetJetBrainsConfigurations(t *testing.T) {
+	tests := []struct {
+		debugger     Debugger
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			debugger:     DebuggerJava,
+			wantContains: []string{`type="Remote"`, `<option name="HOST" value="127.0.0.1" />`, `<option name="PORT" value="20002" />`},
+		},
+		{
+			debugger:     DebuggerNode,
+			wantContains: []string{`type="ChromiumRemoteDebugType"`, `port="20002"`, `<mapping url="/projects" local-file="$PROJECT_DIR$" />`},
+		},
+		{
+			debugger:     DebuggerGo,
+			wantContains: []string{`type="GoRemoteDebugConfigurationType"`, `host="127.0.0.1"`, `port="20002"`},
+		},
+		{
+			debugger: DebuggerPython,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.debugger), func(t *testing.T) {
+			got, err := GetJetBrainsConfigurations(tt.debugger, []Target{target})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetJetBrainsConfigurations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("GetJetBrainsConfigurations() returned %d configurations, want 1", len(got))
+			}
+			if got[0].File != ".run/odo_mycomponent.run.xml" {
+				t.Errorf("File = %q", got[0].File)
+			}
+			content := string(got[0].Content)
+			if !strings.HasPrefix(content, `<component name="ProjectRunConfigurationManager">`) || !strings.Contains(content, `name="odo: mycomponent"`) {
+				t.Errorf("unexpected content:\n%s", content)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(content, want) {
+					t.Errorf("content should contain %q:\n%s", want, content)
+				}
+			}
+		})
+	}
+}
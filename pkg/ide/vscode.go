@@ -0,0 +1,98 @@
+package ide
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// VSCodeLaunchFile is the path of the file containing the launch configurations of VS Code, relative to the workspace
+	VSCodeLaunchFile = ".vscode/launch.json"
+
+	vscodeLaunchVersion   = "0.2.0"
+	vscodeWorkspaceFolder = "${workspaceFolder}"
+)
+
+// GetVSCodeConfigurations returns the launch configurations attaching the debugger of VS Code to the targets
+func GetVSCodeConfigurations(debugger Debugger, targets []Target) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(targets))
+	for _, target := range targets {
+		config, err := getVSCodeConfiguration(debugger, target)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, config)
+	}
+	return result, nil
+}
+
+func getVSCodeConfiguration(debugger Debugger, target Target) (map[string]interface{}, error) {
+	config := map[string]interface{}{
+		"name":    target.Name,
+		"request": "attach",
+	}
+	switch debugger {
+	case DebuggerJava:
+		config["type"] = "java"
+		config["hostName"] = target.Host
+		config["port"] = target.Port
+	case DebuggerNode:
+		config["type"] = "node"
+		config["address"] = target.Host
+		config["port"] = target.Port
+		config["localRoot"] = vscodeWorkspaceFolder
+		config["remoteRoot"] = target.RemoteRoot
+		config["restart"] = true
+	case DebuggerPython:
+		config["type"] = "debugpy"
+		config["connect"] = map[string]interface{}{
+			"host": target.Host,
+			"port": target.Port,
+		}
+		config["pathMappings"] = []map[string]interface{}{
+			{"localRoot": vscodeWorkspaceFolder, "remoteRoot": target.RemoteRoot},
+		}
+	case DebuggerGo:
+		config["type"] = "go"
+		config["mode"] = "remote"
+		config["host"] = target.Host
+		config["port"] = target.Port
+		config["substitutePath"] = []map[string]interface{}{
+			{"from": vscodeWorkspaceFolder, "to": target.RemoteRoot},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported debugger %q", debugger)
+	}
+	return config, nil
+}
+
+// MergeVSCodeLaunch adds the configurations to the content of an existing launch.json file, if any,
+// replacing the configurations with the same names, and returns the new content of the file.
+// The other fields of the existing file are kept as is; the file must not contain comments.
+func MergeVSCodeLaunch(existing []byte, configurations []map[string]interface{}) ([]byte, error) {
+	launch := map[string]interface{}{
+		"version": vscodeLaunchVersion,
+	}
+	if len(existing) != 0 {
+		if err := json.Unmarshal(existing, &launch); err != nil {
+			return nil, fmt.Errorf("unable to parse the existing %s file, it may contain comments: %w", VSCodeLaunchFile, err)
+		}
+	}
+	existingConfigs, _ := launch["configurations"].([]interface{})
+
+	for _, config := range configurations {
+		replaced := false
+		for i, existingConfig := range existingConfigs {
+			if c, ok := existingConfig.(map[string]interface{}); ok && c["name"] == config["name"] {
+				existingConfigs[i] = config
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			existingConfigs = append(existingConfigs, config)
+		}
+	}
+	launch["configurations"] = existingConfigs
+	return json.MarshalIndent(launch, "", "  ")
+}
@@ -0,0 +1,109 @@
+package ide
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var target = Target{Name: "odo: mycomponent", Host: "127.0.0.1", Port: 20002, RemoteRoot: "/projects"}
+
+func TestGetVSCodeConfigurations(t *testing.T) {
+	tests := []struct {
+		debugger Debugger
+		want     map[string]interface{}
+	}{
+		{
+			debugger: DebuggerJava,
+			want: map[string]interface{}{
+				"name": "odo: mycomponent", "request": "attach", "type": "java", "hostName": "127.0.0.1", "port": 20002,
+			},
+		},
+		{
+			debugger: DebuggerNode,
+			want: map[string]interface{}{
+				"name": "odo: mycomponent", "request": "attach", "type": "node", "address": "127.0.0.1", "port": 20002,
+				"localRoot": "${workspaceFolder}", "remoteRoot": "/projects", "restart": true,
+			},
+		},
+		{
+			debugger: DebuggerPython,
+			want: map[string]interface{}{
+				"name": "odo: mycomponent", "request": "attach", "type": "debugpy",
+				"connect":      map[string]interface{}{"host": "127.0.0.1", "port": 20002},
+				"pathMappings": []map[string]interface{}{{"localRoot": "${workspaceFolder}", "remoteRoot": "/projects"}},
+			},
+		},
+		{
+			debugger: DebuggerGo,
+			want: map[string]interface{}{
+				"name": "odo: mycomponent", "request": "attach", "type": "go", "mode": "remote", "host": "127.0.0.1", "port": 20002,
+				"substitutePath": []map[string]interface{}{{"from": "${workspaceFolder}", "to": "/projects"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.debugger), func(t *testing.T) {
+			got, err := GetVSCodeConfigurations(tt.debugger, []Target{target})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]map[string]interface{}{tt.want}, got); diff != "" {
+				t.Errorf("GetVSCodeConfigurations() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeVSCodeLaunch(t *testing.T) {
+	existing := []byte(`{
+  "version": "0.2.0",
+  "configurations": [
+    {"name": "local", "type": "node", "request": "launch"},
+    {"name": "odo: mycomponent", "type": "node", "request": "attach", "port": 20001}
+  ],
+  "compounds": []
+}`)
+	configs := []map[string]interface{}{
+		{"name": "odo: mycomponent", "type": "node", "request": "attach", "port": 20002},
+		{"name": "odo: other", "type": "node", "request": "attach", "port": 20003},
+	}
+
+	content, err := MergeVSCodeLaunch(existing, configs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err = json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"version": "0.2.0",
+		"configurations": []interface{}{
+			map[string]interface{}{"name": "local", "type": "node", "request": "launch"},
+			map[string]interface{}{"name": "odo: mycomponent", "type": "node", "request": "attach", "port": float64(20002)},
+			map[string]interface{}{"name": "odo: other", "type": "node", "request": "attach", "port": float64(20003)},
+		},
+		"compounds": []interface{}{},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MergeVSCodeLaunch() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = MergeVSCodeLaunch([]byte("// comment\n{}"), configs)
+	if err == nil {
+		t.Error("MergeVSCodeLaunch() should fail on a file with comments")
+	}
+
+	content, err = MergeVSCodeLaunch(nil, configs[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["version"] != "0.2.0" || len(got["configurations"].([]interface{})) != 1 {
+		t.Errorf("MergeVSCodeLaunch() unexpected new file: %s", string(content))
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
+
+	"github.com/moby/term"
 
 	// api resource types
 
@@ -15,10 +18,14 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
+	kterm "k8s.io/kubectl/pkg/util/term"
 
 	"github.com/redhat-developer/odo/pkg/platform"
 )
 
+// terminalSizePollInterval is the interval at which the size of the local terminal is checked during an interactive exec
+const terminalSizePollInterval = 250 * time.Millisecond
+
 // ExecCMDInContainer execute command in the container of a pod, pass an empty string for containerName to execute in the first container of the pod
 func (c *Client) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
 	podExecOptions := corev1.PodExecOptions{
@@ -52,13 +59,22 @@ func (c *Client) ExecCMDInContainer(ctx context.Context, containerName, podName
 	if err != nil {
 		return fmt.Errorf("unable execute command via SPDY: %w", err)
 	}
-	// initialize the transport of the standard shell streams
-	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+	streamOptions := remotecommand.StreamOptions{
 		Stdin:  stdin,
 		Stdout: stdout,
 		Stderr: stderr,
 		Tty:    tty,
-	})
+	}
+	if tty {
+		// Forward the size of the local terminal, if any, to the remote terminal
+		if outFd, isTerminal := term.GetFdInfo(stdout); isTerminal {
+			done := make(chan struct{})
+			defer close(done)
+			streamOptions.TerminalSizeQueue = &terminalSizeQueue{fd: outFd, done: done}
+		}
+	}
+	// initialize the transport of the standard shell streams
+	err = exec.StreamWithContext(ctx, streamOptions)
 	if err != nil {
 		return fmt.Errorf("error while streaming command: %w", err)
 	}
@@ -66,6 +82,31 @@ func (c *Client) ExecCMDInContainer(ctx context.Context, containerName, podName
 	return nil
 }
 
+// terminalSizeQueue returns the size of the local terminal each time it changes, until done is closed.
+// The size is polled, as the terminal resize events are not available on all the operating systems.
+type terminalSizeQueue struct {
+	fd   uintptr
+	last *remotecommand.TerminalSize
+	done <-chan struct{}
+}
+
+var _ remotecommand.TerminalSizeQueue = (*terminalSizeQueue)(nil)
+
+func (o *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	for {
+		size := kterm.GetSize(o.fd)
+		if size != nil && (o.last == nil || *size != *o.last) {
+			o.last = size
+			return size
+		}
+		select {
+		case <-o.done:
+			return nil
+		case <-time.After(terminalSizePollInterval):
+		}
+	}
+}
+
 // GetPodUsingComponentName gets a pod using the component name
 func (c *Client) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
 	podSelector := fmt.Sprintf("component=%s", componentName)
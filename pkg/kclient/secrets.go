@@ -89,6 +89,7 @@ func GenerateSelfSignedCertificate(host string) (SelfSignedCertificate, error) {
 	certPemByteArr := []byte(certPemEncode)
 
 	tlsPrivKeyEncoding := x509.MarshalPKCS1PrivateKey(privateKey)
+	out = &bytes.Buffer{}
 	err = pem.Encode(out, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: tlsPrivKeyEncoding})
 	if err != nil {
 		return SelfSignedCertificate{}, fmt.Errorf("unable to encode rsa private key: %w", err)
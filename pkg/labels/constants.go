@@ -36,6 +36,12 @@ const (
 	devfileStorageLabel = "storage-name"
 
 	sourcePVCLabel = "odo-source-pvc"
+
+	// exposedEndpointLabel is applied to the Ingresses and Routes exposing the endpoints of a component in Dev mode, with the name of the endpoint
+	exposedEndpointLabel = "odo.dev/exposed-endpoint"
+
+	// specHashLabel is applied to the pods created on Podman from Kubernetes components, with the hash of their definition
+	specHashLabel = "odo.dev/spec-hash"
 )
 
 const (
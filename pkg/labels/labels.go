@@ -178,6 +178,24 @@ func GetMode(labels map[string]string) string {
 	return labels[odoModeLabel]
 }
 
+// AddExposedEndpoint adds the label identifying the Ingresses and Routes exposing the endpoint in Dev mode
+func AddExposedEndpoint(labels map[string]string, endpointName string) {
+	labels[exposedEndpointLabel] = endpointName
+}
+
+func GetExposedEndpoint(labels map[string]string) string {
+	return labels[exposedEndpointLabel]
+}
+
+// SetSpecHash adds the label containing the hash of the definition of a pod created on Podman from a Kubernetes component
+func SetSpecHash(labels map[string]string, hash string) {
+	labels[specHashLabel] = hash
+}
+
+func GetSpecHash(labels map[string]string) string {
+	return labels[specHashLabel]
+}
+
 // IsProjectTypeSetInAnnotations checks if the ProjectType annotation is set;
 // this function is helpful in identifying if a resource is created by odo
 func IsProjectTypeSetInAnnotations(annotations map[string]string) bool {
@@ -215,6 +233,11 @@ func GetSelector(componentName string, applicationName string, mode string, isPa
 	return labels.String()
 }
 
+// GetExposedEndpointsSelector returns a selector string used for selection of the Ingresses and Routes exposing the endpoints of the component in Dev mode
+func GetExposedEndpointsSelector(componentName string, applicationName string) string {
+	return GetSelector(componentName, applicationName, ComponentDevMode, true) + "," + exposedEndpointLabel
+}
+
 func GetNameSelector(componentName string) string {
 	labels := k8slabels.Set{
 		kubernetesInstanceLabel: componentName,
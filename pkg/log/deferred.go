@@ -0,0 +1,47 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// DeferredWriter is a writer keeping the content written until Release is called.
+// The content written after Release is written directly to the underlying writer.
+type DeferredWriter struct {
+	out io.Writer
+
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	released bool
+}
+
+var _ io.Writer = (*DeferredWriter)(nil)
+
+// NewDeferredWriter returns a DeferredWriter writing to out once released
+func NewDeferredWriter(out io.Writer) *DeferredWriter {
+	return &DeferredWriter{
+		out: out,
+	}
+}
+
+func (o *DeferredWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.released {
+		return o.out.Write(p)
+	}
+	return o.buf.Write(p)
+}
+
+// Release writes the content kept to the underlying writer. The next writes are not deferred anymore.
+func (o *DeferredWriter) Release() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.released {
+		return nil
+	}
+	o.released = true
+	_, err := o.buf.WriteTo(o.out)
+	return err
+}
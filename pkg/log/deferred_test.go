@@ -0,0 +1,34 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeferredWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := NewDeferredWriter(&out)
+
+	_, err := w.Write([]byte("line 1\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("content written before release: %q", out.String())
+	}
+
+	if err = w.Release(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = w.Write([]byte("line 2\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = w.Release(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := out.String(), "line 1\nline 2\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
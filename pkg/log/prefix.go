@@ -0,0 +1,69 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixWriter is a writer prefixing each line written to the underlying writer.
+// The lines are written to the underlying writer only once complete, so the lines
+// written concurrently by several PrefixWriter sharing the same underlying writer are not mixed.
+type PrefixWriter struct {
+	out    io.Writer
+	prefix []byte
+
+	mu sync.Mutex
+	// buf contains the last line written, not yet terminated by a newline character
+	buf []byte
+}
+
+var _ io.Writer = (*PrefixWriter)(nil)
+
+// NewPrefixWriter returns a PrefixWriter writing to out the lines prefixed with prefix
+func NewPrefixWriter(out io.Writer, prefix string) *PrefixWriter {
+	return &PrefixWriter{
+		out:    out,
+		prefix: []byte(prefix),
+	}
+}
+
+func (o *PrefixWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.buf = append(o.buf, p...)
+	var lines []byte
+	for {
+		i := bytes.IndexByte(o.buf, '\n')
+		if i < 0 {
+			break
+		}
+		lines = append(lines, o.prefix...)
+		lines = append(lines, o.buf[:i+1]...)
+		o.buf = o.buf[i+1:]
+	}
+	if len(lines) == 0 {
+		return len(p), nil
+	}
+	_, err := o.out.Write(lines)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes the last line, not terminated by a newline character, to the underlying writer
+func (o *PrefixWriter) Flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(o.buf) == 0 {
+		return nil
+	}
+	line := append(append([]byte{}, o.prefix...), o.buf...)
+	line = append(line, '\n')
+	o.buf = nil
+	_, err := o.out.Write(line)
+	return err
+}
@@ -0,0 +1,60 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrefixWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		flush  bool
+		want   string
+	}{
+		{
+			name:   "complete lines",
+			writes: []string{"line 1\nline 2\n"},
+			want:   "[api] line 1\n[api] line 2\n",
+		},
+		{
+			name:   "line written in several parts",
+			writes: []string{"li", "ne 1\nline", " 2\n"},
+			want:   "[api] line 1\n[api] line 2\n",
+		},
+		{
+			name:   "last line not terminated",
+			writes: []string{"line 1\nline 2"},
+			want:   "[api] line 1\n",
+		},
+		{
+			name:   "last line not terminated, flushed",
+			writes: []string{"line 1\nline 2"},
+			flush:  true,
+			want:   "[api] line 1\n[api] line 2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := NewPrefixWriter(&out, "[api] ")
+			for _, s := range tt.writes {
+				n, err := w.Write([]byte(s))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if n != len(s) {
+					t.Errorf("Write() = %d, want %d", n, len(s))
+				}
+			}
+			if tt.flush {
+				if err := w.Flush(); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
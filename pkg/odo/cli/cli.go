@@ -21,11 +21,15 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/alizer"
 	"github.com/redhat-developer/odo/pkg/odo/cli/build_images"
 	"github.com/redhat-developer/odo/pkg/odo/cli/completion"
+	"github.com/redhat-developer/odo/pkg/odo/cli/cp"
 	"github.com/redhat-developer/odo/pkg/odo/cli/create"
 	_delete "github.com/redhat-developer/odo/pkg/odo/cli/delete"
 	"github.com/redhat-developer/odo/pkg/odo/cli/deploy"
 	"github.com/redhat-developer/odo/pkg/odo/cli/describe"
 	"github.com/redhat-developer/odo/pkg/odo/cli/dev"
+	"github.com/redhat-developer/odo/pkg/odo/cli/exec"
+	"github.com/redhat-developer/odo/pkg/odo/cli/export"
+	filescmd "github.com/redhat-developer/odo/pkg/odo/cli/files/cmd"
 	_init "github.com/redhat-developer/odo/pkg/odo/cli/init"
 	"github.com/redhat-developer/odo/pkg/odo/cli/list"
 	"github.com/redhat-developer/odo/pkg/odo/cli/login"
@@ -203,6 +207,10 @@ func odoRootCmd(ctx context.Context, name, fullName string, unknownCmdHandler fu
 		logs.NewCmdLogs(logs.RecommendedCommandName, util.GetFullName(fullName, logs.RecommendedCommandName), testClientset),
 		completion.NewCmdCompletion(completion.RecommendedCommandName, util.GetFullName(fullName, completion.RecommendedCommandName)),
 		run.NewCmdRun(run.RecommendedCommandName, util.GetFullName(fullName, run.RecommendedCommandName), testClientset),
+		exec.NewCmdExec(exec.RecommendedCommandName, util.GetFullName(fullName, exec.RecommendedCommandName), testClientset),
+		cp.NewCmdCp(cp.RecommendedCommandName, util.GetFullName(fullName, cp.RecommendedCommandName), testClientset),
+		export.NewCmdExport(export.RecommendedCommandName, util.GetFullName(fullName, export.RecommendedCommandName), testClientset),
+		filescmd.NewCmdFiles(filescmd.RecommendedCommandName, util.GetFullName(fullName, filescmd.RecommendedCommandName), testClientset),
 	)
 	if feature.IsExperimentalModeEnabled(ctx) {
 		rootCmdList = append(rootCmdList, apiserver.NewCmdApiServer(ctx, apiserver.RecommendedCommandName, util.GetFullName(fullName, apiserver.RecommendedCommandName), testClientset))
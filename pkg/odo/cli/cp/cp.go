@@ -0,0 +1,220 @@
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+const (
+	RecommendedCommandName = "cp"
+
+	// remotePrefix is the prefix of the paths in the container
+	remotePrefix = ":"
+)
+
+type CpOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Args
+	localPath  string
+	remotePath string
+	// upload is true if the local file or directory is copied into the container
+	upload bool
+
+	// Flags
+	containerFlag string
+
+	// Variables
+	platformClient platform.Client
+}
+
+var _ genericclioptions.Runnable = (*CpOptions)(nil)
+
+func NewCpOptions() *CpOptions {
+	return &CpOptions{}
+}
+
+var cpExample = ktemplates.Examples(`
+	# Copy a local file into the /tmp directory of the container of the run command of the component running in the Dev mode
+	%[1]s ./config.yaml :/tmp
+
+	# Copy the test reports directory, relative to the project directory in the container, to the local machine
+	%[1]s :target/reports ./reports
+
+	# Copy a heap dump from the container named "runtime"
+	%[1]s --container runtime :/tmp/heap.hprof ./heap.hprof
+`)
+
+func (o *CpOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *CpOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	var err error
+	o.localPath, o.remotePath, o.upload, err = parsePaths(args[0], args[1])
+	return err
+}
+
+// parsePaths returns the local and remote paths passed as source and destination,
+// and whether the file or directory is copied from the local machine into the container.
+// Exactly one of the paths must be a path in the container, prefixed with a colon.
+func parsePaths(src, dest string) (localPath string, remotePath string, upload bool, err error) {
+	srcRemote := strings.HasPrefix(src, remotePrefix)
+	destRemote := strings.HasPrefix(dest, remotePrefix)
+	switch {
+	case srcRemote && destRemote:
+		return "", "", false, errors.New("copying files between two paths in the container is not supported, use odo exec instead")
+	case !srcRemote && !destRemote:
+		return "", "", false, errors.New("one of the paths must be a path in the container, prefixed with ':', for example :/tmp")
+	case srcRemote:
+		localPath, remotePath = dest, strings.TrimPrefix(src, remotePrefix)
+	default:
+		localPath, remotePath, upload = src, strings.TrimPrefix(dest, remotePrefix), true
+	}
+	if localPath == "" {
+		return "", "", false, errors.New("the local path cannot be empty")
+	}
+	if remotePath == "" {
+		return "", "", false, errors.New("the path in the container cannot be empty")
+	}
+	return localPath, remotePath, upload, nil
+}
+
+func (o *CpOptions) Validate(ctx context.Context) error {
+	if odocontext.GetEffectiveDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+		o.platformClient = o.clientset.KubernetesClient
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+		o.platformClient = o.clientset.PodmanClient
+	}
+	return nil
+}
+
+func (o *CpOptions) Run(ctx context.Context) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+	)
+
+	pod, err := component.GetRunningDevPod(o.platformClient, componentName, appName)
+	if err != nil {
+		return err
+	}
+
+	containerName, err := component.GetDevContainerName(o.containerFlag, component.GetContainersNames(pod), *devfileObj)
+	if err != nil {
+		return err
+	}
+
+	syncFolder := getSyncFolder(pod, containerName)
+	if syncFolder == "" && !strings.HasPrefix(o.remotePath, "/") {
+		return fmt.Errorf("the sources are not mounted in the container %q, the path in the container must be absolute", containerName)
+	}
+
+	copyParameters := sync.CopyParameters{
+		LocalPath:  o.localPath,
+		RemotePath: o.remotePath,
+		CompInfo: sync.ComponentInfo{
+			ComponentName: componentName,
+			PodName:       pod.GetName(),
+			ContainerName: containerName,
+			SyncFolder:    syncFolder,
+		},
+	}
+
+	if o.upload {
+		err = o.clientset.SyncClient.CopyToContainer(ctx, copyParameters)
+		if err != nil {
+			return err
+		}
+		log.Successf("Copied %s to %s in the container %q", o.localPath, o.remotePath, containerName)
+		return nil
+	}
+
+	files, err := o.clientset.SyncClient.CopyFromContainer(ctx, copyParameters)
+	if err != nil {
+		return err
+	}
+	log.Successf("Copied %s from the container %q to %s (%d files)", o.remotePath, containerName, o.localPath, len(files))
+	return nil
+}
+
+// getSyncFolder returns the directory into which the sources are synchronized in the container, if any
+func getSyncFolder(pod *corev1.Pod, containerName string) string {
+	for _, c := range pod.Spec.Containers {
+		if c.Name != containerName {
+			continue
+		}
+		for _, env := range c.Env {
+			if env.Name == generator.EnvProjectsSrc {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
+
+func NewCmdCp(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewCpOptions()
+	cpCmd := &cobra.Command{
+		Use:   name + " [--container name] SRC DEST",
+		Short: "Copy files between the local machine and a container of the component running in the Dev mode",
+		Long: `odo cp copies a file or directory between the local machine and a container of the component running in the Dev mode ("odo dev" needs to be running).
+The path in the container is prefixed with a colon; a relative path is relative to the directory into which the sources are synchronized.
+By default, the files are copied from or into the container of the default run command of the Devfile.`,
+		Example: fmt.Sprintf(cpExample, fullName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	cpCmd.Flags().StringVarP(&o.containerFlag, "container", "c", "", "Container from or into which the files are copied. Defaults to the container of the default run command")
+
+	clientset.Add(cpCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+		clientset.SYNC,
+	)
+
+	odoutil.SetCommandGroup(cpCmd, odoutil.MainGroup)
+	cpCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(cpCmd)
+	return cpCmd
+}
@@ -0,0 +1,69 @@
+package cp
+
+import (
+	"testing"
+)
+
+func Test_parsePaths(t *testing.T) {
+	tests := []struct {
+		name           string
+		src            string
+		dest           string
+		wantLocalPath  string
+		wantRemotePath string
+		wantUpload     bool
+		wantErr        bool
+	}{
+		{
+			name:           "upload",
+			src:            "./config.yaml",
+			dest:           ":/tmp",
+			wantLocalPath:  "./config.yaml",
+			wantRemotePath: "/tmp",
+			wantUpload:     true,
+		},
+		{
+			name:           "download",
+			src:            ":target/reports",
+			dest:           "reports",
+			wantLocalPath:  "reports",
+			wantRemotePath: "target/reports",
+		},
+		{
+			name:           "download to a Windows path",
+			src:            ":/tmp/heap.hprof",
+			dest:           `C:\dumps`,
+			wantLocalPath:  `C:\dumps`,
+			wantRemotePath: "/tmp/heap.hprof",
+		},
+		{
+			name:    "both remote",
+			src:     ":/tmp/a",
+			dest:    ":/tmp/b",
+			wantErr: true,
+		},
+		{
+			name:    "both local",
+			src:     "a",
+			dest:    "b",
+			wantErr: true,
+		},
+		{
+			name:    "empty remote path",
+			src:     "a",
+			dest:    ":",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localPath, remotePath, upload, err := parsePaths(tt.src, tt.dest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if localPath != tt.wantLocalPath || remotePath != tt.wantRemotePath || upload != tt.wantUpload {
+				t.Errorf("parsePaths() = (%q, %q, %v), want (%q, %q, %v)", localPath, remotePath, upload, tt.wantLocalPath, tt.wantRemotePath, tt.wantUpload)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ import (
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/component"
+	_delete "github.com/redhat-developer/odo/pkg/component/delete"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/log"
 	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
@@ -28,6 +30,7 @@ import (
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
@@ -69,6 +72,9 @@ type ComponentOptions struct {
 	// It can be either Dev, Deploy or Any (using constant labels.Component*Mode).
 	runningIn string
 
+	// podmanPlatform is the name of the platform accessed with the Podman client, podman or docker
+	podmanPlatform string
+
 	// Clients
 	clientset *clientset.Clientset
 }
@@ -104,13 +110,17 @@ func (o *ComponentOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline
 	// Limit access to platforms if necessary
 	if !feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
 		o.clientset.PodmanClient = nil
+		o.clientset.DockerClient = nil
 	}
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		o.clientset.PodmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		o.clientset.KubernetesClient = nil
 	}
+	if fcontext.GetPlatform(ctx, "") == commonflags.PlatformDocker {
+		o.podmanPlatform = commonflags.PlatformDocker
+	}
 
 	// 1. Name is not passed, and odo has access to devfile.yaml; Name is not passed so we assume that odo has access to the devfile.yaml
 	if o.name == "" {
@@ -156,7 +166,7 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 		appName = odocontext.GetApplication(ctx)
 
 		clusterResources []unstructured.Unstructured
-		podmanResources  []*corev1.Pod
+		podmanResources  []containerPlatformPods
 		err              error
 	)
 	log.Finfof(o.clientset.Stdout, "Searching resources to delete, please wait...")
@@ -167,17 +177,22 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 		}
 	}
 
-	if o.clientset.PodmanClient != nil {
-		_, podmanResources, err = o.clientset.DeleteClient.ListPodmanResourcesToDelete(appName, o.name, o.runningIn)
+	containerPlatforms := o.getContainerPlatforms()
+	for _, platform := range containerPlatforms {
+		var pods []*corev1.Pod
+		_, pods, err = platform.deleteClient.ListPodmanResourcesToDelete(appName, o.name, o.runningIn)
 		if err != nil {
 			return err
 		}
+		if len(pods) > 0 {
+			podmanResources = append(podmanResources, containerPlatformPods{containerPlatform: platform, pods: pods})
+		}
 	}
 
 	if len(clusterResources) == 0 && len(podmanResources) == 0 {
 		log.Finfof(o.clientset.Stdout, messageWithPlatforms(
 			o.clientset.KubernetesClient != nil,
-			o.clientset.PodmanClient != nil,
+			getContainerPlatformNames(containerPlatforms),
 			o.name, o.namespace,
 		))
 		return nil
@@ -207,18 +222,13 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 			log.Finfof(o.clientset.Stdout, successMsg)
 		}
 
-		if len(podmanResources) > 0 {
-			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from podman")
-			for _, pod := range podmanResources {
-				err = o.clientset.PodmanClient.CleanupPodResources(pod, true)
-				if err != nil {
-					log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", pod.GetName(), err)
-				}
-			}
+		for _, platformPods := range podmanResources {
+			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from %s", platformPods.name)
+			o.deletePods(appName, o.name, platformPods)
 			spinner.End(true)
-			successMsg := fmt.Sprintf("The component %q is successfully deleted from podman", o.name)
+			successMsg := fmt.Sprintf("The component %q is successfully deleted from %s", o.name, platformPods.name)
 			if o.runningIn != "" {
-				successMsg = fmt.Sprintf("The component %q running in the %s mode is successfully deleted podman", o.name, o.runningIn)
+				successMsg = fmt.Sprintf("The component %q running in the %s mode is successfully deleted %s", o.name, o.runningIn, platformPods.name)
 			}
 			log.Finfof(o.clientset.Stdout, successMsg)
 		}
@@ -230,17 +240,99 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 	return nil
 }
 
-func messageWithPlatforms(cluster, podman bool, name, namespace string) string {
+func messageWithPlatforms(cluster bool, podmanPlatforms []string, name, namespace string) string {
 	details := []string{}
 	if cluster {
 		details = append(details, fmt.Sprintf(" in namespace %q", namespace))
 	}
-	if podman {
-		details = append(details, " on podman")
+	for _, podmanPlatform := range podmanPlatforms {
+		details = append(details, " on "+podmanPlatform)
 	}
 	return fmt.Sprintf("No resource found for component %q%s\n", name, strings.Join(details, " or"))
 }
 
+// containerPlatform is a platform running the pods of the components, accessed with a Podman client
+type containerPlatform struct {
+	name         string
+	client       podman.Client
+	deleteClient _delete.Client
+}
+
+// containerPlatformPods are the pods of a component to delete from a container platform
+type containerPlatformPods struct {
+	containerPlatform
+	isInnerLoopDeployed bool
+	pods                []*corev1.Pod
+}
+
+// getContainerPlatforms returns the platforms running the pods of the components.
+// Without platform selected, both Podman and Docker are accessed, as the state of the sessions does.
+func (o *ComponentOptions) getContainerPlatforms() []containerPlatform {
+	var platforms []containerPlatform
+	if o.clientset.PodmanClient != nil {
+		platforms = append(platforms, containerPlatform{
+			name:         o.getPodmanPlatform(),
+			client:       o.clientset.PodmanClient,
+			deleteClient: o.clientset.DeleteClient,
+		})
+	}
+	if o.clientset.DockerClient != nil {
+		platforms = append(platforms, containerPlatform{
+			name:         commonflags.PlatformDocker,
+			client:       o.clientset.DockerClient,
+			deleteClient: _delete.NewDeleteComponentClient(nil, o.clientset.DockerClient, nil, nil),
+		})
+	}
+	return platforms
+}
+
+func getContainerPlatformNames(platforms []containerPlatform) []string {
+	names := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		names = append(names, platform.name)
+	}
+	return names
+}
+
+func getPodsPlatformNames(platformsPods []containerPlatformPods) []string {
+	names := make([]string, 0, len(platformsPods))
+	for _, platformPods := range platformsPods {
+		names = append(names, platformPods.name)
+	}
+	return names
+}
+
+// getPodmanPlatform returns the name of the platform accessed with the Podman client
+func (o *ComponentOptions) getPodmanPlatform() string {
+	if o.podmanPlatform == "" {
+		return commonflags.PlatformPodman
+	}
+	return o.podmanPlatform
+}
+
+// deletePods deletes the pods of the component from the platform, and the network they share
+func (o *ComponentOptions) deletePods(appName string, componentName string, platformPods containerPlatformPods) {
+	for _, pod := range platformPods.pods {
+		err := platformPods.client.CleanupPodResources(pod, true)
+		if err != nil {
+			log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from %s: %s\n", pod.GetName(), platformPods.name, err)
+		}
+	}
+	deletePodmanNetwork(platformPods.client, appName, componentName)
+}
+
+// deletePodmanNetwork deletes the network shared by the pods of the component, if it is not used anymore
+func deletePodmanNetwork(client podman.Client, appName string, componentName string) {
+	network, err := component.GetPodmanNetworkName(componentName, appName)
+	if err != nil {
+		return
+	}
+	// The network is still used if the component is running in the other mode
+	if err = client.NetworkRm(network); err != nil {
+		klog.V(4).Infof("unable to delete network %q: %v", network, err)
+	}
+}
+
 // printRemainingResources lists the remaining cluster resources that are not found in the devfile.
 func (o *ComponentOptions) printRemainingResources(ctx context.Context, remainingResources []unstructured.Unstructured) {
 	if len(remainingResources) == 0 {
@@ -269,9 +361,8 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 		clusterResources           []unstructured.Unstructured
 		remainingResources         []unstructured.Unstructured
 
-		isPodmanInnerLoopDeployed bool
-		hasPodmanResources        bool
-		podmanPods                []*corev1.Pod
+		hasPodmanResources bool
+		podmanPods         []containerPlatformPods
 
 		err error
 	)
@@ -298,8 +389,13 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 	}
 
 	// 2. get podman resources
-	if o.clientset.PodmanClient != nil {
-		isPodmanInnerLoopDeployed, podmanPods, err = o.clientset.DeleteClient.ListPodmanResourcesToDelete(appName, componentName, o.runningIn)
+	containerPlatforms := o.getContainerPlatforms()
+	for _, platform := range containerPlatforms {
+		var (
+			isInnerLoopDeployed bool
+			pods                []*corev1.Pod
+		)
+		isInnerLoopDeployed, pods, err = platform.deleteClient.ListPodmanResourcesToDelete(appName, componentName, o.runningIn)
 		if err != nil {
 			if clierrors.AsWarning(err) {
 				log.Fwarning(o.clientset.Stderr, err.Error())
@@ -307,8 +403,11 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 				return nil, err
 			}
 		}
-		hasPodmanResources = len(podmanPods) != 0
+		if len(pods) != 0 {
+			podmanPods = append(podmanPods, containerPlatformPods{containerPlatform: platform, isInnerLoopDeployed: isInnerLoopDeployed, pods: pods})
+		}
 	}
+	hasPodmanResources = len(podmanPods) != 0
 
 	orphans, err := o.getOrphanDevstateFiles(o.clientset.FS, ctx)
 	if err != nil {
@@ -316,7 +415,7 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 	}
 
 	if !(hasClusterResources || hasPodmanResources) {
-		log.Finfof(o.clientset.Stdout, messageWithPlatforms(o.clientset.KubernetesClient != nil, o.clientset.PodmanClient != nil, componentName, namespace))
+		log.Finfof(o.clientset.Stdout, messageWithPlatforms(o.clientset.KubernetesClient != nil, getContainerPlatformNames(containerPlatforms), componentName, namespace))
 		if !o.withFilesFlag && len(orphans) == 0 {
 			// check for resources here
 			return remainingResources, nil
@@ -381,20 +480,15 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 
 		}
 
-		if hasPodmanResources {
-			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from podman")
-			if isPodmanInnerLoopDeployed {
+		for _, platformPods := range podmanPods {
+			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from %s", platformPods.name)
+			if platformPods.isInnerLoopDeployed {
 				// TODO(feloy) #6424
-				_ = isPodmanInnerLoopDeployed
-			}
-			for _, pod := range podmanPods {
-				err = o.clientset.PodmanClient.CleanupPodResources(pod, true)
-				if err != nil {
-					log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", pod.GetName(), err)
-				}
+				_ = platformPods.isInnerLoopDeployed
 			}
+			o.deletePods(appName, componentName, platformPods)
 			spinner.End(true)
-			log.Finfof(o.clientset.Stdout, "The component %q is successfully deleted from podman", componentName)
+			log.Finfof(o.clientset.Stdout, "The component %q is successfully deleted from %s", componentName, platformPods.name)
 		}
 
 		if o.withFilesFlag || len(orphans) > 0 {
@@ -442,11 +536,11 @@ func listResourcesMissingFromDevfilePresentOnCluster(componentName string, devfi
 func (o *ComponentOptions) printDevfileComponents(
 	componentName, namespace string,
 	k8sResources []unstructured.Unstructured,
-	podmanResources []*corev1.Pod,
+	podmanResources []containerPlatformPods,
 ) {
 	log.Finfof(o.clientset.Stdout, infoMsg(
 		len(k8sResources) != 0,
-		len(podmanResources) != 0,
+		getPodsPlatformNames(podmanResources),
 		componentName,
 		namespace,
 	))
@@ -459,9 +553,9 @@ func (o *ComponentOptions) printDevfileComponents(
 		log.Fprintln(o.clientset.Stdout)
 	}
 
-	if len(podmanResources) != 0 {
-		log.Fprintf(o.clientset.Stdout, "The following pods and associated volumes will get deleted from podman:")
-		for _, pod := range podmanResources {
+	for _, platformPods := range podmanResources {
+		log.Fprintf(o.clientset.Stdout, "The following pods and associated volumes will get deleted from %s:", platformPods.name)
+		for _, pod := range platformPods.pods {
 			log.Fprintf(o.clientset.Stdout, "\t- %s", pod.GetName())
 		}
 		log.Fprintln(o.clientset.Stdout)
@@ -469,15 +563,16 @@ func (o *ComponentOptions) printDevfileComponents(
 }
 
 func infoMsg(
-	cluster, podman bool,
+	cluster bool,
+	podmanPlatforms []string,
 	componentName, namespace string,
 ) string {
 	froms := []string{}
 	if cluster {
 		froms = append(froms, fmt.Sprintf("from the namespace %q", namespace))
 	}
-	if podman {
-		froms = append(froms, "from podman")
+	for _, podmanPlatform := range podmanPlatforms {
+		froms = append(froms, "from "+podmanPlatform)
 	}
 	return fmt.Sprintf("This will delete %q %s.", componentName, strings.Join(froms, " and "))
 
@@ -571,7 +666,7 @@ func NewCmdComponent(ctx context.Context, name, fullName string, testClientset c
 	componentCmd.Flags().BoolVarP(&o.waitFlag, "wait", "w", false, "Wait for deletion of all dependent resources")
 	clientset.Add(componentCmd, clientset.DELETE_COMPONENT, clientset.KUBERNETES, clientset.FILESYSTEM, clientset.STATE)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(componentCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(componentCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 	commonflags.UsePlatformFlag(componentCmd)
 
@@ -41,6 +41,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 		kubernetesClient      func(ctrl *gomock.Controller) kclient.ClientInterface
 		deleteComponentClient func(ctrl *gomock.Controller) _delete.Client
 		podmanClient          func(ctrl *gomock.Controller) podman.Client
+		dockerClient          func(ctrl *gomock.Controller) podman.Client
 	}
 	tests := []struct {
 		name    string
@@ -214,6 +215,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
 					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -236,6 +238,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
 					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -281,6 +284,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
 					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -312,6 +316,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
 					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -368,11 +373,48 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "1 podman and 1 docker resources to delete",
+			fields: fields{
+				name:      "my-component",
+				forceFlag: true,
+				kubernetesClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+					return nil
+				},
+				podmanClient: func(ctrl *gomock.Controller) podman.Client {
+					client := podman.NewMockClient(ctrl)
+					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
+					return client
+				},
+				dockerClient: func(ctrl *gomock.Controller) podman.Client {
+					dockerPod := corev1.Pod{}
+					dockerPod.SetName("my-component-app")
+					client := podman.NewMockClient(ctrl)
+					client.EXPECT().PodLs().Return(map[string]bool{"my-component-app": true}, nil)
+					client.EXPECT().KubeGenerate("my-component-app").Return(&dockerPod, nil)
+					client.EXPECT().GetPodsMatchingSelector(gomock.Any()).Return(&corev1.PodList{}, nil).Times(2)
+					client.EXPECT().CleanupPodResources(&dockerPod, true).Times(1)
+					client.EXPECT().NetworkRm("odo-my-component-app").Times(1)
+					return client
+				},
+				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
+					client := _delete.NewMockClient(ctrl)
+					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", "").
+						Return(true, []*corev1.Pod{&pod1}, nil).Times(1)
+					return client
+				},
+			},
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
+			var dockerClient podman.Client
+			if tt.fields.dockerClient != nil {
+				dockerClient = tt.fields.dockerClient(ctrl)
+			}
 			o := &ComponentOptions{
 				name:      tt.fields.name,
 				namespace: tt.fields.namespace,
@@ -384,6 +426,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 					KubernetesClient: tt.fields.kubernetesClient(ctrl),
 					DeleteClient:     tt.fields.deleteComponentClient(ctrl),
 					PodmanClient:     tt.fields.podmanClient(ctrl),
+					DockerClient:     dockerClient,
 				},
 			}
 			ctx := odocontext.WithApplication(context.TODO(), "app")
@@ -833,10 +876,10 @@ func Test_listResourcesMissingFromDevfilePresentOnCluster(t *testing.T) {
 
 func Test_messageWithPlatforms(t *testing.T) {
 	type args struct {
-		cluster   bool
-		podman    bool
-		name      string
-		namespace string
+		cluster         bool
+		podmanPlatforms []string
+		name            string
+		namespace       string
 	}
 	tests := []struct {
 		name string
@@ -856,27 +899,47 @@ func Test_messageWithPlatforms(t *testing.T) {
 		{
 			name: "podman only",
 			args: args{
-				podman: true,
-				name:   "componame",
+				podmanPlatforms: []string{"podman"},
+				name:            "componame",
 			},
 			want: `No resource found for component "componame" on podman
+`,
+		},
+		{
+			name: "docker only",
+			args: args{
+				podmanPlatforms: []string{"docker"},
+				name:            "componame",
+			},
+			want: `No resource found for component "componame" on docker
 `,
 		},
 		{
 			name: "cluster and podman",
 			args: args{
-				cluster:   true,
-				podman:    true,
-				name:      "componame",
-				namespace: "def",
+				cluster:         true,
+				podmanPlatforms: []string{"podman"},
+				name:            "componame",
+				namespace:       "def",
 			},
 			want: `No resource found for component "componame" in namespace "def" or on podman
+`,
+		},
+		{
+			name: "cluster, podman and docker",
+			args: args{
+				cluster:         true,
+				podmanPlatforms: []string{"podman", "docker"},
+				name:            "componame",
+				namespace:       "def",
+			},
+			want: `No resource found for component "componame" in namespace "def" or on podman or on docker
 `,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := messageWithPlatforms(tt.args.cluster, tt.args.podman, tt.args.name, tt.args.namespace); got != tt.want {
+			if got := messageWithPlatforms(tt.args.cluster, tt.args.podmanPlatforms, tt.args.name, tt.args.namespace); got != tt.want {
 				t.Errorf("messageWithPlatforms() = %q, want %q", got, tt.want)
 			}
 		})
@@ -885,10 +948,10 @@ func Test_messageWithPlatforms(t *testing.T) {
 
 func Test_infoMsg(t *testing.T) {
 	type args struct {
-		cluster       bool
-		podman        bool
-		componentName string
-		namespace     string
+		cluster         bool
+		podmanPlatforms []string
+		componentName   string
+		namespace       string
 	}
 	tests := []struct {
 		name string
@@ -907,25 +970,41 @@ func Test_infoMsg(t *testing.T) {
 		{
 			name: "podman only",
 			args: args{
-				podman:        true,
-				componentName: "compo",
+				podmanPlatforms: []string{"podman"},
+				componentName:   "compo",
 			},
 			want: `This will delete "compo" from podman.`,
 		},
+		{
+			name: "docker only",
+			args: args{
+				podmanPlatforms: []string{"docker"},
+				componentName:   "compo",
+			},
+			want: `This will delete "compo" from docker.`,
+		},
 		{
 			name: "cluster and podman",
 			args: args{
-				cluster:       true,
-				podman:        true,
-				componentName: "compo",
-				namespace:     "def",
+				cluster:         true,
+				podmanPlatforms: []string{"podman"},
+				componentName:   "compo",
+				namespace:       "def",
 			},
 			want: `This will delete "compo" from the namespace "def" and from podman.`,
 		},
+		{
+			name: "podman and docker",
+			args: args{
+				podmanPlatforms: []string{"podman", "docker"},
+				componentName:   "compo",
+			},
+			want: `This will delete "compo" from podman and from docker.`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := infoMsg(tt.args.cluster, tt.args.podman, tt.args.componentName, tt.args.namespace); got != tt.want {
+			if got := infoMsg(tt.args.cluster, tt.args.podmanPlatforms, tt.args.componentName, tt.args.namespace); got != tt.want {
 				t.Errorf("infoMsg() = %v, want %v", got, tt.want)
 			}
 		})
@@ -16,11 +16,13 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/podman"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 )
 
@@ -55,7 +57,12 @@ func (o *DeployOptions) PreInit() string {
 
 // Complete DeployOptions after they've been created
 func (o *DeployOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
-	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformPodman:
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	default:
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	}
 	return nil
 }
 
@@ -65,8 +72,17 @@ func (o *DeployOptions) Validate(ctx context.Context) error {
 	if devfileObj == nil {
 		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
 	}
-	if o.clientset.KubernetesClient == nil {
-		return kclient.NewNoConnectionError()
+	switch platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster); platform {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+	default:
+		return fmt.Errorf("odo deploy is not supported on %s", platform)
 	}
 	componentName := odocontext.GetComponentName(ctx)
 	err := dfutil.ValidateK8sResourceName("component name", componentName)
@@ -78,7 +94,7 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	var (
 		devfileObj  = odocontext.GetEffectiveDevfileObj(ctx)
 		devfileName = odocontext.GetComponentName(ctx)
-		namespace   = odocontext.GetNamespace(ctx)
+		platform    = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
 
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
@@ -86,10 +102,16 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
 	scontext.SetDevfileName(ctx, devfileName)
 	// Output what the command is doing / information
-	log.Title("Running the application in Deploy mode using the \""+devfileName+"\" Devfile",
-		"Namespace: "+namespace)
-
-	genericclioptions.WarnIfDefaultNamespace(namespace, o.clientset.KubernetesClient)
+	if platform == commonflags.PlatformPodman {
+		log.Title("Running the application in Deploy mode using the \""+devfileName+"\" Devfile",
+			"Platform: "+platform)
+	} else {
+		namespace := odocontext.GetNamespace(ctx)
+		log.Title("Running the application in Deploy mode using the \""+devfileName+"\" Devfile",
+			"Namespace: "+namespace)
+
+		genericclioptions.WarnIfDefaultNamespace(namespace, o.clientset.KubernetesClient)
+	}
 
 	// Run actual deploy command to be used
 	err := o.clientset.DeployClient.Deploy(ctx)
@@ -114,11 +136,12 @@ func NewCmdDeploy(name, fullName string, testClientset clientset.Clientset) *cob
 			return genericclioptions.GenericRun(o, testClientset, cmd, args)
 		},
 	}
-	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(deployCmd, util.MainGroup)
 	deployCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(deployCmd)
+	commonflags.UsePlatformFlag(deployCmd)
 	return deployCmd
 }
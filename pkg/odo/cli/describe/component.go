@@ -97,7 +97,7 @@ func (o *ComponentOptions) Validate(ctx context.Context) (err error) {
 		if o.clientset.KubernetesClient == nil {
 			log.Warning(kclient.NewNoConnectionError())
 		}
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		if o.namespaceFlag != "" {
 			log.Warning("--namespace flag ignored on Podman")
 		}
@@ -201,6 +201,22 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		fmt.Println()
 	}
 
+	if len(cmp.DevCommands) > 0 {
+		log.Info("Dev commands:")
+		for _, command := range cmp.DevCommands {
+			details := fmt.Sprintf("%s: %s", command.Name, command.Status)
+			if command.Component != "" {
+				details = command.Component + "/" + details
+			}
+			if withPlatformFeature {
+				details = fmt.Sprintf("[%s] ", command.Platform) + details
+			}
+			details += fmt.Sprintf("\n    Restarts: %d", command.Restarts)
+			log.Printf(details)
+		}
+		fmt.Println()
+	}
+
 	log.Info("Supported odo features:")
 	if cmp.DevfileData != nil && cmp.DevfileData.SupportedOdoFeatures != nil {
 		log.Printf("Dev: %v", cmp.DevfileData.SupportedOdoFeatures.Dev)
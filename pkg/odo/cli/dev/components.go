@@ -0,0 +1,330 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"golang.org/x/sync/errgroup"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	apiserver_impl "github.com/redhat-developer/odo/pkg/apiserver-impl"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// devComponent is one of the components developed by a session started with the --component-dir flag
+type devComponent struct {
+	// dir is the absolute path of the directory containing the Devfile of the component
+	dir         string
+	devfilePath string
+	devfileObj  *parser.DevfileObj
+	name        string
+
+	// clientset contains the clients dedicated to the component
+	clientset      *clientset.Clientset
+	ignorePaths    []string
+	forwardedPorts []api.ForwardedPort
+
+	// out and errOut prefix the messages of the component with its name
+	out    *log.PrefixWriter
+	errOut *log.PrefixWriter
+}
+
+// context returns a context containing the information about the component, derived from ctx
+func (o *devComponent) context(ctx context.Context) context.Context {
+	ctx = odocontext.WithWorkingDirectory(ctx, o.dir)
+	ctx = odocontext.WithDevfilePath(ctx, o.devfilePath)
+	ctx = odocontext.WithEffectiveDevfileObj(ctx, o.devfileObj)
+	return odocontext.WithComponentName(ctx, o.name)
+}
+
+// completeComponents parses the Devfiles of the directories passed with the --component-dir flag,
+// and creates the clients dedicated to each component
+func (o *DevOptions) completeComponents(ctx context.Context) error {
+	var (
+		workingDir = odocontext.GetWorkingDirectory(ctx)
+		variables  = fcontext.GetVariables(ctx)
+		platform   = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+		dirs       = make(map[string]bool)
+		names      = make(map[string]string)
+	)
+	for _, dir := range o.componentDirFlag {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(workingDir, dir)
+		}
+		dir = filepath.Clean(dir)
+		if dirs[dir] {
+			return fmt.Errorf("directory %q is passed several times with --component-dir", dir)
+		}
+		dirs[dir] = true
+
+		devfilePath, devfileObj, componentName, err := genericclioptions.GetComponentDevfileInfo(
+			o.clientset.FS, dir, variables, o.clientset.PreferenceClient.GetImageRegistry())
+		if err != nil {
+			return err
+		}
+		if otherDir, found := names[componentName]; found {
+			return fmt.Errorf("the components in directories %q and %q have the same name %q", otherDir, dir, componentName)
+		}
+		names[componentName] = dir
+
+		o.components = append(o.components, &devComponent{
+			dir:         dir,
+			devfilePath: devfilePath,
+			devfileObj:  devfileObj,
+			name:        componentName,
+			clientset:   o.clientset.ForComponent(componentName, platform),
+		})
+	}
+
+	// Align the messages of all the components
+	width := 0
+	for _, c := range o.components {
+		if len(c.name) > width {
+			width = len(c.name)
+		}
+	}
+	for _, c := range o.components {
+		prefix := fmt.Sprintf("[%s]%s ", c.name, strings.Repeat(" ", width-len(c.name)))
+		c.out = log.NewPrefixWriter(o.out, prefix)
+		c.errOut = log.NewPrefixWriter(o.errOut, prefix)
+	}
+	return nil
+}
+
+// validateComponents validates the flags and the Devfiles of a session started with the --component-dir flag
+func (o *DevOptions) validateComponents() error {
+	if o.portForwardFlag != nil {
+		return errors.New("--port-forward cannot be used with --component-dir")
+	}
+	for _, c := range o.components {
+		if _, err := common.GetWatchTriggersFromAttributes(*c.devfileObj); err != nil {
+			return fmt.Errorf("component %q: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+// runComponents starts the development of all the components passed with the --component-dir flag.
+// The components share the same state file and API server, and the local ports forwarded for the components
+// are allocated together, so the components do not use the same ports.
+func (o *DevOptions) runComponents(ctx context.Context) (err error) {
+	var (
+		variables = fcontext.GetVariables(ctx)
+		platform  = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+		names     []string
+	)
+	dest, deployingTo := getDestination(ctx, platform)
+
+	for _, c := range o.components {
+		names = append(names, fmt.Sprintf("%q", c.name))
+	}
+	log.Title("Developing using the "+strings.Join(names, ", ")+" Devfiles", dest)
+	if platform == commonflags.PlatformCluster {
+		genericclioptions.WarnIfDefaultNamespace(odocontext.GetNamespace(ctx), o.clientset.KubernetesClient)
+	}
+
+	for _, c := range o.components {
+		c.ignorePaths, err = getIgnorePaths(c.dir, o.syncGitDirFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Sectionf("Running on %s in Dev mode", deployingTo)
+
+	err = o.initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	var apiServer apiserver_impl.ApiServer
+	if o.apiServerFlag {
+		// The API server controls the whole session, and gives access to the Devfile of the component only if there is a single one
+		var (
+			serverCtx    = ctx
+			devfilePath  string
+			devfileFiles []string
+		)
+		if len(o.components) == 1 {
+			c := o.components[0]
+			serverCtx = c.context(ctx)
+			devfilePath = c.devfilePath
+			devfileFiles, err = libdevfile.GetReferencedLocalFiles(*c.devfileObj)
+			if err != nil {
+				return err
+			}
+			devfileFiles = append(devfileFiles, devfilePath)
+		}
+		apiServer, err = apiserver_impl.StartServer(
+			serverCtx,
+			o.cancel,
+			o.randomPortsFlag,
+			o.apiServerPortFlag,
+			devfilePath,
+			devfileFiles,
+			o.clientset.FS,
+			o.clientset.KubernetesClient,
+			o.clientset.PodmanClient,
+			o.clientset.StateClient,
+			o.clientset.PreferenceClient,
+			o.clientset.InformerClient,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !o.randomPortsFlag {
+		err = allocateForwardedPorts(o.components, o.debugFlag, o.addressFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.logsFlag {
+		for _, c := range o.components {
+			go func(c *devComponent) {
+				_ = o.followComponentLogs(ctx, c)
+			}(c)
+		}
+	}
+
+	o.clientset.InformerClient.AppendInfo(log.Sbold("Keyboard Commands:") + "\n" +
+		"[Ctrl+c] - Exit and delete resources from " + deployingTo + "\n" +
+		"     [p] - Manually apply local changes to the applications on " + deployingTo + "\n")
+
+	// The keyboard and the API server are watched once for all the components
+	pushWatchers := make([]chan struct{}, len(o.components))
+	for i := range pushWatchers {
+		pushWatchers[i] = make(chan struct{}, 1)
+	}
+	go dispatchPushes(o.ctx, watch.GetKeyWatcher(o.ctx, o.out), apiServer.PushWatcher, pushWatchers)
+
+	g := new(errgroup.Group)
+	for i, c := range o.components {
+		i, c := i, c
+		g.Go(func() error {
+			options := o.getStartOptions(variables, c.ignorePaths, c.forwardedPorts, pushWatchers[i], c.out, c.errOut)
+			options.SkipKeyWatcher = true
+			startErr := c.clientset.DevClient.Start(c.context(o.ctx), options)
+			_ = c.out.Flush()
+			_ = c.errOut.Flush()
+			if startErr != nil {
+				// Stop the other components
+				o.cancel()
+				return fmt.Errorf("component %q: %w", c.name, startErr)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// cleanupComponents deletes the resources of all the components passed with the --component-dir flag
+func (o *DevOptions) cleanupComponents(ctx context.Context) error {
+	var errs []error
+	for _, c := range o.components {
+		err := c.clientset.DevClient.CleanupResources(c.context(ctx), log.GetStdout())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("component %q: %w", c.name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (o *DevOptions) followComponentLogs(ctx context.Context, c *devComponent) error {
+	ns := ""
+	if o.clientset.KubernetesClient != nil {
+		ns = odocontext.GetNamespace(ctx)
+	}
+
+	return o.clientset.LogsClient.DisplayLogs(
+		ctx,
+		odolabels.ComponentDevMode,
+		c.name,
+		ns,
+		true,
+		c.out,
+	)
+}
+
+// allocateForwardedPorts allocates the local ports to forward for the endpoints of all the components,
+// in the order of the components, so the same local port is not used by several components.
+// As for a single component, the ports are allocated starting at 20001.
+func allocateForwardedPorts(components []*devComponent, debug bool, address string) error {
+	if address == "" {
+		address = "127.0.0.1"
+	}
+	startPort := 20001
+	endPort := startPort + 10000
+	for _, c := range components {
+		ceMapping, err := libdevfile.GetDevfileContainerEndpointMapping(*c.devfileObj, debug)
+		if err != nil {
+			return fmt.Errorf("component %q: %w", c.name, err)
+		}
+		var containers []string
+		for container := range ceMapping {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+
+		c.forwardedPorts = nil
+		for _, container := range containers {
+			for _, ep := range ceMapping[container] {
+				freePort, err := util.NextFreePort(startPort, endPort, nil, address)
+				if err != nil {
+					return fmt.Errorf("unable to allocate a local port for port %d of container %q of component %q: %w", ep.TargetPort, container, c.name, err)
+				}
+				klog.V(4).Infof("local port %d allocated for port %d of container %q of component %q", freePort, ep.TargetPort, container, c.name)
+				c.forwardedPorts = append(c.forwardedPorts, api.ForwardedPort{
+					ContainerName: container,
+					LocalAddress:  address,
+					LocalPort:     freePort,
+					ContainerPort: ep.TargetPort,
+				})
+				startPort = freePort + 1
+			}
+		}
+	}
+	return nil
+}
+
+// dispatchPushes requests all the components to push the local changes, when the key 'p' is pressed
+// or when a push is requested through pushWatcher
+func dispatchPushes(ctx context.Context, keyWatcher <-chan byte, pushWatcher <-chan struct{}, componentWatchers []chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case key := <-keyWatcher:
+			if key != 'p' {
+				continue
+			}
+		case <-pushWatcher:
+		}
+		for _, componentWatcher := range componentWatchers {
+			select {
+			case componentWatcher <- struct{}{}:
+			default:
+				// a push is already requested for this component
+			}
+		}
+	}
+}
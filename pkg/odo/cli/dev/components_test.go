@@ -0,0 +1,109 @@
+package dev
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func Test_allocateForwardedPorts(t *testing.T) {
+	newComponent := func(t *testing.T, name string, containers ...v1alpha2.Component) *devComponent {
+		devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = devfileData.AddComponents(containers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &devComponent{
+			name: name,
+			devfileObj: &parser.DevfileObj{
+				Data: devfileData,
+			},
+		}
+	}
+
+	frontend := newComponent(t, "frontend", testingutil.GetFakeContainerComponent("runtime", 3000, 8080))
+	apiComponent := newComponent(t, "api",
+		testingutil.GetFakeContainerComponent("tools", 5000),
+		testingutil.GetFakeContainerComponent("runtime", 3000),
+	)
+	worker := newComponent(t, "worker", testingutil.GetFakeContainerComponent("runtime"))
+	components := []*devComponent{frontend, apiComponent, worker}
+
+	err := allocateForwardedPorts(components, false, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string][]api.ForwardedPort{
+		"frontend": {
+			{ContainerName: "runtime", LocalAddress: "127.0.0.1", ContainerPort: 3000},
+			{ContainerName: "runtime", LocalAddress: "127.0.0.1", ContainerPort: 8080},
+		},
+		"api": {
+			{ContainerName: "runtime", LocalAddress: "127.0.0.1", ContainerPort: 3000},
+			{ContainerName: "tools", LocalAddress: "127.0.0.1", ContainerPort: 5000},
+		},
+		"worker": nil,
+	}
+	previousPort := 20000
+	for _, c := range components {
+		if diff := cmp.Diff(want[c.name], c.forwardedPorts, cmpopts.IgnoreFields(api.ForwardedPort{}, "LocalPort")); diff != "" {
+			t.Errorf("allocateForwardedPorts() mismatch for component %q (-want +got):\n%s", c.name, diff)
+		}
+		// The local ports are allocated in the order of the components, and are not shared between components
+		for _, port := range c.forwardedPorts {
+			if port.LocalPort <= previousPort {
+				t.Errorf("local port %d of component %q should be greater than %d", port.LocalPort, c.name, previousPort)
+			}
+			previousPort = port.LocalPort
+		}
+	}
+}
+
+func Test_dispatchPushes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keyWatcher := make(chan byte)
+	pushWatcher := make(chan struct{})
+	componentWatchers := []chan struct{}{make(chan struct{}, 1), make(chan struct{}, 1)}
+	go dispatchPushes(ctx, keyWatcher, pushWatcher, componentWatchers)
+
+	// checkPushed checks that a push is requested to all the components, and only once
+	checkPushed := func(t *testing.T) {
+		for i, componentWatcher := range componentWatchers {
+			select {
+			case <-componentWatcher:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("no push requested for component %d", i)
+			}
+			select {
+			case <-componentWatcher:
+				t.Errorf("several pushes requested for component %d", i)
+			default:
+			}
+		}
+	}
+
+	keyWatcher <- 'x'
+	keyWatcher <- 'p'
+	checkPushed(t)
+
+	// pushes requested while a push is pending are merged
+	pushWatcher <- struct{}{}
+	pushWatcher <- struct{}{}
+	keyWatcher <- 'p'
+	checkPushed(t)
+}
@@ -0,0 +1,125 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+const (
+	detachFlagName   = "detach"
+	detachedFlagName = "detached"
+
+	// detachedStartTimeout is the maximum duration to wait for the API server of a session started in the background
+	detachedStartTimeout = 2 * time.Minute
+)
+
+// startDetached starts the same odo dev command in the background, with its output written to a log file,
+// and waits for the API server of this background session to be started
+func (o *DevOptions) startDetached(ctx context.Context) error {
+	var (
+		platform   = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+		workingDir = odocontext.GetWorkingDirectory(ctx)
+		logFile    = state.GetLogFilename(platform)
+	)
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("unable to get the path of the odo executable: %w", err)
+	}
+
+	logPath := filepath.Join(workingDir, logFile)
+	err = os.MkdirAll(filepath.Dir(logPath), 0750)
+	if err != nil {
+		return err
+	}
+	out, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("unable to create log file: %w", err)
+	}
+	defer out.Close()
+
+	cmd := exec.Command(executable, getDetachedArgs(os.Args[1:])...)
+	cmd.Dir = workingDir
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.SysProcAttr = detachedSysProcAttr()
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("unable to start odo dev in the background: %w", err)
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	spinner := log.Spinnerf("Starting the Dev session in the background (PID %d)", cmd.Process.Pid)
+	session, err := waitForSession(ctx, o.clientset.StateClient, cmd.Process.Pid, exited, detachedStartTimeout)
+	spinner.End(err == nil)
+	if err != nil {
+		return fmt.Errorf("%w; the output of the session is in %s", err, logPath)
+	}
+
+	log.Finfof(o.out, "\nThe Dev session is running in the background with PID %d", session.PID)
+	log.Finfof(o.out, "API Server started at http://localhost:%d/api/v1", session.APIServerPort)
+	log.Finfof(o.out, "Output of the session: %s", logPath)
+	fmt.Fprintln(o.out)
+	fmt.Fprintln(o.out, "Use `odo dev attach` to follow the session, `odo dev push` to apply the local changes and `odo dev stop` to stop the session.")
+	return nil
+}
+
+// getDetachedArgs returns the arguments of the odo dev command to run in the background,
+// from the arguments of the command started with the --detach flag
+func getDetachedArgs(args []string) []string {
+	result := make([]string, 0, len(args)+1)
+	for _, arg := range args {
+		if arg == "--"+detachFlagName || strings.HasPrefix(arg, "--"+detachFlagName+"=") {
+			continue
+		}
+		result = append(result, arg)
+	}
+	return append(result, "--"+detachedFlagName)
+}
+
+// waitForSession waits for the session started by the process pid to be running, with its API server started.
+// It returns an error if the process exits before, which is notified by the exited channel.
+func waitForSession(ctx context.Context, stateClient state.Client, pid int, exited <-chan error, timeout time.Duration) (api.DevSession, error) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return api.DevSession{}, ctx.Err()
+		case err := <-exited:
+			if err == nil {
+				err = errors.New("exit status 0")
+			}
+			return api.DevSession{}, fmt.Errorf("the Dev session exited: %w", err)
+		case <-deadline:
+			return api.DevSession{}, fmt.Errorf("the Dev session did not start after %s", timeout)
+		case <-ticker.C:
+			sessions, err := stateClient.GetRunningSessions(ctx)
+			if err != nil {
+				return api.DevSession{}, err
+			}
+			for _, session := range sessions {
+				if session.PID == pid && session.APIServerPort != 0 {
+					return session, nil
+				}
+			}
+		}
+	}
+}
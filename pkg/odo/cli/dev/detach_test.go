@@ -0,0 +1,39 @@
+package dev
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_getDetachedArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "detach flag",
+			args: []string{"dev", "--detach", "--platform", "podman"},
+			want: []string{"dev", "--platform", "podman", "--detached"},
+		},
+		{
+			name: "detach flag with value",
+			args: []string{"dev", "--random-ports", "--detach=true"},
+			want: []string{"dev", "--random-ports", "--detached"},
+		},
+		{
+			name: "other flags starting with the same name are kept",
+			args: []string{"dev", "--detach", "--detachable"},
+			want: []string{"dev", "--detachable", "--detached"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getDetachedArgs(tt.args)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getDetachedArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package dev
+
+import (
+	"syscall"
+)
+
+// detachedSysProcAttr returns the attributes of a process running in its own session,
+// so it is not terminated when the terminal of odo is closed
+func detachedSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{
+		Setsid: true,
+	}
+}
@@ -0,0 +1,15 @@
+package dev
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// detachedSysProcAttr returns the attributes of a process detached from the console of odo,
+// so it is not terminated when the console is closed
+func detachedSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{
+		CreationFlags: windows.CREATE_NEW_PROCESS_GROUP | windows.DETACHED_PROCESS,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	apiserver_impl "github.com/redhat-developer/odo/pkg/apiserver-impl"
 
@@ -22,10 +23,13 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/dev/ideconfig"
+	"github.com/redhat-developer/odo/pkg/odo/cli/dev/session"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -35,9 +39,11 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/redhat-developer/odo/pkg/watch"
 )
 
 // RecommendedCommandName is the recommended command name
@@ -54,6 +60,12 @@ type DevOptions struct {
 	out            io.Writer
 	errOut         io.Writer
 	forwardedPorts []api.ForwardedPort
+	// reverseForwardedPorts are the local addresses made reachable from the pod, parsed from the --reverse-port-forward flag
+	reverseForwardedPorts []api.ReverseForwardedPort
+	// components are the components developed when the --component-dir flag is used
+	components []*devComponent
+	// restartPolicy is the restart policy of the run or debug command, parsed from the --restart-policy flag
+	restartPolicy remotecmd.RestartPolicy
 
 	// ctx is used to communicate with WatchAndPush to stop watching and start cleaning up
 	ctx context.Context
@@ -62,24 +74,41 @@ type DevOptions struct {
 	cancel context.CancelFunc
 
 	// Flags
-	noWatchFlag          bool
-	randomPortsFlag      bool
-	debugFlag            bool
-	buildCommandFlag     string
-	runCommandFlag       string
-	ignoreLocalhostFlag  bool
-	forwardLocalhostFlag bool
-	portForwardFlag      []string
-	addressFlag          string
-	noCommandsFlag       bool
-	apiServerFlag        bool
-	apiServerPortFlag    int
-	syncGitDirFlag       bool
-	logsFlag             bool
+	noWatchFlag            bool
+	randomPortsFlag        bool
+	debugFlag              bool
+	buildCommandFlag       string
+	runCommandFlag         string
+	ignoreLocalhostFlag    bool
+	forwardLocalhostFlag   bool
+	portForwardFlag        []string
+	addressFlag            string
+	reversePortForwardFlag []string
+	noCommandsFlag         bool
+	apiServerFlag          bool
+	apiServerPortFlag      int
+	syncGitDirFlag         bool
+	deltaSyncFlag          bool
+	pullPathFlag           []string
+	pullIntervalFlag       time.Duration
+	watchPollingFlag       bool
+	watchPollIntervalFlag  time.Duration
+	watchDebounceFlag      time.Duration
+	restartPolicyFlag      string
+	maxRestartsFlag        int
+	exposeFlag             bool
+	exposeDomainFlag       string
+	exposeTLSFlag          bool
+	logsFlag               bool
+	componentDirFlag       []string
+	detachFlag             bool
+	// detachedFlag is set on the odo dev command started in the background by the --detach flag
+	detachedFlag bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
 var _ genericclioptions.SignalHandler = (*DevOptions)(nil)
+var _ genericclioptions.DevfileUser = (*DevOptions)(nil)
 
 func NewDevOptions() *DevOptions {
 	return &DevOptions{
@@ -100,6 +129,15 @@ var devExample = ktemplates.Examples(`
 
 	# Run your application on cluster in the Dev mode, using custom port-mapping for port-forwarding
 	%[1]s --port-forward 8080:3000 --port-forward 5000:runtime:5858
+
+	# Run your application on the cluster in the Dev mode, making the service listening on the local port 9090 reachable from the pod on port 8080
+	%[1]s --reverse-port-forward 8080:localhost:9090
+
+	# Run your application on the cluster in the Dev mode, in the background
+	%[1]s --detach
+
+	# Run the applications of several components on the cluster in the Dev mode, each directory containing a Devfile
+	%[1]s --component-dir frontend --component-dir api --component-dir worker
 `)
 
 func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
@@ -110,14 +148,21 @@ func (o *DevOptions) PreInit() string {
 	return messages.DevInitializeExistingComponent
 }
 
+// UseDevfile returns false when the Devfiles of the components are in the directories passed with the --component-dir flag
+func (o *DevOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return len(o.componentDirFlag) == 0
+}
+
 func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	// Define this first so that if user hits Ctrl+c very soon after running odo dev, odo doesn't panic
 	o.ctx, o.cancel = context.WithCancel(ctx)
+	if len(o.componentDirFlag) != 0 {
+		return o.completeComponents(ctx)
+	}
 	return nil
 }
 
 func (o *DevOptions) Validate(ctx context.Context) error {
-	devfileObj := *odocontext.GetEffectiveDevfileObj(ctx)
 	if o.noCommandsFlag {
 		if o.buildCommandFlag != "" || o.runCommandFlag != "" {
 			return errors.New("--no-commands cannot be used with --build-command or --run-command")
@@ -137,7 +182,7 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 			return kclient.NewNoConnectionError()
 		}
 		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		if o.ignoreLocalhostFlag && o.forwardLocalhostFlag {
 			return errors.New("--ignore-localhost and --forward-localhost cannot be used together")
 		}
@@ -147,6 +192,59 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
 	}
 
+	if o.watchPollIntervalFlag <= 0 {
+		return errors.New("--watch-poll-interval must be a positive duration")
+	}
+	if o.watchDebounceFlag <= 0 {
+		return errors.New("--watch-debounce must be a positive duration")
+	}
+	if o.pullIntervalFlag <= 0 {
+		return errors.New("--pull-interval must be a positive duration")
+	}
+	var err error
+	o.restartPolicy, err = remotecmd.ParseRestartPolicy(o.restartPolicyFlag)
+	if err != nil {
+		return fmt.Errorf("invalid value for --restart-policy: %w", err)
+	}
+	if o.maxRestartsFlag < 0 {
+		return errors.New("--max-restarts must be a positive number, or 0 for no limit")
+	}
+
+	if len(o.components) != 0 {
+		if err := o.validateComponents(); err != nil {
+			return err
+		}
+	} else if _, err := common.GetWatchTriggersFromAttributes(*odocontext.GetEffectiveDevfileObj(ctx)); err != nil {
+		return err
+	}
+
+	if !o.exposeFlag && (o.exposeDomainFlag != "" || o.exposeTLSFlag) {
+		return errors.New("--expose-domain and --expose-tls can only be used with --expose")
+	}
+	if o.exposeFlag && platform != commonflags.PlatformCluster {
+		return fmt.Errorf("--expose is not supported on %s, the endpoints are only forwarded to the local machine", platform)
+	}
+
+	if o.reversePortForwardFlag != nil {
+		if platform != commonflags.PlatformCluster {
+			return fmt.Errorf("--reverse-port-forward is not supported on %s", platform)
+		}
+		reverseForwardedPorts, err := parseReversePortForwardFlag(o.reversePortForwardFlag)
+		if err != nil {
+			return err
+		}
+		if len(o.components) == 0 {
+			containerEndpointMapping, err := libdevfile.GetDevfileContainerEndpointMapping(*odocontext.GetEffectiveDevfileObj(ctx), true)
+			if err != nil {
+				return fmt.Errorf("failed to obtain container endpoints to validate --reverse-port-forward ports; cause:%w", err)
+			}
+			if err = validateReversePortForwardFlagData(reverseForwardedPorts, containerEndpointMapping); err != nil {
+				return err
+			}
+		}
+		o.reverseForwardedPorts = reverseForwardedPorts
+	}
+
 	if o.randomPortsFlag && o.portForwardFlag != nil {
 		return errors.New("--random-ports and --port-forward cannot be used together")
 	}
@@ -157,7 +255,7 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		}
 	}
 	if o.portForwardFlag != nil {
-		containerEndpointMapping, err := libdevfile.GetDevfileContainerEndpointMapping(devfileObj, true)
+		containerEndpointMapping, err := libdevfile.GetDevfileContainerEndpointMapping(*odocontext.GetEffectiveDevfileObj(ctx), true)
 		if err != nil {
 			return fmt.Errorf("failed to obtain container endpoints to validate --port-forward ports; cause:%w", err)
 		}
@@ -182,6 +280,10 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		return errors.New("--api-server-port makes sense only if --api-server is enabled")
 	}
 
+	if o.detachFlag && !o.apiServerFlag {
+		return errors.New("--detach requires the API Server, to control the session running in the background; it cannot be used with --api-server=false")
+	}
+
 	if o.apiServerFlag && o.apiServerPortFlag != 0 {
 		if o.randomPortsFlag {
 			return errors.New("--random-ports and --api-server-port cannot be used together")
@@ -195,6 +297,13 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 }
 
 func (o *DevOptions) Run(ctx context.Context) (err error) {
+	if o.detachFlag {
+		return o.startDetached(ctx)
+	}
+	if len(o.components) != 0 {
+		return o.runComponents(ctx)
+	}
+
 	var (
 		devFileObj    = odocontext.GetEffectiveDevfileObj(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
@@ -202,20 +311,8 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		componentName = odocontext.GetComponentName(ctx)
 		variables     = fcontext.GetVariables(ctx)
 		platform      = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
-		dest          string
-		deployingTo   string
 	)
-
-	switch platform {
-	case commonflags.PlatformPodman:
-		dest = "Platform: podman"
-		deployingTo = "podman"
-	case commonflags.PlatformCluster:
-		dest = "Namespace: " + odocontext.GetNamespace(ctx)
-		deployingTo = "the cluster"
-	default:
-		panic(fmt.Errorf("platform %s is not implemented", platform))
-	}
+	dest, deployingTo := getDestination(ctx, platform)
 
 	// Output what the command is doing / information
 	log.Title("Developing using the \""+componentName+"\" Devfile", dest)
@@ -223,32 +320,11 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		genericclioptions.WarnIfDefaultNamespace(odocontext.GetNamespace(ctx), o.clientset.KubernetesClient)
 	}
 
-	// check for .gitignore file and add odo-file-index.json to .gitignore.
-	// In case the .gitignore was created by odo, it is purposely not reported as candidate for deletion (via a call to files.ReportLocalFileGeneratedByOdo)
-	// because a .gitignore file is more likely to be modified by the user afterward (for another usage).
-	gitIgnoreFile, _, err := util.TouchGitIgnoreFile(path)
+	o.ignorePaths, err = getIgnorePaths(path, o.syncGitDirFlag)
 	if err != nil {
 		return err
 	}
 
-	// add .odo dir to .gitignore
-	err = util.AddOdoDirectory(gitIgnoreFile)
-	if err != nil {
-		return err
-	}
-
-	var ignores []string
-	err = genericclioptions.ApplyIgnore(&ignores, "")
-	if err != nil {
-		return err
-	}
-	// Ignore the devfile, as it will be handled independently
-	o.ignorePaths = ignores
-
-	if o.syncGitDirFlag {
-		o.ignorePaths = removeGitDir(o.ignorePaths)
-	}
-
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devFileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devFileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devFileObj.Data.GetMetadata().ProjectType)
@@ -256,9 +332,8 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 
 	log.Sectionf("Running on %s in Dev mode", deployingTo)
 
-	err = o.clientset.StateClient.Init(ctx)
+	err = o.initState(ctx)
 	if err != nil {
-		err = fmt.Errorf("unable to save state file: %w", err)
 		return err
 	}
 
@@ -302,26 +377,50 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 
 	return o.clientset.DevClient.Start(
 		o.ctx,
-		dev.StartOptions{
-			IgnorePaths:          o.ignorePaths,
-			Debug:                o.debugFlag,
-			BuildCommand:         o.buildCommandFlag,
-			RunCommand:           o.runCommandFlag,
-			SkipCommands:         o.noCommandsFlag,
-			RandomPorts:          o.randomPortsFlag,
-			WatchFiles:           !o.noWatchFlag,
-			IgnoreLocalhost:      o.ignoreLocalhostFlag,
-			ForwardLocalhost:     o.forwardLocalhostFlag,
-			Variables:            variables,
-			CustomForwardedPorts: o.forwardedPorts,
-			CustomAddress:        o.addressFlag,
-			PushWatcher:          apiServer.PushWatcher,
-			Out:                  o.out,
-			ErrOut:               o.errOut,
-		},
+		o.getStartOptions(variables, o.ignorePaths, o.forwardedPorts, apiServer.PushWatcher, o.out, o.errOut),
 	)
 }
 
+// getStartOptions returns the options to start the development of a component, from the flags of the command
+func (o *DevOptions) getStartOptions(
+	variables map[string]string,
+	ignorePaths []string,
+	forwardedPorts []api.ForwardedPort,
+	pushWatcher <-chan struct{},
+	out io.Writer,
+	errOut io.Writer,
+) dev.StartOptions {
+	return dev.StartOptions{
+		IgnorePaths:           ignorePaths,
+		Debug:                 o.debugFlag,
+		BuildCommand:          o.buildCommandFlag,
+		RunCommand:            o.runCommandFlag,
+		SkipCommands:          o.noCommandsFlag,
+		RandomPorts:           o.randomPortsFlag,
+		WatchFiles:            !o.noWatchFlag,
+		IgnoreLocalhost:       o.ignoreLocalhostFlag,
+		ForwardLocalhost:      o.forwardLocalhostFlag,
+		DeltaSync:             o.deltaSyncFlag,
+		PullPaths:             o.pullPathFlag,
+		PullInterval:          o.pullIntervalFlag,
+		WatchPolling:          o.watchPollingFlag,
+		WatchPollInterval:     o.watchPollIntervalFlag,
+		WatchDebounceInterval: o.watchDebounceFlag,
+		RestartPolicy:         o.restartPolicy,
+		MaxRestarts:           o.maxRestartsFlag,
+		Expose:                o.exposeFlag,
+		ExposeDomain:          o.exposeDomainFlag,
+		ExposeTLS:             o.exposeTLSFlag,
+		Variables:             variables,
+		CustomForwardedPorts:  forwardedPorts,
+		CustomAddress:         o.addressFlag,
+		ReverseForwardedPorts: o.reverseForwardedPorts,
+		PushWatcher:           pushWatcher,
+		Out:                   out,
+		ErrOut:                errOut,
+	}
+}
+
 func (o *DevOptions) followLogs(
 	ctx context.Context,
 ) error {
@@ -344,6 +443,65 @@ func (o *DevOptions) followLogs(
 	)
 }
 
+// initState initializes the state file of the session, and saves the file containing the output of the session if it runs in the background
+func (o *DevOptions) initState(ctx context.Context) error {
+	err := o.clientset.StateClient.Init(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to save state file: %w", err)
+	}
+	if o.detachedFlag {
+		platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+		logFile := filepath.Join(odocontext.GetWorkingDirectory(ctx), state.GetLogFilename(platform))
+		err = o.clientset.StateClient.SetLogFile(ctx, logFile)
+		if err != nil {
+			return fmt.Errorf("unable to save state file: %w", err)
+		}
+	}
+	return nil
+}
+
+// getDestination returns the description of the platform on which the application is run, to be displayed in the title,
+// and the name of this platform
+func getDestination(ctx context.Context, platform string) (dest string, deployingTo string) {
+	switch platform {
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		return "Platform: " + platform, platform
+	case commonflags.PlatformCluster:
+		return "Namespace: " + odocontext.GetNamespace(ctx), "the cluster"
+	default:
+		panic(fmt.Errorf("platform %s is not implemented", platform))
+	}
+}
+
+// getIgnorePaths returns the paths of the component in directory path to ignore when pushing files to the container.
+// It adds the odo-file-index.json file and the .odo directory to the .gitignore file of the component.
+func getIgnorePaths(path string, syncGitDir bool) ([]string, error) {
+	// check for .gitignore file and add odo-file-index.json to .gitignore.
+	// In case the .gitignore was created by odo, it is purposely not reported as candidate for deletion (via a call to files.ReportLocalFileGeneratedByOdo)
+	// because a .gitignore file is more likely to be modified by the user afterward (for another usage).
+	gitIgnoreFile, _, err := util.TouchGitIgnoreFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// add .odo dir to .gitignore
+	err = util.AddOdoDirectory(gitIgnoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var ignores []string
+	err = genericclioptions.ApplyIgnore(&ignores, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if syncGitDir {
+		ignores = removeGitDir(ignores)
+	}
+	return ignores, nil
+}
+
 // removeGitDir removes the `.git` entry from the list of paths to ignore
 // and adds `!.git`, to force the sync of all files into the .git directory
 func removeGitDir(ignores []string) []string {
@@ -364,6 +522,10 @@ func (o *DevOptions) HandleSignal(ctx context.Context, cancelFunc context.Cancel
 }
 
 func (o *DevOptions) Cleanup(ctx context.Context, commandError error) error {
+	if o.detachFlag {
+		// The resources are cleaned up by the session running in the background, when it is stopped
+		return commandError
+	}
 	if errors.As(commandError, &state.ErrAlreadyRunningOnPlatform{}) {
 		klog.V(4).Info("session already running, no need to cleanup")
 		return commandError
@@ -385,6 +547,9 @@ func (o *DevOptions) Cleanup(ctx context.Context, commandError error) error {
 		return err
 	}
 
+	if len(o.components) != 0 {
+		return wrapWithCmdErr(o.cleanupComponents(ctx))
+	}
 	err := o.clientset.DevClient.CleanupResources(ctx, log.GetStdout())
 	return wrapWithCmdErr(err)
 }
@@ -417,11 +582,34 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.Flags().StringArrayVar(&o.portForwardFlag, "port-forward", nil,
 		"Define custom port mapping for port forwarding. Acceptable formats: LOCAL_PORT:REMOTE_PORT, LOCAL_PORT:CONTAINER_NAME:REMOTE_PORT.")
 	devCmd.Flags().StringVar(&o.addressFlag, "address", "127.0.0.1", "Define custom address for port forwarding.")
+	devCmd.Flags().StringArrayVar(&o.reversePortForwardFlag, "reverse-port-forward", nil,
+		"Make a local address reachable from the containers of the component, on a port of the pod. Acceptable formats: REMOTE_PORT:LOCAL_PORT, REMOTE_PORT:LOCAL_ADDRESS:LOCAL_PORT. Applicable to the cluster only.")
 	devCmd.Flags().BoolVar(&o.noCommandsFlag, "no-commands", false, "Do not run any commands; just start the development environment.")
 	devCmd.Flags().BoolVar(&o.syncGitDirFlag, "sync-git-dir", false, "Synchronize the .git directory to the container. By default, this directory is not synchronized.")
+	devCmd.Flags().BoolVar(&o.deltaSyncFlag, "delta-sync", false, "Synchronize only the changed blocks of large files already present in the container, instead of the whole files.")
+	devCmd.Flags().StringArrayVar(&o.pullPathFlag, "pull-path", nil,
+		"Path, relative to the project directory, of files generated in the container to copy back into the local directory. Can be repeated.")
+	devCmd.Flags().DurationVar(&o.pullIntervalFlag, "pull-interval", watch.DefaultPullInterval, "Interval at which the container is checked for files to copy back, when --pull-path is used.")
+	devCmd.Flags().BoolVar(&o.watchPollingFlag, "watch-polling", false,
+		"Poll the files for changes instead of relying on filesystem notifications. Polling is used automatically on network filesystems or when the system limits on watches are reached.")
+	devCmd.Flags().DurationVar(&o.watchPollIntervalFlag, "watch-poll-interval", watch.DefaultPollInterval, "Interval at which the files are polled for changes, when polling is used.")
+	devCmd.Flags().DurationVar(&o.watchDebounceFlag, "watch-debounce", watch.DefaultDebounceInterval, "Time to wait for other changes after a file change is detected, before synchronizing the files.")
+	devCmd.Flags().StringVar(&o.restartPolicyFlag, "restart-policy", string(remotecmd.RestartNever),
+		"Restart the run or debug command when its process exits: never, on-failure (when it exits with an error) or always.")
+	devCmd.Flags().IntVar(&o.maxRestartsFlag, "max-restarts", 5, "Maximum number of consecutive restarts of the run or debug command, when --restart-policy is not never; 0 for no limit.")
+	devCmd.Flags().BoolVar(&o.exposeFlag, "expose", false, "Create an Ingress, or a Route on OpenShift, for each public endpoint. Applicable to the cluster only.")
+	devCmd.Flags().StringVar(&o.exposeDomainFlag, "expose-domain", "",
+		"Domain under which the hostnames of the exposed endpoints are generated, as <endpoint>-<component>-<namespace>.<domain>. Required for Ingresses; optional on OpenShift.")
+	devCmd.Flags().BoolVar(&o.exposeTLSFlag, "expose-tls", false, "Serve the exposed endpoints over TLS, with a generated self-signed certificate.")
 	devCmd.Flags().BoolVar(&o.logsFlag, "logs", false, "Follow logs of component")
 	devCmd.Flags().BoolVar(&o.apiServerFlag, "api-server", true, "Start the API Server")
 	devCmd.Flags().IntVar(&o.apiServerPortFlag, "api-server-port", 0, "Define custom port for API Server; this flag should be used in combination with --api-server flag.")
+	devCmd.Flags().BoolVar(&o.detachFlag, detachFlagName, false,
+		"Run the Dev session in the background. Use `odo dev attach`, `odo dev push` and `odo dev stop` to control it.")
+	devCmd.Flags().BoolVar(&o.detachedFlag, detachedFlagName, false, "Indicates that the Dev session runs in the background")
+	_ = devCmd.Flags().MarkHidden(detachedFlagName)
+	devCmd.Flags().StringArrayVar(&o.componentDirFlag, "component-dir", nil,
+		"Directory containing the Devfile of a component to run in the same session as the other components passed with this flag. Can be repeated.")
 
 	clientset.Add(devCmd,
 		clientset.BINDING,
@@ -444,6 +632,14 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(devCmd)
 	commonflags.UsePlatformFlag(devCmd)
+
+	devCmd.AddCommand(
+		session.NewCmdAttach(session.AttachRecommendedCommandName, odoutil.GetFullName(fullName, session.AttachRecommendedCommandName), testClientset),
+		ideconfig.NewCmdIDEConfig(ideconfig.RecommendedCommandName, odoutil.GetFullName(fullName, ideconfig.RecommendedCommandName), testClientset),
+		session.NewCmdList(session.ListRecommendedCommandName, odoutil.GetFullName(fullName, session.ListRecommendedCommandName), testClientset),
+		session.NewCmdPush(session.PushRecommendedCommandName, odoutil.GetFullName(fullName, session.PushRecommendedCommandName), testClientset),
+		session.NewCmdStop(session.StopRecommendedCommandName, odoutil.GetFullName(fullName, session.StopRecommendedCommandName), testClientset),
+	)
 	return devCmd
 }
 
@@ -557,6 +753,51 @@ func parsePortForwardFlag(portForwardFlag []string) (forwardedPorts []api.Forwar
 	return forwardedPorts, nil
 }
 
+// parseReversePortForwardFlag parses the reverse port forwarding configuration; acceptable patterns: <remotePort>:<localPort>, <remotePort>:<localAddress>:<localPort>
+func parseReversePortForwardFlag(reversePortForwardFlag []string) (reverseForwardedPorts []api.ReverseForwardedPort, err error) {
+	// acceptable examples: 8080:9090, 8080:localhost:9090, 5432:192.168.1.10:5432, 8080:my-host.local:80
+	portReg := regexp.MustCompile(`^(\d{1,5}):(?:([\w.-]+):)?(\d{1,5})$`)
+	const largestPortValue = 65535
+	remotePorts := make(map[int]struct{})
+	for _, portData := range reversePortForwardFlag {
+		matches := portReg.FindStringSubmatch(portData)
+		if matches == nil {
+			return nil, errors.New("reverse ports are not defined properly, acceptable formats are: <remotePort>:<localPort>, <remotePort>:<localAddress>:<localPort>; where ports must be numbers in the range [1, 65535]")
+		}
+		var portR api.ReverseForwardedPort
+		portR.RemotePort, _ = strconv.Atoi(matches[1])
+		portR.LocalAddress = matches[2]
+		portR.LocalPort, _ = strconv.Atoi(matches[3])
+		if portR.LocalAddress == "" {
+			portR.LocalAddress = "localhost"
+		}
+		if !(portR.RemotePort > 0 && portR.RemotePort <= largestPortValue) || !(portR.LocalPort > 0 && portR.LocalPort <= largestPortValue) {
+			return nil, fmt.Errorf("%s is invalid; port numbers must be between 1 and %d", portData, largestPortValue)
+		}
+		if _, ok := remotePorts[portR.RemotePort]; ok {
+			return nil, fmt.Errorf("remote port %d is used more than once, please use unique remote ports", portR.RemotePort)
+		}
+		remotePorts[portR.RemotePort] = struct{}{}
+		reverseForwardedPorts = append(reverseForwardedPorts, portR)
+	}
+	return reverseForwardedPorts, nil
+}
+
+// validateReversePortForwardFlagData checks that the remote ports of the reverse port forwarding are not used by the endpoints of the containers,
+// as all the containers of the pod share the same network
+func validateReversePortForwardFlagData(reverseForwardedPorts []api.ReverseForwardedPort, containerEndpointMapping map[string][]v1alpha2.Endpoint) error {
+	for _, rPort := range reverseForwardedPorts {
+		for container, endpoints := range containerEndpointMapping {
+			for _, endpoint := range endpoints {
+				if endpoint.TargetPort == rPort.RemotePort {
+					return fmt.Errorf("remote port %d of --reverse-port-forward is already used by the endpoint %q of the container %q", rPort.RemotePort, endpoint.Name, container)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // validateCustomAddress validates if the provided ip address is valid;
 // it uses the same checks as defined by func parseAddresses() in "k8s.io/client-go/tools/portforward"
 func validateCustomAddress(address string) error {
@@ -330,6 +330,72 @@ func Test_parsePortForwardFlag(t *testing.T) {
 	}
 }
 
+func Test_parseReversePortForwardFlag(t *testing.T) {
+	tests := []struct {
+		name                      string
+		reversePortForwardFlag    []string
+		wantReverseForwardedPorts []api.ReverseForwardedPort
+		wantErr                   bool
+	}{
+		{
+			name:                   "<remotePort>:<localPort>",
+			reversePortForwardFlag: []string{"8080:9090"},
+			wantReverseForwardedPorts: []api.ReverseForwardedPort{
+				{RemotePort: 8080, LocalAddress: "localhost", LocalPort: 9090},
+			},
+		},
+		{
+			name:                   "<remotePort>:<localAddress>:<localPort>",
+			reversePortForwardFlag: []string{"8080:127.0.0.1:9090", "5432:db.local:5432"},
+			wantReverseForwardedPorts: []api.ReverseForwardedPort{
+				{RemotePort: 8080, LocalAddress: "127.0.0.1", LocalPort: 9090},
+				{RemotePort: 5432, LocalAddress: "db.local", LocalPort: 5432},
+			},
+		},
+		{
+			name:                   "invalid port number",
+			reversePortForwardFlag: []string{"8080:99999"},
+			wantErr:                true,
+		},
+		{
+			name:                   "invalid pattern",
+			reversePortForwardFlag: []string{"localhost:9090:8080"},
+			wantErr:                true,
+		},
+		{
+			name:                   "duplicate remote port",
+			reversePortForwardFlag: []string{"8080:9090", "8080:9091"},
+			wantErr:                true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReversePortForwardFlag(tt.reversePortForwardFlag)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseReversePortForwardFlag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.wantReverseForwardedPorts, got); diff != "" {
+				t.Errorf("parseReversePortForwardFlag() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateReversePortForwardFlagData(t *testing.T) {
+	containerEndpointMapping := map[string][]v1alpha2.Endpoint{
+		"runtime": {{Name: "http", TargetPort: 3000}},
+	}
+	err := validateReversePortForwardFlagData([]api.ReverseForwardedPort{{RemotePort: 8080, LocalAddress: "localhost", LocalPort: 9090}}, containerEndpointMapping)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = validateReversePortForwardFlagData([]api.ReverseForwardedPort{{RemotePort: 3000, LocalAddress: "localhost", LocalPort: 9090}}, containerEndpointMapping)
+	if err == nil {
+		t.Error("expected an error for a remote port used by an endpoint")
+	}
+}
+
 func Test_validateCustomAddress(t *testing.T) {
 	type args struct {
 		address string
@@ -0,0 +1,261 @@
+// Package ideconfig contains the odo dev ide-config command, generating the IDE configurations
+// attaching a debugger to the application of a running Dev session
+package ideconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/ide"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/dev/session"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended ide-config command name
+const RecommendedCommandName = "ide-config"
+
+var ideConfigExample = ktemplates.Examples(`
+	# Display the VS Code launch configuration attaching the debugger to the application of the Dev session started with --debug
+	%[1]s
+
+	# Add the configuration to the .vscode/launch.json file
+	%[1]s --write
+
+	# Create the JetBrains run configuration in the .run directory, for a Java application
+	%[1]s --ide jetbrains --language java --write
+`)
+
+// IDEConfigOptions encapsulates the options for the odo dev ide-config command
+type IDEConfigOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	ideFlag      string
+	languageFlag string
+	writeFlag    bool
+
+	// Variables
+	debugger ide.Debugger
+
+	out io.Writer
+}
+
+var _ genericclioptions.Runnable = (*IDEConfigOptions)(nil)
+
+// NewIDEConfigOptions returns new instance of IDEConfigOptions
+func NewIDEConfigOptions() *IDEConfigOptions {
+	return &IDEConfigOptions{
+		out: log.GetStdout(),
+	}
+}
+
+func (o *IDEConfigOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *IDEConfigOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *IDEConfigOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	supported := false
+	for _, i := range ide.SupportedIDEs {
+		supported = supported || string(i) == o.ideFlag
+	}
+	if !supported {
+		var ides []string
+		for _, i := range ide.SupportedIDEs {
+			ides = append(ides, string(i))
+		}
+		return fmt.Errorf("unsupported IDE %q, supported IDEs are: %s", o.ideFlag, strings.Join(ides, ", "))
+	}
+
+	var err error
+	if o.languageFlag != "" {
+		o.debugger, err = ide.ParseDebugger(o.languageFlag)
+	} else {
+		o.debugger, err = ide.DetectDebugger(devfileObj.Data.GetMetadata())
+	}
+	return err
+}
+
+func (o *IDEConfigOptions) Run(ctx context.Context) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+		componentDir  = filepath.Dir(odocontext.GetDevfilePath(ctx))
+	)
+
+	devSession, err := session.GetSession(ctx, o.clientset.StateClient)
+	if err != nil {
+		return err
+	}
+	targets, err := getDebugTargets(componentName, devSession.ForwardedPorts, *devfileObj)
+	if err != nil {
+		return err
+	}
+
+	switch ide.IDE(o.ideFlag) {
+	case ide.VSCode:
+		return o.runVSCode(componentDir, targets)
+	case ide.JetBrains:
+		return o.runJetBrains(componentDir, targets)
+	}
+	return nil
+}
+
+func (o *IDEConfigOptions) runVSCode(componentDir string, targets []ide.Target) error {
+	configs, err := ide.GetVSCodeConfigurations(o.debugger, targets)
+	if err != nil {
+		return err
+	}
+
+	launchFile := filepath.Join(componentDir, filepath.FromSlash(ide.VSCodeLaunchFile))
+	var existing []byte
+	if o.writeFlag {
+		existing, err = o.clientset.FS.ReadFile(launchFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	content, err := ide.MergeVSCodeLaunch(existing, configs)
+	if err != nil {
+		return err
+	}
+
+	if !o.writeFlag {
+		fmt.Fprintln(o.out, string(content))
+		return nil
+	}
+	if err = o.writeFile(launchFile, content); err != nil {
+		return err
+	}
+	log.Successf("Launch configuration written to %s", ide.VSCodeLaunchFile)
+	return nil
+}
+
+func (o *IDEConfigOptions) runJetBrains(componentDir string, targets []ide.Target) error {
+	configs, err := ide.GetJetBrainsConfigurations(o.debugger, targets)
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		if !o.writeFlag {
+			fmt.Fprintf(o.out, "<!-- %s -->\n%s", config.File, string(config.Content))
+			continue
+		}
+		if err = o.writeFile(filepath.Join(componentDir, filepath.FromSlash(config.File)), config.Content); err != nil {
+			return err
+		}
+		log.Successf("Run configuration written to %s", config.File)
+	}
+	return nil
+}
+
+func (o *IDEConfigOptions) writeFile(file string, content []byte) error {
+	if err := o.clientset.FS.MkdirAll(filepath.Dir(file), 0750); err != nil {
+		return err
+	}
+	return o.clientset.FS.WriteFile(file, content, 0600)
+}
+
+// getDebugTargets returns the targets of the debugger, from the debug ports forwarded by the session.
+// The remote root of each target is the directory into which the sources are synchronized in the container, as defined in the Devfile.
+func getDebugTargets(componentName string, fwPorts []api.ForwardedPort, devfileObj parser.DevfileObj) ([]ide.Target, error) {
+	var debugPorts []api.ForwardedPort
+	for _, fwPort := range fwPorts {
+		if fwPort.IsDebug {
+			debugPorts = append(debugPorts, fwPort)
+		}
+	}
+	if len(debugPorts) == 0 {
+		return nil, errors.New("no debug port is forwarded by the Dev session, run 'odo dev --debug' to start the application in debug mode")
+	}
+
+	containers, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	remoteRoots := make(map[string]string, len(containers))
+	for _, c := range containers {
+		remoteRoots[c.Name] = generator.DevfileSourceVolumeMount
+		if c.Container.GetMountSources() && c.Container.SourceMapping != "" {
+			remoteRoots[c.Name] = c.Container.SourceMapping
+		}
+	}
+
+	targets := make([]ide.Target, 0, len(debugPorts))
+	for _, fwPort := range debugPorts {
+		name := "odo: " + componentName
+		if len(debugPorts) > 1 {
+			name = fmt.Sprintf("odo: %s (%s:%d)", componentName, fwPort.ContainerName, fwPort.ContainerPort)
+		}
+		remoteRoot, ok := remoteRoots[fwPort.ContainerName]
+		if !ok {
+			remoteRoot = generator.DevfileSourceVolumeMount
+		}
+		// The IDE connects to the local host when the port is forwarded on all the interfaces
+		host := fwPort.LocalAddress
+		if host == "" || host == "0.0.0.0" {
+			host = "127.0.0.1"
+		}
+		targets = append(targets, ide.Target{
+			Name:       name,
+			Host:       host,
+			Port:       fwPort.LocalPort,
+			RemoteRoot: remoteRoot,
+		})
+	}
+	return targets, nil
+}
+
+// NewCmdIDEConfig implements the odo dev ide-config command
+func NewCmdIDEConfig(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewIDEConfigOptions()
+	ideConfigCmd := &cobra.Command{
+		Use:   name,
+		Short: "Generate the IDE configurations attaching a debugger to the application of a running Dev session",
+		Long: `Generate the configurations of VS Code or of the JetBrains IDEs attaching a debugger to the application of the Dev session running in the current directory.
+The session needs to be started with 'odo dev --debug'. The debugger (Java JDWP, Node.js inspector, Python debugpy or Delve) is selected from the language of the Devfile metadata.
+The configurations are displayed, or written into the IDE files of the project with --write.`,
+		Example: fmt.Sprintf(ideConfigExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	ideConfigCmd.Flags().StringVar(&o.ideFlag, "ide", string(ide.VSCode), "IDE for which the configurations are generated: vscode or jetbrains")
+	ideConfigCmd.Flags().StringVar(&o.languageFlag, "language", "", "Language of the application (java, node, python, go...). Defaults to the language of the Devfile metadata")
+	ideConfigCmd.Flags().BoolVar(&o.writeFlag, "write", false, "Write the configurations into the IDE files of the project (.vscode/launch.json, or the .run directory for the JetBrains IDEs)")
+	clientset.Add(ideConfigCmd, clientset.FILESYSTEM, clientset.STATE)
+	ideConfigCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(ideConfigCmd)
+	return ideConfigCmd
+}
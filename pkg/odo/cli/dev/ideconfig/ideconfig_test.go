@@ -0,0 +1,113 @@
+package ideconfig
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/v2/pkg/devfile/parser/context"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/ide"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func Test_getDebugTargets(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime := testingutil.GetFakeContainerComponent("runtime")
+	runtime.Container.SourceMapping = "/src"
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		runtime,
+		testingutil.GetFakeContainerComponent("tools"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{
+		Ctx:  devfileCtx.FakeContext(filesystem.NewFakeFs(), parser.OutputDevfileYamlPath),
+		Data: devfileData,
+	}
+
+	httpPort := api.ForwardedPort{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000}
+	runtimeDebug := api.ForwardedPort{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20002, ContainerPort: 5858, IsDebug: true}
+	toolsDebug := api.ForwardedPort{ContainerName: "tools", LocalAddress: "127.0.0.1", LocalPort: 20003, ContainerPort: 5005, IsDebug: true}
+
+	tests := []struct {
+		name    string
+		fwPorts []api.ForwardedPort
+		want    []ide.Target
+		wantErr bool
+	}{
+		{
+			name:    "no debug port",
+			fwPorts: []api.ForwardedPort{httpPort},
+			wantErr: true,
+		},
+		{
+			name:    "single debug port",
+			fwPorts: []api.ForwardedPort{httpPort, runtimeDebug},
+			want: []ide.Target{
+				{Name: "odo: mycomp", Host: "127.0.0.1", Port: 20002, RemoteRoot: "/src"},
+			},
+		},
+		{
+			name:    "several debug ports",
+			fwPorts: []api.ForwardedPort{httpPort, runtimeDebug, toolsDebug},
+			want: []ide.Target{
+				{Name: "odo: mycomp (runtime:5858)", Host: "127.0.0.1", Port: 20002, RemoteRoot: "/src"},
+				{Name: "odo: mycomp (tools:5005)", Host: "127.0.0.1", Port: 20003, RemoteRoot: "/projects"},
+			},
+		},
+		{
+			name: "debug ports forwarded on all the interfaces",
+			fwPorts: []api.ForwardedPort{
+				{ContainerName: "runtime", LocalAddress: "0.0.0.0", LocalPort: 20002, ContainerPort: 5858, IsDebug: true},
+				{ContainerName: "tools", LocalPort: 20003, ContainerPort: 5005, IsDebug: true},
+			},
+			want: []ide.Target{
+				{Name: "odo: mycomp (runtime:5858)", Host: "127.0.0.1", Port: 20002, RemoteRoot: "/src"},
+				{Name: "odo: mycomp (tools:5005)", Host: "127.0.0.1", Port: 20003, RemoteRoot: "/projects"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getDebugTargets("mycomp", tt.fwPorts, devfileObj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getDebugTargets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getDebugTargets() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIDEConfigOptions_runJetBrains(t *testing.T) {
+	var out bytes.Buffer
+	o := &IDEConfigOptions{
+		debugger: ide.DebuggerJava,
+		out:      &out,
+	}
+	targets := []ide.Target{
+		{Name: "odo: mycomp", Host: "127.0.0.1", Port: 20002, RemoteRoot: "/projects"},
+	}
+	if err := o.runJetBrains("/path/to/component", targets); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	if !strings.HasPrefix(got, "<!-- .run/odo_mycomp.run.xml -->\n") {
+		t.Errorf("runJetBrains() should display the file of the configuration first, got:\n%s", got)
+	}
+	if !strings.Contains(got, `<option name="PORT" value="20002" />`) {
+		t.Errorf("runJetBrains() should display the port of the target, got:\n%s", got)
+	}
+}
@@ -0,0 +1,217 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// AttachRecommendedCommandName is the recommended attach command name
+const AttachRecommendedCommandName = "attach"
+
+// followInterval is the interval at which the output of the session is checked for new content
+const followInterval = 500 * time.Millisecond
+
+var attachExample = ktemplates.Examples(`
+	# Follow the output and status of the Dev session running in the background in the current directory
+	%[1]s
+`)
+
+// AttachOptions encapsulates the options for the odo dev attach command
+type AttachOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	out io.Writer
+}
+
+var _ genericclioptions.Runnable = (*AttachOptions)(nil)
+var _ genericclioptions.SignalHandler = (*AttachOptions)(nil)
+
+// NewAttachOptions returns new instance of AttachOptions
+func NewAttachOptions() *AttachOptions {
+	return &AttachOptions{
+		out: log.GetStdout(),
+	}
+}
+
+func (o *AttachOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *AttachOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return false
+}
+
+func (o *AttachOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *AttachOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (o *AttachOptions) Run(ctx context.Context) error {
+	session, err := GetSession(ctx, o.clientset.StateClient)
+	if err != nil {
+		return err
+	}
+	if !session.IsDetached() {
+		return fmt.Errorf("the Dev session with PID %d does not run in the background; its output is displayed in the terminal it has been started from", session.PID)
+	}
+
+	log.Infof("Attached to the Dev session with PID %d running on %s. Press Ctrl+c to detach; the session keeps running in the background.\n", session.PID, session.Platform)
+	var (
+		statuses       map[string]api.DevCommandStatus
+		forwardedPorts = session.ForwardedPorts
+	)
+	// isRunning also displays the changes of the status of the session since the previous call
+	isRunning := func() (bool, error) {
+		sessions, err := o.clientset.StateClient.GetRunningSessions(ctx)
+		if err != nil {
+			return false, err
+		}
+		current, found := findSession(sessions, session.PID)
+		if !found {
+			return false, nil
+		}
+		commands, err := o.clientset.StateClient.GetCommandStatuses(ctx)
+		if err != nil {
+			return false, err
+		}
+		var changes []string
+		statuses, changes = getStatusChanges(statuses, commands, session.Platform)
+		changes = append(changes, getForwardedPortsChanges(forwardedPorts, current.ForwardedPorts)...)
+		forwardedPorts = current.ForwardedPorts
+		for _, change := range changes {
+			fmt.Fprintln(o.out, log.Sbold("Status: ")+change)
+		}
+		return true, nil
+	}
+	err = followFile(ctx, session.LogFile, o.out, followInterval, isRunning)
+	if err != nil {
+		return err
+	}
+	if ctx.Err() == nil {
+		log.Infof("\nThe Dev session with PID %d has exited", session.PID)
+	}
+	return nil
+}
+
+func (o *AttachOptions) HandleSignal(ctx context.Context, cancelFunc context.CancelFunc) error {
+	cancelFunc()
+	select {}
+}
+
+// getStatusChanges returns the statuses of the commands executed on platform, indexed by component and name,
+// and the descriptions of the statuses which changed since previous
+func getStatusChanges(previous map[string]api.DevCommandStatus, commands []api.DevCommandStatus, platform string) (map[string]api.DevCommandStatus, []string) {
+	var (
+		result  = make(map[string]api.DevCommandStatus, len(commands))
+		changes []string
+	)
+	for _, command := range commands {
+		if command.Platform != platform {
+			continue
+		}
+		key := command.Component + "/" + command.Name
+		result[key] = command
+		if prev, found := previous[key]; found && prev.Status == command.Status && prev.Restarts == command.Restarts {
+			continue
+		}
+		change := fmt.Sprintf("command %q", command.Name)
+		if command.Component != "" {
+			change += fmt.Sprintf(" of component %q", command.Component)
+		}
+		change += " is " + command.Status
+		if command.Restarts != 0 {
+			change += fmt.Sprintf(" (restarted %d times)", command.Restarts)
+		}
+		changes = append(changes, change)
+	}
+	return result, changes
+}
+
+// getForwardedPortsChanges returns the descriptions of the ports forwarded in current and not in previous
+func getForwardedPortsChanges(previous, current []api.ForwardedPort) []string {
+	var changes []string
+	for _, port := range current {
+		found := false
+		for _, prev := range previous {
+			if prev.LocalAddress == port.LocalAddress && prev.LocalPort == port.LocalPort && prev.ContainerPort == port.ContainerPort {
+				found = true
+				break
+			}
+		}
+		if !found {
+			changes = append(changes, fmt.Sprintf("forwarding from %s:%d -> %d", port.LocalAddress, port.LocalPort, port.ContainerPort))
+		}
+	}
+	return changes
+}
+
+// followFile writes the content of the file to out, then the content appended to the file every interval,
+// until the context is cancelled or isRunning returns false. isRunning is called every interval.
+func followFile(ctx context.Context, path string, out io.Writer, interval time.Duration, isRunning func() (bool, error)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open the output of the Dev session: %w", err)
+	}
+	defer f.Close()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, err = io.Copy(out, f)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			running, err := isRunning()
+			if err != nil {
+				return err
+			}
+			if !running {
+				// Display the last messages of the session
+				_, err = io.Copy(out, f)
+				return err
+			}
+		}
+	}
+}
+
+// NewCmdAttach implements the odo dev attach command
+func NewCmdAttach(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewAttachOptions()
+	attachCmd := &cobra.Command{
+		Use:   name,
+		Short: "Follow the output and status of a Dev session running in the background",
+		Long: `Follow the output of the Dev session started in the background in the current directory with 'odo dev --detach',
+and the changes of its status: the status of the run or debug commands, and the ports forwarded by the session.`,
+		Example: fmt.Sprintf(attachExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(attachCmd, clientset.FILESYSTEM, clientset.STATE)
+	attachCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(attachCmd)
+	return attachCmd
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_followFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devsession.cluster.log")
+	err := os.WriteFile(path, []byte("line 1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	isRunning := func() (bool, error) {
+		calls++
+		if calls == 1 {
+			// the session writes a new line, then exits
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+			if err != nil {
+				return false, err
+			}
+			defer f.Close()
+			_, err = f.WriteString("line 2\n")
+			return true, err
+		}
+		return false, nil
+	}
+
+	var out bytes.Buffer
+	err = followFile(context.Background(), path, &out, time.Millisecond, isRunning)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "line 1\nline 2\n"
+	if got := out.String(); got != want {
+		t.Errorf("followFile() wrote %q, want %q", got, want)
+	}
+}
+
+func Test_followFile_cancelled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devsession.cluster.log")
+	err := os.WriteFile(path, []byte("line 1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var out bytes.Buffer
+	err = followFile(ctx, path, &out, time.Hour, func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := out.String(); got != "line 1\n" {
+		t.Errorf("followFile() wrote %q, want %q", got, "line 1\n")
+	}
+}
+
+func Test_getStatusChanges(t *testing.T) {
+	commands := []api.DevCommandStatus{
+		{Platform: "cluster", Name: "run", Status: "running"},
+		{Platform: "cluster", Component: "api", Name: "debug", Status: "errored", Restarts: 2},
+		{Platform: "podman", Name: "run", Status: "stopped"},
+	}
+	statuses, changes := getStatusChanges(nil, commands, "cluster")
+	want := []string{
+		`command "run" is running`,
+		`command "debug" of component "api" is errored (restarted 2 times)`,
+	}
+	if diff := cmp.Diff(want, changes); diff != "" {
+		t.Errorf("getStatusChanges() mismatch (-want +got):\n%s", diff)
+	}
+
+	commands[0].Status = "stopped"
+	_, changes = getStatusChanges(statuses, commands, "cluster")
+	want = []string{`command "run" is stopped`}
+	if diff := cmp.Diff(want, changes); diff != "" {
+		t.Errorf("getStatusChanges() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getForwardedPortsChanges(t *testing.T) {
+	previous := []api.ForwardedPort{
+		{LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000},
+	}
+	current := []api.ForwardedPort{
+		{LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000},
+		{LocalAddress: "127.0.0.1", LocalPort: 20002, ContainerPort: 5858},
+	}
+	want := []string{"forwarding from 127.0.0.1:20002 -> 5858"}
+	if diff := cmp.Diff(want, getForwardedPortsChanges(previous, current)); diff != "" {
+		t.Errorf("getForwardedPortsChanges() mismatch (-want +got):\n%s", diff)
+	}
+}
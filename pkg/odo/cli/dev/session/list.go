@@ -0,0 +1,124 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// ListRecommendedCommandName is the recommended list command name
+const ListRecommendedCommandName = "list"
+
+var listExample = ktemplates.Examples(`
+	# List the Dev sessions running in the current directory
+	%[1]s
+`)
+
+// ListOptions encapsulates the options for the odo dev list command
+type ListOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*ListOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*ListOptions)(nil)
+
+// NewListOptions returns new instance of ListOptions
+func NewListOptions() *ListOptions {
+	return &ListOptions{}
+}
+
+func (o *ListOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *ListOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return false
+}
+
+func (o *ListOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *ListOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (o *ListOptions) Run(ctx context.Context) error {
+	sessions, err := o.clientset.StateClient.GetRunningSessions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		log.Info("No Dev session is running in this directory")
+		return nil
+	}
+	HumanReadableOutput(sessions)
+	return nil
+}
+
+func (o *ListOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	sessions, err := o.clientset.StateClient.GetRunningSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sessions == nil {
+		sessions = []api.DevSession{}
+	}
+	return sessions, nil
+}
+
+// HumanReadableOutput displays the sessions in a table
+func HumanReadableOutput(sessions []api.DevSession) {
+	t := ui.NewTable()
+	t.AppendHeader(table.Row{"PID", "PLATFORM", "MODE", "API SERVER", "FORWARDED PORTS"})
+	for _, session := range sessions {
+		mode := "foreground"
+		if session.IsDetached() {
+			mode = "background"
+		}
+		apiServer := "None"
+		if session.APIServerPort != 0 {
+			apiServer = fmt.Sprintf("http://localhost:%d/api/v1", session.APIServerPort)
+		}
+		var ports []string
+		for _, port := range session.ForwardedPorts {
+			ports = append(ports, fmt.Sprintf("%s:%d -> %s:%d", port.LocalAddress, port.LocalPort, port.ContainerName, port.ContainerPort))
+		}
+		t.AppendRow(table.Row{session.PID, session.Platform, mode, apiServer, strings.Join(ports, "\n")})
+	}
+	t.Render()
+}
+
+// NewCmdList implements the odo dev list command
+func NewCmdList(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewListOptions()
+	listCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the running Dev sessions",
+		Long:    "List the Dev sessions running in the current directory, in the foreground or in the background.",
+		Example: fmt.Sprintf(listExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(listCmd, clientset.FILESYSTEM, clientset.STATE)
+	listCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UseOutputFlag(listCmd)
+	commonflags.UsePlatformFlag(listCmd)
+	return listCmd
+}
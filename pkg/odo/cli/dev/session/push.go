@@ -0,0 +1,89 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	openapi "github.com/redhat-developer/odo/pkg/apiserver-gen/go"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// PushRecommendedCommandName is the recommended push command name
+const PushRecommendedCommandName = "push"
+
+var pushExample = ktemplates.Examples(`
+	# Apply the local changes to the application of the Dev session running in the current directory
+	%[1]s
+`)
+
+// PushOptions encapsulates the options for the odo dev push command
+type PushOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*PushOptions)(nil)
+
+// NewPushOptions returns new instance of PushOptions
+func NewPushOptions() *PushOptions {
+	return &PushOptions{}
+}
+
+func (o *PushOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *PushOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return false
+}
+
+func (o *PushOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *PushOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (o *PushOptions) Run(ctx context.Context) error {
+	session, err := GetSession(ctx, o.clientset.StateClient)
+	if err != nil {
+		return err
+	}
+	err = callAPIServer(ctx, session, http.MethodPost, "component/command", openapi.ComponentCommandPostRequest{
+		Name: "push",
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("Local changes are being applied by the Dev session with PID %d", session.PID)
+	return nil
+}
+
+// NewCmdPush implements the odo dev push command
+func NewCmdPush(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewPushOptions()
+	pushCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Apply the local changes to the application of a running Dev session",
+		Long:    "Apply the local changes to the application of the Dev session running in the current directory, as when the 'p' key is pressed in the session.",
+		Example: fmt.Sprintf(pushExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	clientset.Add(pushCmd, clientset.FILESYSTEM, clientset.STATE)
+	pushCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(pushCmd)
+	return pushCmd
+}
@@ -0,0 +1,80 @@
+// Package session contains the commands controlling the odo dev sessions running in the current directory,
+// in particular the sessions started in the background with `odo dev --detach`
+package session
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	openapi "github.com/redhat-developer/odo/pkg/apiserver-gen/go"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+// apiServerTimeout is the maximum duration of a request to the API server of a session
+const apiServerTimeout = 30 * time.Second
+
+// GetSession returns the odo dev session running in the current directory, on the platform passed with the --platform flag, if any.
+// It returns an error if no session is running, or if several sessions are running on different platforms.
+func GetSession(ctx context.Context, stateClient state.Client) (api.DevSession, error) {
+	sessions, err := stateClient.GetRunningSessions(ctx)
+	if err != nil {
+		return api.DevSession{}, err
+	}
+	switch len(sessions) {
+	case 0:
+		return api.DevSession{}, errors.New("no Dev session is running in this directory")
+	case 1:
+		return sessions[0], nil
+	default:
+		return api.DevSession{}, errors.New("several Dev sessions are running in this directory on different platforms, use the --platform flag to select one")
+	}
+}
+
+// callAPIServer sends a request to the API server of the session, on the path relative to the API root.
+// The body, if not nil, is sent in JSON format.
+func callAPIServer(ctx context.Context, session api.DevSession, method string, path string, body interface{}) error {
+	if session.APIServerPort == 0 {
+		return fmt.Errorf("the Dev session with PID %d cannot be controlled, as it has been started without the API Server", session.PID)
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiServerTimeout)
+	defer cancel()
+	url := fmt.Sprintf("http://127.0.0.1:%d/api/v1/%s", session.APIServerPort, path)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach the API Server of the Dev session with PID %d: %w", session.PID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr openapi.GeneralError
+		if err = json.NewDecoder(resp.Body).Decode(&apiErr); err != nil || apiErr.Message == "" {
+			return fmt.Errorf("the API Server of the Dev session with PID %d returned status %d", session.PID, resp.StatusCode)
+		}
+		return errors.New(apiErr.Message)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/state"
+)
+
+// StopRecommendedCommandName is the recommended stop command name
+const StopRecommendedCommandName = "stop"
+
+var stopExample = ktemplates.Examples(`
+	# Stop the Dev session running in the current directory, and delete the resources it created
+	%[1]s
+`)
+
+// StopOptions encapsulates the options for the odo dev stop command
+type StopOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	timeoutFlag time.Duration
+}
+
+var _ genericclioptions.Runnable = (*StopOptions)(nil)
+
+// NewStopOptions returns new instance of StopOptions
+func NewStopOptions() *StopOptions {
+	return &StopOptions{}
+}
+
+func (o *StopOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *StopOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return false
+}
+
+func (o *StopOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *StopOptions) Validate(ctx context.Context) error {
+	if o.timeoutFlag <= 0 {
+		return errors.New("--timeout must be a positive duration")
+	}
+	return nil
+}
+
+func (o *StopOptions) Run(ctx context.Context) error {
+	session, err := GetSession(ctx, o.clientset.StateClient)
+	if err != nil {
+		return err
+	}
+	err = callAPIServer(ctx, session, http.MethodDelete, "instance", nil)
+	if err != nil {
+		return err
+	}
+
+	spinner := log.Spinnerf("Stopping the Dev session with PID %d", session.PID)
+	err = waitForSessionStopped(ctx, o.clientset.StateClient, session, o.timeoutFlag)
+	spinner.End(err == nil)
+	return err
+}
+
+// waitForSessionStopped waits for the session to be stopped, after it has cleaned up its resources
+func waitForSessionStopped(ctx context.Context, stateClient state.Client, session api.DevSession, timeout time.Duration) error {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("the Dev session with PID %d is still running after %s", session.PID, timeout)
+		case <-ticker.C:
+			sessions, err := stateClient.GetRunningSessions(ctx)
+			if err != nil {
+				return err
+			}
+			if _, found := findSession(sessions, session.PID); !found {
+				return nil
+			}
+		}
+	}
+}
+
+// findSession returns the session with the given PID, and false if it is not found
+func findSession(sessions []api.DevSession, pid int) (api.DevSession, bool) {
+	for _, session := range sessions {
+		if session.PID == pid {
+			return session, true
+		}
+	}
+	return api.DevSession{}, false
+}
+
+// NewCmdStop implements the odo dev stop command
+func NewCmdStop(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewStopOptions()
+	stopCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Stop a running Dev session",
+		Long:    "Stop the Dev session running in the current directory. As when Ctrl+c is pressed in the session, the resources created by the session are deleted.",
+		Example: fmt.Sprintf(stopExample, fullName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	stopCmd.Flags().DurationVar(&o.timeoutFlag, "timeout", 5*time.Minute, "Time to wait for the session to delete its resources and exit")
+	clientset.Add(stopCmd, clientset.FILESYSTEM, clientset.STATE)
+	stopCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(stopCmd)
+	return stopCmd
+}
@@ -0,0 +1,174 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/kubectl/pkg/util/term"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+const (
+	RecommendedCommandName = "exec"
+)
+
+type ExecOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Args
+	command []string
+
+	// Flags
+	containerFlag string
+	stdinFlag     bool
+	ttyFlag       bool
+
+	// Variables
+	platformClient platform.Client
+}
+
+var _ genericclioptions.Runnable = (*ExecOptions)(nil)
+
+func NewExecOptions() *ExecOptions {
+	return &ExecOptions{}
+}
+
+var execExample = ktemplates.Examples(`
+	# Run a command in the container of the run command of the component running in the Dev mode
+	%[1]s -- ls -l
+
+	# Run an interactive shell in the container named "runtime"
+	%[1]s --container runtime -it -- /bin/sh
+`)
+
+func (o *ExecOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *ExecOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	var err error
+	o.command, err = cmdline.GetArgsAfterDashes(args)
+	if err != nil || len(o.command) == 0 {
+		return errors.New("the command to execute must be passed after --, for example: odo exec -- ls -l")
+	}
+	return nil
+}
+
+func (o *ExecOptions) Validate(ctx context.Context) error {
+	if odocontext.GetEffectiveDevfileObj(ctx) == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	if o.ttyFlag && !o.stdinFlag {
+		return errors.New("--tty requires --stdin, use -it to run an interactive command")
+	}
+
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+		o.platformClient = o.clientset.KubernetesClient
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+		o.platformClient = o.clientset.PodmanClient
+	}
+	return nil
+}
+
+func (o *ExecOptions) Run(ctx context.Context) error {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
+	)
+
+	pod, err := component.GetRunningDevPod(o.platformClient, componentName, appName)
+	if err != nil {
+		return err
+	}
+
+	containerName, err := component.GetDevContainerName(o.containerFlag, component.GetContainersNames(pod), *devfileObj)
+	if err != nil {
+		return err
+	}
+
+	var stdin io.Reader
+	tty := term.TTY{
+		Out: os.Stdout,
+	}
+	if o.stdinFlag {
+		stdin = os.Stdin
+		tty.In = os.Stdin
+		if o.ttyFlag {
+			if tty.IsTerminalIn() {
+				tty.Raw = true
+			} else {
+				log.Warning("Unable to use a TTY, the input is not a terminal")
+			}
+		}
+	}
+
+	// The error output of the remote command is merged into its standard output when a TTY is used
+	var stderr io.Writer = os.Stderr
+	if tty.Raw {
+		stderr = nil
+	}
+
+	return tty.Safe(func() error {
+		return o.platformClient.ExecCMDInContainer(ctx, containerName, pod.Name, o.command, os.Stdout, stderr, stdin, tty.Raw)
+	})
+}
+
+func NewCmdExec(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewExecOptions()
+	execCmd := &cobra.Command{
+		Use:   name + " [--container name] -- command [args...]",
+		Short: "Execute a command in a container of the component running in the Dev mode",
+		Long: `odo exec executes a command in a container of the component running in the Dev mode ("odo dev" needs to be running).
+By default, the command is executed in the container of the default run command of the Devfile.
+Use the --stdin and --tty flags to run an interactive command, such as a shell.`,
+		Example: fmt.Sprintf(execExample, fullName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	execCmd.Flags().StringVarP(&o.containerFlag, "container", "c", "", "Container in which the command is executed. Defaults to the container of the default run command")
+	execCmd.Flags().BoolVarP(&o.stdinFlag, "stdin", "i", false, "Pass the standard input to the command")
+	execCmd.Flags().BoolVarP(&o.ttyFlag, "tty", "t", false, "Allocate a TTY for the command")
+
+	clientset.Add(execCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+	)
+
+	odoutil.SetCommandGroup(execCmd, odoutil.MainGroup)
+	execCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(execCmd)
+	return execCmd
+}
@@ -0,0 +1,101 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/export"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// ComposeRecommendedCommandName is the recommended compose sub-command name
+const ComposeRecommendedCommandName = "compose"
+
+var exportComposeExample = ktemplates.Examples(`
+  # Export the Devfile as a docker-compose.yaml file in the current directory
+  %[1]s
+
+  # Export the Devfile as a docker-compose.yaml file in another directory, overwriting the existing file
+  %[1]s --output-dir ../environment --force
+`)
+
+// ComposeOptions encapsulates the options for the odo export compose command
+type ComposeOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	outputDirFlag string
+	forceFlag     bool
+
+	// Absolute path of the directory the file is written into
+	outputDir string
+}
+
+var _ genericclioptions.Runnable = (*ComposeOptions)(nil)
+
+// NewComposeOptions creates a new ComposeOptions instance
+func NewComposeOptions() *ComposeOptions {
+	return &ComposeOptions{}
+}
+
+func (o *ComposeOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ComposeOptions after they've been created
+func (o *ComposeOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.outputDir, err = getOutputDir(o.outputDirFlag, odocontext.GetWorkingDirectory(ctx))
+	return err
+}
+
+// Validate validates the ComposeOptions based on completed values
+func (o *ComposeOptions) Validate(ctx context.Context) (err error) {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+// Run contains the logic for the odo export compose command
+func (o *ComposeOptions) Run(ctx context.Context) (err error) {
+	content, err := export.Compose(ctx, *odocontext.GetEffectiveDevfileObj(ctx), o.outputDir)
+	if err != nil {
+		return err
+	}
+	return writeFiles(o.clientset.FS, o.outputDir, map[string][]byte{export.ComposeFileName: content}, o.forceFlag)
+}
+
+// NewCmdCompose implements the odo export compose command
+func NewCmdCompose(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewComposeOptions()
+	composeCmd := &cobra.Command{
+		Use:   name,
+		Short: "Export the Devfile as a Docker Compose file",
+		Long: `Export the Devfile as a Docker Compose file.
+
+The containers of the Devfile are run as services, sharing the same network, as they are in the Pod run by "odo dev --platform podman".
+The sources of the component are mounted into the containers, and the Image components building the images of the containers are
+exported as build sections.`,
+		Example: fmt.Sprintf(exportComposeExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	composeCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "", "Directory into which the docker-compose.yaml file is written (defaults to the directory of the component)")
+	composeCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the existing file")
+	commonflags.UseVariablesFlags(composeCmd)
+	clientset.Add(composeCmd, clientset.FILESYSTEM)
+	composeCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return composeCmd
+}
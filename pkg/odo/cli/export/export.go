@@ -0,0 +1,75 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// RecommendedCommandName is the recommended export command name
+const RecommendedCommandName = "export"
+
+// NewCmdExport implements the export odo command
+func NewCmdExport(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   name,
+		Short: "Export the Devfile into files for other tools",
+	}
+
+	composeCmd := NewCmdCompose(ComposeRecommendedCommandName, util.GetFullName(fullName, ComposeRecommendedCommandName), testClientset)
+	quadletCmd := NewCmdQuadlet(QuadletRecommendedCommandName, util.GetFullName(fullName, QuadletRecommendedCommandName), testClientset)
+	kubernetesCmd := NewCmdKubernetes(KubernetesRecommendedCommandName, util.GetFullName(fullName, KubernetesRecommendedCommandName), testClientset)
+	exportCmd.AddCommand(composeCmd, quadletCmd, kubernetesCmd)
+	util.SetCommandGroup(exportCmd, util.UtilityGroup)
+	exportCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return exportCmd
+}
+
+// getOutputDir returns the absolute path of the directory passed with the --output-dir flag,
+// defaulting to defaultDir
+func getOutputDir(outputDirFlag string, defaultDir string) (string, error) {
+	if outputDirFlag == "" {
+		return defaultDir, nil
+	}
+	return filepath.Abs(outputDirFlag)
+}
+
+// writeFiles writes the files into dir, in the order of their names. The names of the files may contain subdirectories.
+// No file is written if one of them already exists, unless force is true.
+func writeFiles(fs filesystem.Filesystem, dir string, files map[string][]byte, force bool) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !force {
+		for _, name := range names {
+			path := filepath.Join(dir, name)
+			if _, err := fs.Stat(path); err == nil {
+				return fmt.Errorf("file %q already exists, use --force to overwrite it", path)
+			}
+		}
+	}
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := fs.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+		log.Successf("Exported %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/export"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// KubernetesRecommendedCommandName is the recommended kubernetes sub-command name
+const KubernetesRecommendedCommandName = "kubernetes"
+
+// defaultKubernetesOutputDir is the directory, in the directory of the component, into which the manifests are written by default
+const defaultKubernetesOutputDir = "kubernetes"
+
+var exportKubernetesExample = ktemplates.Examples(`
+  # Export the Kubernetes manifests created by odo deploy in the kubernetes directory
+  %[1]s
+
+  # Export the Kubernetes manifests created by odo deploy as a kustomize base
+  %[1]s --format kustomize --output-dir deploy/base
+
+  # Export the Kubernetes manifests created by odo deploy as a Helm chart, whose values are the variables of the Devfile
+  %[1]s --format helm --output-dir chart
+`)
+
+// KubernetesOptions encapsulates the options for the odo export kubernetes command
+type KubernetesOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	formatFlag    string
+	outputDirFlag string
+	forceFlag     bool
+
+	// Absolute path of the directory the manifests are written into
+	outputDir string
+}
+
+var _ genericclioptions.Runnable = (*KubernetesOptions)(nil)
+
+// NewKubernetesOptions creates a new KubernetesOptions instance
+func NewKubernetesOptions() *KubernetesOptions {
+	return &KubernetesOptions{}
+}
+
+func (o *KubernetesOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes KubernetesOptions after they've been created
+func (o *KubernetesOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.outputDir, err = getOutputDir(o.outputDirFlag, filepath.Join(odocontext.GetWorkingDirectory(ctx), defaultKubernetesOutputDir))
+	return err
+}
+
+// Validate validates the KubernetesOptions based on completed values
+func (o *KubernetesOptions) Validate(ctx context.Context) (err error) {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	for _, format := range export.KubernetesFormats {
+		if o.formatFlag == string(format) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported format %q, supported formats are: %s", o.formatFlag, getKubernetesFormatsList())
+}
+
+// Run contains the logic for the odo export kubernetes command
+func (o *KubernetesOptions) Run(ctx context.Context) (err error) {
+	files, err := export.Kubernetes(ctx, *odocontext.GetEffectiveDevfileObj(ctx), export.KubernetesFormat(o.formatFlag),
+		o.clientset.PreferenceClient.GetImageRegistry())
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no Kubernetes or OpenShift component is applied by the deploy command of the Devfile")
+	}
+	return writeFiles(o.clientset.FS, o.outputDir, files, o.forceFlag)
+}
+
+func getKubernetesFormatsList() string {
+	formats := make([]string, 0, len(export.KubernetesFormats))
+	for _, format := range export.KubernetesFormats {
+		formats = append(formats, string(format))
+	}
+	return strings.Join(formats, ", ")
+}
+
+// NewCmdKubernetes implements the odo export kubernetes command
+func NewCmdKubernetes(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewKubernetesOptions()
+	kubernetesCmd := &cobra.Command{
+		Use:   name,
+		Short: "Export the Kubernetes manifests created by odo deploy",
+		Long: `Export the Kubernetes manifests created by odo deploy.
+
+The deploy command of the Devfile is run in render-only mode: the Kubernetes and OpenShift components it applies are written
+with the labels set by odo, with the variables of the Devfile substituted and the image names computed as odo deploy does.
+The images are neither built nor pushed, and the commands executed in containers are skipped.`,
+		Example: fmt.Sprintf(exportKubernetesExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	kubernetesCmd.Flags().StringVar(&o.formatFlag, "format", string(export.YAMLFormat),
+		fmt.Sprintf("Format of the exported manifests (%s)", getKubernetesFormatsList()))
+	kubernetesCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "", "Directory into which the manifests are written (defaults to the kubernetes directory of the component)")
+	kubernetesCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the existing files")
+	commonflags.UseVariablesFlags(kubernetesCmd)
+	clientset.Add(kubernetesCmd, clientset.FILESYSTEM, clientset.PREFERENCE)
+	kubernetesCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return kubernetesCmd
+}
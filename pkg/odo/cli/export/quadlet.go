@@ -0,0 +1,102 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/export"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// QuadletRecommendedCommandName is the recommended quadlet sub-command name
+const QuadletRecommendedCommandName = "quadlet"
+
+var exportQuadletExample = ktemplates.Examples(`
+  # Export the Devfile as Podman Quadlet units in the current directory
+  %[1]s
+
+  # Export the Devfile as Podman Quadlet units of the current user, and start them with systemd
+  %[1]s --output-dir ~/.config/containers/systemd
+  systemctl --user daemon-reload
+`)
+
+// QuadletOptions encapsulates the options for the odo export quadlet command
+type QuadletOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	outputDirFlag string
+	forceFlag     bool
+
+	// Absolute path of the directory the units are written into
+	outputDir string
+}
+
+var _ genericclioptions.Runnable = (*QuadletOptions)(nil)
+
+// NewQuadletOptions creates a new QuadletOptions instance
+func NewQuadletOptions() *QuadletOptions {
+	return &QuadletOptions{}
+}
+
+func (o *QuadletOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes QuadletOptions after they've been created
+func (o *QuadletOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.outputDir, err = getOutputDir(o.outputDirFlag, odocontext.GetWorkingDirectory(ctx))
+	return err
+}
+
+// Validate validates the QuadletOptions based on completed values
+func (o *QuadletOptions) Validate(ctx context.Context) (err error) {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+	return nil
+}
+
+// Run contains the logic for the odo export quadlet command
+func (o *QuadletOptions) Run(ctx context.Context) (err error) {
+	units, err := export.Quadlet(ctx, *odocontext.GetEffectiveDevfileObj(ctx))
+	if err != nil {
+		return err
+	}
+	return writeFiles(o.clientset.FS, o.outputDir, units, o.forceFlag)
+}
+
+// NewCmdQuadlet implements the odo export quadlet command
+func NewCmdQuadlet(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewQuadletOptions()
+	quadletCmd := &cobra.Command{
+		Use:   name,
+		Short: "Export the Devfile as Podman Quadlet units",
+		Long: `Export the Devfile as Podman Quadlet units.
+
+A .container unit is written for each container of the Devfile, a .volume unit for each volume, and a .network unit.
+The containers share the same network, as they do in the Pod run by "odo dev --platform podman", and the sources of the component
+are mounted into the containers.`,
+		Example: fmt.Sprintf(exportQuadletExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	quadletCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "", "Directory into which the units are written (defaults to the directory of the component)")
+	quadletCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the existing files")
+	commonflags.UseVariablesFlags(quadletCmd)
+	clientset.Add(quadletCmd, clientset.FILESYSTEM)
+	quadletCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return quadletCmd
+}
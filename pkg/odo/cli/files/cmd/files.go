@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/cli/files/status"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended files command name
+const RecommendedCommandName = "files"
+
+// NewCmdFiles implements the files odo command
+func NewCmdFiles(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	var filesCmd = &cobra.Command{
+		Use:   name,
+		Short: "Inspect the files synchronized into the component",
+	}
+
+	statusCmd := status.NewCmdStatus(status.RecommendedCommandName, util.GetFullName(fullName, status.RecommendedCommandName), testClientset)
+	filesCmd.AddCommand(statusCmd)
+	util.SetCommandGroup(filesCmd, util.MainGroup)
+	filesCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return filesCmd
+}
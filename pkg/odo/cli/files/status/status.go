@@ -0,0 +1,220 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+	"github.com/redhat-developer/odo/pkg/sync"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// RecommendedCommandName is the recommended status sub-command name
+const RecommendedCommandName = "status"
+
+var statusExample = ktemplates.Examples(`
+	# Display the files differing between the local directory and the container of the component running in Dev mode
+	%[1]s
+
+	# Synchronize the files differing between the local directory and the container
+	%[1]s --sync
+`)
+
+type StatusOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	syncFlag bool
+
+	// platformClient is the client of the platform the component is running on
+	platformClient platform.Client
+}
+
+var _ genericclioptions.Runnable = (*StatusOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*StatusOptions)(nil)
+
+func NewStatusOptions() *StatusOptions {
+	return &StatusOptions{}
+}
+
+func (o *StatusOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *StatusOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	return nil
+}
+
+func (o *StatusOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return kclient.NewNoConnectionError()
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+		o.platformClient = o.clientset.KubernetesClient
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		if o.clientset.PodmanClient == nil {
+			return podman.NewPodmanNotFoundError(nil)
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+		o.platformClient = o.clientset.PodmanClient
+	}
+	return nil
+}
+
+func (o *StatusOptions) Run(ctx context.Context) error {
+	status, err := o.run(ctx)
+	if err != nil {
+		return err
+	}
+	printHumanReadableOutput(status)
+	if o.syncFlag && status.HasDrift() {
+		log.Successf("Synchronized %d files into the container", len(status.Added)+len(status.Modified)+len(status.Deleted))
+	}
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *StatusOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.run(ctx)
+}
+
+func (o *StatusOptions) run(ctx context.Context) (api.FilesStatus, error) {
+	var (
+		componentName = odocontext.GetComponentName(ctx)
+		devfilePath   = odocontext.GetDevfilePath(ctx)
+		path          = filepath.Dir(devfilePath)
+	)
+
+	pod, err := o.platformClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return api.FilesStatus{}, fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
+	}
+	containerName, syncFolder, err := common.GetFirstContainerWithSourceVolume(pod.Spec.Containers)
+	if err != nil {
+		return api.FilesStatus{}, fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", pod.GetName(), err)
+	}
+	compInfo := sync.ComponentInfo{
+		ComponentName: componentName,
+		ContainerName: containerName,
+		PodName:       pod.GetName(),
+		SyncFolder:    syncFolder,
+	}
+
+	var ignores []string
+	err = genericclioptions.ApplyIgnore(&ignores, path)
+	if err != nil {
+		return api.FilesStatus{}, err
+	}
+
+	status, err := o.clientset.SyncClient.GetFilesStatus(ctx, sync.FilesStatusParameters{
+		Path:         path,
+		IgnoredFiles: ignores,
+		CompInfo:     compInfo,
+	})
+	if err != nil {
+		return api.FilesStatus{}, err
+	}
+
+	if !o.syncFlag || !status.HasDrift() {
+		return status, nil
+	}
+
+	toAbsolute := func(files []string) []string {
+		result := make([]string, 0, len(files))
+		for _, file := range files {
+			result = append(result, filepath.Join(path, filepath.FromSlash(file)))
+		}
+		return result
+	}
+	// As for the first sync of odo dev, the index of the files is generated if it does not exist yet.
+	// Otherwise, it is updated with the files differing, as for the syncs of the changes watched by odo dev.
+	indexPath, err := util.ResolveIndexFilePath(path)
+	if err != nil {
+		return api.FilesStatus{}, err
+	}
+	_, err = o.clientset.FS.Stat(indexPath)
+	scanIndex := errors.Is(err, fs.ErrNotExist)
+
+	_, err = o.clientset.SyncClient.SyncFiles(ctx, sync.SyncParameters{
+		Path:                     path,
+		WatchFiles:               toAbsolute(append(append([]string{}, status.Added...), status.Modified...)),
+		WatchDeletedFiles:        toAbsolute(status.Deleted),
+		IgnoredFiles:             ignores,
+		DevfileScanIndexForWatch: scanIndex,
+		CompInfo:                 compInfo,
+		Compression:              o.clientset.SyncClient.GetSupportedCompression(ctx, compInfo),
+	})
+	if err != nil {
+		return api.FilesStatus{}, fmt.Errorf("unable to synchronize files: %w", err)
+	}
+	return status, nil
+}
+
+func printHumanReadableOutput(status api.FilesStatus) {
+	if !status.HasDrift() {
+		log.Success("The files in the container are up to date with the local files")
+		return
+	}
+	log.Info("Files differing between the local directory and the container:")
+	for _, file := range status.Added {
+		log.Printf("added:    %s", file)
+	}
+	for _, file := range status.Modified {
+		log.Printf("modified: %s", file)
+	}
+	for _, file := range status.Deleted {
+		log.Printf("deleted:  %s", file)
+	}
+}
+
+func NewCmdStatus(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewStatusOptions()
+	statusCmd := &cobra.Command{
+		Use:     name,
+		Aliases: []string{"diff"},
+		Short:   "Display the differences between the local files and the files in the container",
+		Long: `odo files status compares the checksums of the local files with the checksums of the files in the container of the component running in Dev mode ("odo dev" needs to be running).
+It reports the files added or modified locally but not synchronized yet, and the files deleted locally but still present in the container.`,
+		Example: fmt.Sprintf(statusExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	statusCmd.Flags().BoolVar(&o.syncFlag, "sync", false, "Synchronize into the container the files differing from the local files")
+	clientset.Add(statusCmd,
+		clientset.FILESYSTEM,
+		clientset.KUBERNETES_NULLABLE,
+		clientset.PODMAN_NULLABLE,
+		clientset.SYNC,
+	)
+	commonflags.UseOutputFlag(statusCmd)
+	commonflags.UsePlatformFlag(statusCmd)
+	return statusCmd
+}
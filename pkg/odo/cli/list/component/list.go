@@ -115,12 +115,12 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		kubeClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, lo.clientset.DockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -156,7 +156,7 @@ func NewCmdComponentList(ctx context.Context, name, fullName string, testClients
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
 
@@ -115,12 +115,12 @@ func (lo *ListOptions) run(ctx context.Context) (list api.ResourcesList, err err
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		kubeClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, lo.clientset.DockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -167,7 +167,7 @@ func NewCmdList(ctx context.Context, name, fullName string, testClientset client
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.BINDING, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 
 	namespaceCmd := namespace.NewCmdNamespaceList(namespace.RecommendedCommandName, odoutil.GetFullName(fullName, namespace.RecommendedCommandName), testClientset)
@@ -92,7 +92,7 @@ func (o *LogsOptions) Validate(ctx context.Context) error {
 		if o.clientset.KubernetesClient == nil {
 			return kclient.NewNoConnectionError()
 		}
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		if o.clientset.PodmanClient == nil {
 			return podman.NewPodmanNotFoundError(nil)
 		}
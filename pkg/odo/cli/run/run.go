@@ -83,7 +83,7 @@ func (o *RunOptions) Validate(ctx context.Context) error {
 		}
 		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
 
-	case commonflags.PlatformPodman:
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
 		if o.clientset.PodmanClient == nil {
 			return podman.NewPodmanNotFoundError(nil)
 		}
@@ -20,6 +20,19 @@ func TestUsePlatformFlagOK(t *testing.T) {
 	}
 }
 
+func TestUsePlatformFlagDocker(t *testing.T) {
+	cmd := &cobra.Command{}
+	UsePlatformFlag(cmd)
+	err := pflag.CommandLine.Set("platform", "docker")
+	if err != nil {
+		t.Errorf("Set error should be nil but is %v", err)
+	}
+	err = CheckPlatformCommand(cmd)
+	if err != nil {
+		t.Errorf("Check error should be nil but is %v", err)
+	}
+}
+
 func TestUsePlatformFlagNotUsed(t *testing.T) {
 	cmd := &cobra.Command{}
 	err := pflag.CommandLine.Set("platform", "cluster")
@@ -39,7 +52,7 @@ func TestUsePlatformFlagWrongValue(t *testing.T) {
 		t.Errorf("Set error should be nil but is %v", err)
 	}
 	err = CheckPlatformCommand(cmd)
-	if err.Error() != `wrong-value is not a valid target platform for --platform, please select either "cluster" (default) or "podman"` {
+	if err.Error() != `wrong-value is not a valid target platform for --platform, please select either "cluster" (default), "podman" or "docker"` {
 		t.Errorf("Check error is %v", err)
 	}
 }
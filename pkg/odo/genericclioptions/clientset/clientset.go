@@ -20,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev"
 	"github.com/redhat-developer/odo/pkg/dev/podmandev"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/informer"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -61,6 +62,9 @@ const (
 	DEPLOY = "DEP_DEPLOY"
 	// DEV instantiates client for pkg/dev
 	DEV = "DEP_DEV"
+	// DOCKER_NULLABLE instantiates client for pkg/docker, in addition to the Podman client, when no platform is selected, can be nil.
+	// When the docker platform is selected, the Podman client accesses Docker.
+	DOCKER_NULLABLE = "DEP_DOCKER_NULLABLE"
 	// EXEC instantiates client for pkg/exec
 	EXEC = "DEP_EXEC"
 	// FILESYSTEM instantiates client for pkg/testingutil/filesystem
@@ -104,7 +108,7 @@ var subdeps map[string][]string = map[string][]string{
 	ALIZER:           {REGISTRY},
 	CONFIG_AUTOMOUNT: {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
 	DELETE_COMPONENT: {KUBERNETES_NULLABLE, PODMAN_NULLABLE, EXEC, CONFIG_AUTOMOUNT},
-	DEPLOY:           {KUBERNETES, FILESYSTEM, CONFIG_AUTOMOUNT},
+	DEPLOY:           {KUBERNETES_NULLABLE, PODMAN_NULLABLE, FILESYSTEM, CONFIG_AUTOMOUNT},
 	DEV: {
 		BINDING,
 		DELETE_COMPONENT,
@@ -142,6 +146,7 @@ type Clientset struct {
 	DeleteClient          _delete.Client
 	DeployClient          deploy.Client
 	DevClient             dev.Client
+	DockerClient          podman.Client
 	ExecClient            exec.Client
 	FS                    filesystem.Filesystem
 	InformerClient        *informer.InformerClient
@@ -233,8 +238,15 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	if isDefined(command, PODMAN) || isDefined(command, PODMAN_NULLABLE) {
 		if testClientset.PodmanClient != nil {
 			dep.PodmanClient = testClientset.PodmanClient
+		} else if platform == commonflags.PlatformDocker {
+			// The Docker client runs the pods of the components as the Podman client does
+			dockerClient, err := docker.NewDockerCli(ctx)
+			if err != nil {
+				return nil, docker.NewDockerNotFoundError(err)
+			}
+			dep.PodmanClient = dockerClient
 		} else {
-			dep.PodmanClient, err = podman.NewPodmanCli(ctx)
+			dep.PodmanClient, err = podman.NewPodmanClient(ctx)
 			if err != nil {
 				// send error in case the command is to run on podman platform or if PODMAN clientset is required.
 				if isDefined(command, PODMAN) || platform == commonflags.PlatformPodman {
@@ -245,6 +257,18 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 			}
 		}
 	}
+	if isDefined(command, DOCKER_NULLABLE) && platform == "" {
+		if testClientset.DockerClient != nil {
+			dep.DockerClient = testClientset.DockerClient
+		} else {
+			dockerClient, err := docker.NewDockerCli(ctx)
+			if err != nil {
+				klog.V(3).Infof("no Docker client initialized: %v", err)
+			} else {
+				dep.DockerClient = dockerClient
+			}
+		}
+	}
 	if isDefined(command, PREFERENCE) {
 		dep.PreferenceClient, err = preference.NewClient(ctx)
 		if err != nil {
@@ -265,7 +289,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, EXEC) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.ExecClient = exec.NewExecClient(dep.PodmanClient)
 		default:
 			dep.ExecClient = exec.NewExecClient(dep.KubernetesClient)
@@ -273,7 +297,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, CONFIG_AUTOMOUNT) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.ConfigAutomountClient = nil // Not supported
 		default:
 			dep.ConfigAutomountClient = configAutomount.NewKubernetesClient(dep.KubernetesClient)
@@ -283,14 +307,19 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 		dep.DeleteClient = _delete.NewDeleteComponentClient(dep.KubernetesClient, dep.PodmanClient, dep.ExecClient, dep.ConfigAutomountClient)
 	}
 	if isDefined(command, DEPLOY) {
-		dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.ConfigAutomountClient, dep.FS)
+		switch platform {
+		case commonflags.PlatformPodman:
+			dep.DeployClient = deploy.NewDeployClient(dep.PodmanClient, dep.ConfigAutomountClient, dep.FS)
+		default:
+			dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.ConfigAutomountClient, dep.FS)
+		}
 	}
 	if isDefined(command, INIT) {
 		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient)
 	}
 	if isDefined(command, LOGS) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.LogsClient = logs.NewLogsClient(dep.PodmanClient)
 		default:
 			dep.LogsClient = logs.NewLogsClient(dep.KubernetesClient)
@@ -304,7 +333,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	}
 	if isDefined(command, SYNC) {
 		switch platform {
-		case commonflags.PlatformPodman:
+		case commonflags.PlatformPodman, commonflags.PlatformDocker:
 			dep.SyncClient = sync.NewSyncClient(dep.PodmanClient, dep.ExecClient)
 		default:
 			dep.SyncClient = sync.NewSyncClient(dep.KubernetesClient, dep.ExecClient)
@@ -317,42 +346,65 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 		dep.BindingClient = binding.NewBindingClient(dep.ProjectClient, dep.KubernetesClient)
 	}
 	if isDefined(command, PORT_FORWARD) {
-		switch platform {
-		case commonflags.PlatformPodman:
-			dep.PortForwardClient = podmanportforward.NewPFClient(dep.ExecClient)
-		default:
-			dep.PortForwardClient = kubeportforward.NewPFClient(dep.KubernetesClient, dep.StateClient)
-		}
+		dep.PortForwardClient = newPortForwardClient(&dep, platform, dep.StateClient)
 	}
 	if isDefined(command, DEV) {
-		switch platform {
-		case commonflags.PlatformPodman:
-			dep.DevClient = podmandev.NewDevClient(
-				dep.FS,
-				dep.PodmanClient,
-				dep.PreferenceClient,
-				dep.PortForwardClient,
-				dep.SyncClient,
-				dep.ExecClient,
-				dep.StateClient,
-				dep.WatchClient,
-			)
-		default:
-			dep.DevClient = kubedev.NewDevClient(
-				dep.KubernetesClient,
-				dep.PreferenceClient,
-				dep.PortForwardClient,
-				dep.WatchClient,
-				dep.BindingClient,
-				dep.SyncClient,
-				dep.FS,
-				dep.ExecClient,
-				dep.DeleteClient,
-				dep.ConfigAutomountClient,
-			)
-		}
+		dep.DevClient = newDevClient(&dep, platform, dep.StateClient)
 	}
 
 	/* Instantiate new clients here. Take care to instantiate after all sub-dependencies */
 	return &dep, nil
 }
+
+// ForComponent returns a copy of the clientset, with new instances of the clients keeping a state specific to a component.
+// It is used to develop several components in the same session; the ports forwarded for the component
+// are saved into the state of the session.
+func (o *Clientset) ForComponent(componentName string, platform string) *Clientset {
+	dep := *o
+	componentState := o.StateClient.ForComponent(componentName)
+	dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient, dep.InformerClient)
+	dep.PortForwardClient = newPortForwardClient(&dep, platform, componentState)
+	dep.DevClient = newDevClient(&dep, platform, componentState)
+	return &dep
+}
+
+// newPortForwardClient returns a port forward client saving the forwarded ports with stateClient
+func newPortForwardClient(dep *Clientset, platform string, stateClient state.ComponentClient) portForward.Client {
+	switch platform {
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		return podmanportforward.NewPFClient(dep.ExecClient)
+	default:
+		return kubeportforward.NewPFClient(dep.KubernetesClient, stateClient)
+	}
+}
+
+// newDevClient returns a dev client saving the forwarded ports and the statuses of the commands with stateClient
+func newDevClient(dep *Clientset, platform string, stateClient state.ComponentClient) dev.Client {
+	switch platform {
+	case commonflags.PlatformPodman, commonflags.PlatformDocker:
+		return podmandev.NewDevClient(
+			dep.FS,
+			dep.PodmanClient,
+			dep.PreferenceClient,
+			dep.PortForwardClient,
+			dep.SyncClient,
+			dep.ExecClient,
+			stateClient,
+			dep.WatchClient,
+		)
+	default:
+		return kubedev.NewDevClient(
+			dep.KubernetesClient,
+			dep.PreferenceClient,
+			dep.PortForwardClient,
+			dep.WatchClient,
+			dep.BindingClient,
+			dep.SyncClient,
+			dep.FS,
+			dep.ExecClient,
+			dep.DeleteClient,
+			dep.ConfigAutomountClient,
+			stateClient,
+		)
+	}
+}
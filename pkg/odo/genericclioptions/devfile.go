@@ -48,3 +48,22 @@ func getDevfileInfo(cmd *cobra.Command, fsys filesystem.Filesystem, workingDir s
 
 	return devfilePath, devfileObj, componentName, nil
 }
+
+// GetComponentDevfileInfo returns the path and the content of the Devfile contained in the directory dir,
+// and the name of the component it defines.
+// It returns an error if the directory does not contain any Devfile.
+func GetComponentDevfileInfo(fsys filesystem.Filesystem, dir string, variables map[string]string, imageRegistry string) (
+	devfilePath string,
+	devfileObj *parser.DevfileObj,
+	componentName string,
+	err error,
+) {
+	devfilePath, devfileObj, componentName, err = getDevfileInfo(nil, fsys, dir, variables, imageRegistry)
+	if err != nil {
+		return "", nil, "", err
+	}
+	if devfileObj == nil {
+		return "", nil, "", fmt.Errorf("no Devfile found in directory %q", dir)
+	}
+	return devfilePath, devfileObj, componentName, nil
+}
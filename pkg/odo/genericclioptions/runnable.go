@@ -57,6 +57,7 @@ type Cleanuper interface {
 }
 
 // A PreIniter command is a command that will run `init` command if no file is present in current directory
+// and if the command uses the Devfile (see DevfileUser)
 // Commands implementing this interfaec must add FILESYSTEM and INIT dependencies
 type PreIniter interface {
 	// PreInit indicates a command will run `init`, and display the message returned by the method
@@ -247,7 +248,12 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 		}
 		ctx = fcontext.WithVariables(ctx, variables)
 
-		if preiniter, ok := o.(PreIniter); ok {
+		useDevfile := true
+		if devfileUser, ok := o.(DevfileUser); ok {
+			useDevfile = devfileUser.UseDevfile(ctx, cmdLineObj, args)
+		}
+
+		if preiniter, ok := o.(PreIniter); ok && useDevfile {
 			msg := preiniter.PreInit()
 			err = runPreInit(ctx, cwd, deps, cmdLineObj, msg)
 			if err != nil {
@@ -256,11 +262,6 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 			}
 		}
 
-		useDevfile := true
-		if devfileUser, ok := o.(DevfileUser); ok {
-			useDevfile = devfileUser.UseDevfile(ctx, cmdLineObj, args)
-		}
-
 		if useDevfile {
 			var devfilePath, componentName string
 			var devfileObj *parser.DevfileObj
@@ -0,0 +1,232 @@
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// apiVersion is the version of the libpod API used by the client, supported by Podman v4 and later
+const apiVersion = "v4.0.0"
+
+// PodmanAPIClient accesses Podman through the libpod REST API, exposed on the unix socket of the Podman service
+// (started with `podman system service` or with the podman.socket systemd unit)
+type PodmanAPIClient struct {
+	socketPath  string
+	httpClient  *http.Client
+	initTimeout time.Duration
+}
+
+var _ Client = (*PodmanAPIClient)(nil)
+var _ platform.Client = (*PodmanAPIClient)(nil)
+
+// APIError is the error returned by the libpod API
+type APIError struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+func (o APIError) Error() string {
+	return o.Message
+}
+
+// NewPodmanClient returns a client using the libpod API when the socket of the Podman service is available,
+// or a client running the podman command otherwise.
+// The podman command is always used when it is configured with PODMAN_CMD, ODO_CONTAINER_BACKEND_GLOBAL_ARGS or ODO_CONTAINER_RUN_ARGS,
+// as these options cannot be applied to the API.
+func NewPodmanClient(ctx context.Context) (Client, error) {
+	envConfig := envcontext.GetEnvConfig(ctx)
+	if envConfig.PodmanCmd == "podman" && len(envConfig.OdoContainerBackendGlobalArgs) == 0 && len(envConfig.OdoContainerRunArgs) == 0 {
+		if socketPath := getSocketPath(ctx); socketPath != "" {
+			apiClient, err := NewPodmanAPIClient(ctx, socketPath)
+			if err == nil {
+				return apiClient, nil
+			}
+			klog.V(3).Infof("unable to use the Podman socket %s, falling back to the podman command: %v", socketPath, err)
+		}
+	}
+	cli, err := NewPodmanCli(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
+// NewPodmanAPIClient returns a new client using the libpod API exposed on the socketPath unix socket,
+// or an error if the API cannot be reached
+func NewPodmanAPIClient(ctx context.Context, socketPath string) (*PodmanAPIClient, error) {
+	if _, err := os.Stat(socketPath); err != nil {
+		return nil, err
+	}
+	client := &PodmanAPIClient{
+		socketPath:  socketPath,
+		initTimeout: envcontext.GetEnvConfig(ctx).PodmanCmdInitTimeout,
+	}
+	client.httpClient = &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return client.dial(ctx)
+			},
+		},
+	}
+	version, err := client.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version.Client == nil {
+		return nil, fmt.Errorf("socket %q not recognized as a Podman API", socketPath)
+	}
+	return client, nil
+}
+
+// getSocketPath returns the path of the socket of the Podman service, from the CONTAINER_HOST environment variable,
+// or the default path for the current user. An empty string is returned if CONTAINER_HOST does not define a unix socket.
+func getSocketPath(ctx context.Context) string {
+	if host := envcontext.GetEnvConfig(ctx).ContainerHost; host != nil && *host != "" {
+		if !strings.HasPrefix(*host, "unix://") {
+			return ""
+		}
+		return strings.TrimPrefix(*host, "unix://")
+	}
+	uid := os.Geteuid()
+	if uid == 0 {
+		return "/run/podman/podman.sock"
+	}
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = fmt.Sprintf("/run/user/%d", uid)
+	}
+	return filepath.Join(runtimeDir, "podman", "podman.sock")
+}
+
+func (o *PodmanAPIClient) dial(ctx context.Context) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "unix", o.socketPath)
+}
+
+// getURL returns the URL of the path of the libpod API; the host is ignored, the connection being made to the socket
+func getURL(path string, query url.Values) string {
+	u := url.URL{
+		Scheme: "http",
+		Host:   "d",
+		Path:   "/" + apiVersion + "/libpod" + path,
+	}
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+// do sends a request to the libpod API, and returns the response if its status is successful.
+// body is sent as is if it is an io.Reader, or encoded in JSON otherwise.
+func (o *PodmanAPIClient) do(ctx context.Context, method string, path string, query url.Values, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	contentType := ""
+	switch body := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = body
+	default:
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	req, err := http.NewRequestWithContext(ctx, method, getURL(path, query), reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	klog.V(3).Infof("calling Podman API %s %s", method, req.URL.Path)
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		return nil, readAPIError(resp)
+	}
+	return resp, nil
+}
+
+// call sends a request to the libpod API and decodes the JSON response into result, if not nil
+func (o *PodmanAPIClient) call(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	resp, err := o.do(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if result == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// hijack sends a request to the libpod API and returns the connection, on which the data is streamed in both directions
+func (o *PodmanAPIClient) hijack(ctx context.Context, path string, body interface{}) (net.Conn, *bufio.Reader, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, getURL(path, nil), bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+
+	klog.V(3).Infof("calling Podman API %s %s", req.Method, req.URL.Path)
+	conn, err := o.dial(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = req.Write(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		defer conn.Close()
+		return nil, nil, readAPIError(resp)
+	}
+	return conn, reader, nil
+}
+
+func readAPIError(resp *http.Response) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var apiErr APIError
+	if err = json.Unmarshal(data, &apiErr); err != nil || apiErr.Message == "" {
+		return fmt.Errorf("unexpected status %s from the Podman API: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	return apiErr
+}
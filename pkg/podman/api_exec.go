@@ -0,0 +1,254 @@
+package podman
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moby/term"
+	"k8s.io/klog"
+)
+
+// Types of the frames of a multiplexed stream
+const (
+	streamStdin  = 0
+	streamStdout = 1
+	streamStderr = 2
+)
+
+// closeWriter is implemented by the connections which can be half-closed
+type closeWriter interface {
+	CloseWrite() error
+}
+
+// ExecCMDInContainer creates an exec session in the container, and streams its input and outputs through the hijacked connection
+func (o *PodmanAPIClient) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+	name := fmt.Sprintf("%s-%s", podName, containerName)
+
+	var session struct {
+		ID string `json:"Id"`
+	}
+	err := o.call(ctx, http.MethodPost, "/containers/"+url.PathEscape(name)+"/exec", nil, map[string]interface{}{
+		"AttachStdin":  true,
+		"AttachStdout": true,
+		"AttachStderr": true,
+		"Tty":          tty,
+		"Cmd":          cmd,
+	}, &session)
+	if err != nil {
+		return err
+	}
+
+	conn, reader, err := o.hijack(ctx, "/exec/"+session.ID+"/start", map[string]interface{}{
+		"Detach": false,
+		"Tty":    tty,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if tty {
+		// Forward the size of the local terminal, if any, to the terminal of the exec session
+		if outFd, isTerminal := term.GetFdInfo(stdout); isTerminal {
+			go o.forwardTerminalSize(ctx, session.ID, outFd, done)
+		}
+	}
+
+	go func() {
+		if stdin != nil {
+			_, _ = io.Copy(conn, stdin)
+		}
+		// Closing the write side of the connection closes the stdin of the command
+		if cw, ok := conn.(closeWriter); ok {
+			_ = cw.CloseWrite()
+		}
+	}()
+
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	if stderr == nil {
+		stderr = io.Discard
+	}
+	if tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		err = demuxStream(reader, stdout, stderr)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	exitCode, err := o.getExecExitCode(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("command terminated with exit code %d", exitCode)
+	}
+	return nil
+}
+
+// forwardTerminalSize resizes the terminal of the exec session to the size of the local terminal,
+// then each time the local terminal is resized, until done is closed
+func (o *PodmanAPIClient) forwardTerminalSize(ctx context.Context, id string, fd uintptr, done <-chan struct{}) {
+	resized := notifyTerminalResize(done)
+	var last *term.Winsize
+	for {
+		size, err := term.GetWinsize(fd)
+		if err == nil && (last == nil || *size != *last) {
+			last = size
+			if err = o.resizeExec(ctx, id, size.Height, size.Width); err != nil {
+				klog.V(4).Infof("unable to resize the terminal of the exec session: %s", err)
+			}
+		}
+		select {
+		case <-done:
+			return
+		case <-resized:
+		}
+	}
+}
+
+// resizeExec sets the size of the terminal of the exec session
+func (o *PodmanAPIClient) resizeExec(ctx context.Context, id string, height, width uint16) error {
+	query := url.Values{
+		"h": []string{strconv.Itoa(int(height))},
+		"w": []string{strconv.Itoa(int(width))},
+	}
+	return o.call(ctx, http.MethodPost, "/exec/"+id+"/resize", query, nil, nil)
+}
+
+// getExecExitCode returns the exit code of the command of the exec session, waiting for the command to be terminated
+func (o *PodmanAPIClient) getExecExitCode(ctx context.Context, id string) (int, error) {
+	for i := 0; ; i++ {
+		var inspect struct {
+			Running  bool
+			ExitCode int
+		}
+		err := o.call(ctx, http.MethodGet, "/exec/"+id+"/json", nil, nil, &inspect)
+		if err != nil {
+			return 0, err
+		}
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+		if i == 50 {
+			return 0, errors.New("the command is still running after its outputs were closed")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// GetPodLogs returns the logs of the specified pod container.
+// All logs for all containers part of the pod are returned if an empty string is provided as container name.
+func (o *PodmanAPIClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+	containers := []string{podName + "-" + containerName}
+	if containerName == "" {
+		var err error
+		containers, err = o.getPodContainers(podName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := url.Values{
+		"follow": []string{strconv.FormatBool(followLog)},
+		"stdout": []string{"true"},
+		"stderr": []string{"true"},
+	}
+	// The logs of all the containers are written to the same pipe, closed when all the streams are terminated
+	out, writer := io.Pipe()
+	var wg sync.WaitGroup
+	for _, container := range containers {
+		resp, err := o.do(context.Background(), http.MethodGet, "/containers/"+url.PathEscape(container)+"/logs", query, nil)
+		if err != nil {
+			_ = writer.CloseWithError(err)
+			return nil, err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer resp.Body.Close()
+			// As for podman logs, the logs are written to stdout && stderr (when kubectl outputs all logs on stdout)
+			if err := demuxStream(resp.Body, writer, writer); err != nil {
+				klog.V(4).Infof("error getting logs from the Podman API: %s", err)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		_ = writer.Close()
+	}()
+	return out, nil
+}
+
+// getPodContainers returns the names of the user containers of the pod, prefixed with the pod name by Podman
+func (o *PodmanAPIClient) getPodContainers(podName string) ([]string, error) {
+	var list []ListPodsReport
+	err := o.call(context.Background(), http.MethodGet, "/pods/json", getFilters(map[string][]string{"name": {podName}}), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	var containers []string
+	for _, pod := range list {
+		if pod.Name != podName {
+			continue
+		}
+		for _, container := range pod.Containers {
+			if strings.HasPrefix(container.Names, podName+"-") {
+				containers = append(containers, container.Names)
+			}
+		}
+	}
+	return containers, nil
+}
+
+// demuxStream copies the frames of a multiplexed stream to stdout or stderr, until the end of the stream.
+// Each frame starts with a header of 8 bytes: the type of stream, 3 empty bytes, and the size of the frame (big endian uint32).
+func demuxStream(r io.Reader, stdout, stderr io.Writer) error {
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		var w io.Writer
+		switch header[0] {
+		case streamStdin, streamStdout:
+			w = stdout
+		case streamStderr:
+			w = stderr
+		default:
+			return fmt.Errorf("unexpected stream type %d", header[0])
+		}
+		size := int64(binary.BigEndian.Uint32(header[4:]))
+		if _, err := io.CopyN(w, r, size); err != nil {
+			return err
+		}
+	}
+}
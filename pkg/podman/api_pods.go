@@ -0,0 +1,257 @@
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
+	"k8s.io/kubectl/pkg/scheme"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func getYAMLSerializer() *jsonserializer.Serializer {
+	return jsonserializer.NewSerializerWithOptions(
+		jsonserializer.SimpleMetaFactory{},
+		scheme.Scheme,
+		scheme.Scheme,
+		jsonserializer.SerializerOptions{
+			Yaml: true,
+		},
+	)
+}
+
+// getFilters returns the query parameter filtering the results of the list operations
+func getFilters(filters map[string][]string) url.Values {
+	data, _ := json.Marshal(filters)
+	return url.Values{"filters": []string{string(data)}}
+}
+
+func (o *PodmanAPIClient) PlayKube(pod *corev1.Pod) error {
+	return o.PlayKubeOnNetwork(pod, "", nil)
+}
+
+func (o *PodmanAPIClient) PlayKubeOnNetwork(pod *corev1.Pod, network string, aliases []string) error {
+	var sb strings.Builder
+	err := getYAMLSerializer().Encode(pod, &sb)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Pod spec to play: \n---\n%s\n---\n", sb.String())
+
+	var report struct {
+		Pods []struct {
+			ID              string
+			ContainerErrors []string `json:"ContainerErrors,omitempty"`
+		}
+	}
+	var query url.Values
+	if network != "" {
+		query = url.Values{"network": []string{getNetworkSpec(network, aliases)}}
+	}
+	err = o.call(context.Background(), http.MethodPost, "/play/kube", query, strings.NewReader(sb.String()), &report)
+	if err != nil {
+		return err
+	}
+	for _, p := range report.Pods {
+		if len(p.ContainerErrors) > 0 {
+			return fmt.Errorf("errors creating the containers of the pod: %s", strings.Join(p.ContainerErrors, "; "))
+		}
+	}
+	return nil
+}
+
+func (o *PodmanAPIClient) KubeGenerate(name string) (*corev1.Pod, error) {
+	resp, err := o.do(context.Background(), http.MethodGet, "/generate/kube", url.Values{"names": []string{name}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var pod corev1.Pod
+	_, _, err = getYAMLSerializer().Decode(data, nil, &pod)
+	if err != nil {
+		return nil, err
+	}
+	return &pod, nil
+}
+
+func (o *PodmanAPIClient) PodStop(podname string) error {
+	return o.call(context.Background(), http.MethodPost, "/pods/"+url.PathEscape(podname)+"/stop", nil, nil, nil)
+}
+
+func (o *PodmanAPIClient) PodRm(podname string) error {
+	return o.call(context.Background(), http.MethodDelete, "/pods/"+url.PathEscape(podname), nil, nil, nil)
+}
+
+func (o *PodmanAPIClient) PodLs() (map[string]bool, error) {
+	var list []ListPodsReport
+	err := o.call(context.Background(), http.MethodGet, "/pods/json", nil, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, pod := range list {
+		result[pod.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPIClient) PodInspect(podname string) (PodInspectData, error) {
+	var result PodInspectData
+	err := o.call(context.Background(), http.MethodGet, "/pods/"+url.PathEscape(podname)+"/json", nil, nil, &result)
+	return result, err
+}
+
+func (o *PodmanAPIClient) VolumeLs() (map[string]bool, error) {
+	var list []struct {
+		Name string
+	}
+	err := o.call(context.Background(), http.MethodGet, "/volumes/json", nil, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, volume := range list {
+		result[volume.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPIClient) VolumeRm(volumeName string) error {
+	return o.call(context.Background(), http.MethodDelete, "/volumes/"+url.PathEscape(volumeName), nil, nil, nil)
+}
+
+func (o *PodmanAPIClient) NetworkCreate(name string) error {
+	err := o.call(context.Background(), http.MethodGet, "/networks/"+url.PathEscape(name)+"/exists", nil, nil, nil)
+	if err == nil {
+		return nil
+	}
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Response != http.StatusNotFound {
+		return err
+	}
+	return o.call(context.Background(), http.MethodPost, "/networks/create", nil, map[string]string{"name": name}, nil)
+}
+
+func (o *PodmanAPIClient) NetworkRm(name string) error {
+	return o.call(context.Background(), http.MethodDelete, "/networks/"+url.PathEscape(name), nil, nil, nil)
+}
+
+func (o *PodmanAPIClient) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	return cleanupPodResources(o, pod, cleanupVolumes)
+}
+
+func (o *PodmanAPIClient) ListAllComponents() ([]api.ComponentAbstract, error) {
+	var list []ListPodsReport
+	err := o.call(context.Background(), http.MethodGet, "/pods/json", getFilters(map[string][]string{"status": {"running"}}), nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	return getComponentsFromPods(list), nil
+}
+
+func (o *PodmanAPIClient) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	return getPodsMatchingSelector(o, selector)
+}
+
+func (o *PodmanAPIClient) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	return getAllResourcesFromSelector(o, selector)
+}
+
+func (o *PodmanAPIClient) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	// In podman, we return the pods, as there is no resource containing PodSpec
+	return o.GetPodsMatchingSelector(selector)
+}
+
+func (o *PodmanAPIClient) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	return getRunningPodFromSelector(o, selector)
+}
+
+func (o *PodmanAPIClient) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
+	podSelector := fmt.Sprintf("component=%s", componentName)
+	return o.GetRunningPodFromSelector(podSelector)
+}
+
+func (o *PodmanAPIClient) getPodsFromSelector(selector string) ([]ListPodsReport, error) {
+	var query url.Values
+	if selector != "" {
+		query = getFilters(map[string][]string{"label": strings.Split(selector, ",")})
+	}
+	var list []ListPodsReport
+	err := o.call(context.Background(), http.MethodGet, "/pods/json", query, nil, &list)
+	return list, err
+}
+
+func (o *PodmanAPIClient) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	return NewPodWatcher(func() ([]string, error) {
+		var list []struct {
+			ID string `json:"Id"`
+		}
+		err := o.call(ctx, http.MethodGet, "/containers/json", nil, nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		containers := make([]string, 0, len(list))
+		for _, container := range list {
+			containers = append(containers, container.ID)
+		}
+		return containers, nil
+	}), nil
+}
+
+func (o *PodmanAPIClient) Version(ctx context.Context) (SystemVersionReport, error) {
+	// As for the podman command, Version is expected to return in a timely manner, as it is used when injecting the dependencies
+	ctx, cancel := context.WithTimeout(ctx, o.initTimeout)
+	defer cancel()
+	var version struct {
+		Version    string
+		APIVersion string `json:"ApiVersion"`
+		GitCommit  string
+		GoVersion  string
+		Os         string
+		Arch       string
+		BuildTime  string
+	}
+	err := o.call(ctx, http.MethodGet, "/version", nil, nil, &version)
+	if err != nil {
+		return SystemVersionReport{}, err
+	}
+	return SystemVersionReport{
+		Client: &Version{
+			APIVersion: version.APIVersion,
+			Version:    version.Version,
+			GoVersion:  version.GoVersion,
+			GitCommit:  version.GitCommit,
+			BuiltTime:  version.BuildTime,
+			OsArch:     version.Os + "/" + version.Arch,
+			Os:         version.Os,
+		},
+	}, nil
+}
+
+func (o *PodmanAPIClient) GetCapabilities() (Capabilities, error) {
+	var result Capabilities
+	var info podmanInfo
+	err := o.call(context.Background(), http.MethodGet, "/info", nil, nil, &info)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	if info.Host != nil && info.Host.CgroupsVersion == "v2" {
+		result.Cgroupv2 = true
+	}
+	return result, nil
+}
@@ -0,0 +1,248 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+)
+
+// newFakeAPIClient starts a fake libpod API on a unix socket, answering the requests on the paths of mux, and returns a client of this API
+func newFakeAPIClient(t *testing.T, mux *http.ServeMux) *PodmanAPIClient {
+	mux.HandleFunc("/v4.0.0/libpod/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Version":"4.5.0","ApiVersion":"1.41","GoVersion":"go1.20.2","Os":"linux","Arch":"amd64"}`))
+	})
+	socketPath := filepath.Join(t.TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(mux)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{PodmanCmdInitTimeout: 5 * time.Second})
+	client, err := NewPodmanAPIClient(ctx, socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// frame returns a frame of a multiplexed stream
+func frame(stream byte, data string) []byte {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(data)))
+	return append(header, []byte(data)...)
+}
+
+func TestNewPodmanAPIClient(t *testing.T) {
+	client := newFakeAPIClient(t, http.NewServeMux())
+	version, err := client.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.Client == nil || version.Client.Version != "4.5.0" || version.Client.OsArch != "linux/amd64" {
+		t.Errorf("unexpected version %v", version.Client)
+	}
+
+	ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{PodmanCmdInitTimeout: time.Second})
+	if _, err = NewPodmanAPIClient(ctx, filepath.Join(t.TempDir(), "not-found.sock")); err == nil {
+		t.Error("an error is expected when the socket does not exist")
+	}
+}
+
+func TestGetSocketPath(t *testing.T) {
+	for _, tt := range []struct {
+		containerHost string
+		want          string
+	}{
+		{containerHost: "unix:///run/user/1000/podman/podman.sock", want: "/run/user/1000/podman/podman.sock"},
+		{containerHost: "ssh://core@localhost:2222/run/podman/podman.sock", want: ""},
+	} {
+		containerHost := tt.containerHost
+		ctx := envcontext.WithEnvConfig(context.Background(), config.Configuration{ContainerHost: &containerHost})
+		if got := getSocketPath(ctx); got != tt.want {
+			t.Errorf("getSocketPath() with CONTAINER_HOST=%s = %q, want %q", tt.containerHost, got, tt.want)
+		}
+	}
+}
+
+func TestPodmanAPIClient_PodLs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/pods/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"Name":"name1"},{"Name":"name2"}]`))
+	})
+	got, err := newFakeAPIClient(t, mux).PodLs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"name1": true, "name2": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PodmanAPIClient.PodLs() = %v, want %v", got, want)
+	}
+}
+
+func TestPodmanAPIClient_KubeGenerate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/generate/kube", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("names") != "my-pod" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"cause":"no such pod","message":"pod-not-found: no such pod","response":404}`))
+			return
+		}
+		_, _ = w.Write([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: my-pod\n"))
+	})
+	client := newFakeAPIClient(t, mux)
+
+	pod, err := client.KubeGenerate("my-pod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pod.GetName() != "my-pod" {
+		t.Errorf("pod name should be %q but is %q", "my-pod", pod.GetName())
+	}
+
+	_, err = client.KubeGenerate("pod-not-found")
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Response != http.StatusNotFound || apiErr.Error() != "pod-not-found: no such pod" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestPodmanAPIClient_ExecCMDInContainer(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		exitCode   int
+		wantErr    bool
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "command succeeds",
+			wantStdout: "received: input",
+			wantStderr: "warning",
+		},
+		{
+			name:       "command fails",
+			exitCode:   3,
+			wantErr:    true,
+			wantStdout: "received: input",
+			wantStderr: "warning",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v4.0.0/libpod/containers/my-pod-runtime/exec", func(w http.ResponseWriter, r *http.Request) {
+				var config struct {
+					Cmd []string
+				}
+				if err := json.NewDecoder(r.Body).Decode(&config); err != nil || !reflect.DeepEqual(config.Cmd, []string{"cat"}) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"Id":"session-id"}`))
+			})
+			mux.HandleFunc("/v4.0.0/libpod/exec/session-id/start", func(w http.ResponseWriter, r *http.Request) {
+				// The body of the request must be consumed before the connection is hijacked
+				_, _ = io.ReadAll(r.Body)
+				conn, buf, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer conn.Close()
+				_, _ = buf.WriteString("HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.multiplexed-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+				_ = buf.Flush()
+				// The stdin of the command is read until the client closes it
+				input, _ := io.ReadAll(buf)
+				_, _ = conn.Write(frame(streamStdout, "received: "+string(input)))
+				_, _ = conn.Write(frame(streamStderr, "warning"))
+			})
+			mux.HandleFunc("/v4.0.0/libpod/exec/session-id/json", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"Running":false,"ExitCode":%d}`, tt.exitCode)
+			})
+
+			var stdout, stderr bytes.Buffer
+			err := newFakeAPIClient(t, mux).ExecCMDInContainer(context.Background(), "runtime", "my-pod", []string{"cat"}, &stdout, &stderr, bytes.NewBufferString("input"), false)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PodmanAPIClient.ExecCMDInContainer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if stderr.String() != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestPodmanAPIClient_resizeExec(t *testing.T) {
+	var got url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/exec/session-id/resize", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		got = r.URL.Query()
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := newFakeAPIClient(t, mux).resizeExec(context.Background(), "session-id", 40, 120)
+	if err != nil {
+		t.Fatalf("PodmanAPIClient.resizeExec() error = %v", err)
+	}
+	want := url.Values{"h": []string{"40"}, "w": []string{"120"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("query = %v, want %v", got, want)
+	}
+}
+
+func TestPodmanAPIClient_GetPodLogs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/pods/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"Name":"my-pod","Containers":[{"Names":"1234-infra"},{"Names":"my-pod-runtime"}]}]`))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/containers/my-pod-runtime/logs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("follow") != "false" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write(frame(streamStdout, "line 1\n"))
+		_, _ = w.Write(frame(streamStderr, "line 2\n"))
+	})
+	client := newFakeAPIClient(t, mux)
+
+	for _, containerName := range []string{"runtime", ""} {
+		logs, err := client.GetPodLogs("my-pod", containerName, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(logs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "line 1\nline 2\n" {
+			t.Errorf("logs of container %q = %q", containerName, string(got))
+		}
+	}
+}
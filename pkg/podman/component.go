@@ -40,7 +40,11 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 	if err = json.Unmarshal(out, &list); err != nil {
 		return nil, err
 	}
+	return getComponentsFromPods(list), nil
+}
 
+// getComponentsFromPods returns the components running in the pods
+func getComponentsFromPods(list []ListPodsReport) []api.ComponentAbstract {
 	for _, pod := range list {
 		klog.V(5).Infof("\npod name: %s", pod.Name)
 		klog.V(5).Infof("labels:")
@@ -82,15 +86,36 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			RunningOn: commonflags.PlatformPodman,
 			Platform:  commonflags.PlatformPodman,
 		}
-		mode := odolabels.GetMode(labels)
+		components = AppendComponent(components, component, odolabels.GetMode(labels))
+	}
+
+	return components
+}
+
+// AppendComponent appends the component running in mode to the list of components,
+// or adds the mode to the running modes of the component with the same name already in the list,
+// as a component can run in different pods (in Dev and Deploy modes)
+func AppendComponent(components []api.ComponentAbstract, component api.ComponentAbstract, mode string) []api.ComponentAbstract {
+	for i := range components {
+		if components[i].Name != component.Name {
+			continue
+		}
 		if mode != "" {
-			component.RunningIn = api.NewRunningModes()
-			component.RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
+			if components[i].RunningIn == nil {
+				components[i].RunningIn = api.NewRunningModes()
+			}
+			components[i].RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
+		}
+		if components[i].Type == api.TypeUnknown && component.Type != api.TypeUnknown {
+			components[i].Type = component.Type
 		}
-		components = append(components, component)
+		return components
 	}
-
-	return components, nil
+	if mode != "" {
+		component.RunningIn = api.NewRunningModes()
+		component.RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
+	}
+	return append(components, component)
 }
 
 func (o *PodmanCli) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
@@ -15,6 +15,10 @@ type Client interface {
 	// PlayKube creates the Pod with Podman
 	PlayKube(pod *corev1.Pod) error
 
+	// PlayKubeOnNetwork creates the Pod with Podman, connected to the network,
+	// and reachable from the other pods connected to this network with the given DNS aliases
+	PlayKubeOnNetwork(pod *corev1.Pod, network string, aliases []string) error
+
 	// KubeGenerate returns a Kubernetes Pod definition of an existing Pod
 	KubeGenerate(name string) (*corev1.Pod, error)
 
@@ -33,6 +37,12 @@ type Client interface {
 	// VolumeRm deletes the volume with given volumeName
 	VolumeRm(volumeName string) error
 
+	// NetworkCreate creates the network with given name, if it does not exist yet
+	NetworkCreate(name string) error
+
+	// NetworkRm deletes the network with given name
+	NetworkRm(name string) error
+
 	// CleanupPodResources stops and removes a pod and its associated resources (volumes)
 	CleanupPodResources(pod *corev1.Pod, cleanVolumes bool) error
 
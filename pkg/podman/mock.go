@@ -202,6 +202,34 @@ func (mr *MockClientMockRecorder) ListAllComponents() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllComponents", reflect.TypeOf((*MockClient)(nil).ListAllComponents))
 }
 
+// NetworkCreate mocks base method.
+func (m *MockClient) NetworkCreate(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkCreate", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NetworkCreate indicates an expected call of NetworkCreate.
+func (mr *MockClientMockRecorder) NetworkCreate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkCreate", reflect.TypeOf((*MockClient)(nil).NetworkCreate), name)
+}
+
+// NetworkRm mocks base method.
+func (m *MockClient) NetworkRm(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkRm", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NetworkRm indicates an expected call of NetworkRm.
+func (mr *MockClientMockRecorder) NetworkRm(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkRm", reflect.TypeOf((*MockClient)(nil).NetworkRm), name)
+}
+
 // PlayKube mocks base method.
 func (m *MockClient) PlayKube(pod *v1.Pod) error {
 	m.ctrl.T.Helper()
@@ -216,6 +244,20 @@ func (mr *MockClientMockRecorder) PlayKube(pod interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod)
 }
 
+// PlayKubeOnNetwork mocks base method.
+func (m *MockClient) PlayKubeOnNetwork(pod *v1.Pod, network string, aliases []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayKubeOnNetwork", pod, network, aliases)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlayKubeOnNetwork indicates an expected call of PlayKubeOnNetwork.
+func (mr *MockClientMockRecorder) PlayKubeOnNetwork(pod, network, aliases interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKubeOnNetwork", reflect.TypeOf((*MockClient)(nil).PlayKubeOnNetwork), pod, network, aliases)
+}
+
 // PodLs mocks base method.
 func (m *MockClient) PodLs() (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -48,6 +48,10 @@ func NewPodmanCli(ctx context.Context) (*PodmanCli, error) {
 }
 
 func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
+	return o.PlayKubeOnNetwork(pod, "", nil)
+}
+
+func (o *PodmanCli) PlayKubeOnNetwork(pod *corev1.Pod, network string, aliases []string) error {
 	serializer := jsonserializer.NewSerializerWithOptions(
 		jsonserializer.SimpleMetaFactory{},
 		scheme.Scheme,
@@ -57,10 +61,13 @@ func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
 		},
 	)
 
-	// +3 because of "play kube -"
-	args := make([]string, 0, len(o.containerRunGlobalExtraArgs)+len(o.containerRunExtraArgs)+3)
+	// +4 because of "play kube --network -"
+	args := make([]string, 0, len(o.containerRunGlobalExtraArgs)+len(o.containerRunExtraArgs)+4)
 	args = append(args, o.containerRunGlobalExtraArgs...)
 	args = append(args, "play", "kube")
+	if network != "" {
+		args = append(args, "--network="+getNetworkSpec(network, aliases))
+	}
 	args = append(args, o.containerRunExtraArgs...)
 	args = append(args, "-")
 
@@ -114,6 +121,18 @@ func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
 	return nil
 }
 
+// getNetworkSpec returns the value of the network option of podman play kube, connecting the pod to the network with the DNS aliases
+func getNetworkSpec(network string, aliases []string) string {
+	if len(aliases) == 0 {
+		return network
+	}
+	options := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		options = append(options, "alias="+alias)
+	}
+	return network + ":" + strings.Join(options, ",")
+}
+
 func (o *PodmanCli) KubeGenerate(name string) (*corev1.Pod, error) {
 	serializer := jsonserializer.NewSerializerWithOptions(
 		jsonserializer.SimpleMetaFactory{},
@@ -182,6 +201,39 @@ func (o *PodmanCli) PodLs() (map[string]bool, error) {
 	return SplitLinesAsSet(string(out)), nil
 }
 
+func (o *PodmanCli) NetworkCreate(name string) error {
+	cmd := exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, "network", "exists", name)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	if err := cmd.Run(); err == nil {
+		return nil
+	}
+	cmd = exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, "network", "create", name)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return err
+	}
+	klog.V(4).Infof("Created network %s", string(out))
+	return nil
+}
+
+func (o *PodmanCli) NetworkRm(name string) error {
+	cmd := exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, "network", "rm", name)...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return err
+	}
+	klog.V(4).Infof("Deleted network %s", string(out))
+	return nil
+}
+
 func (o *PodmanCli) VolumeRm(volumeName string) error {
 	cmd := exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, "volume", "rm", volumeName)...)
 	klog.V(3).Infof("executing %v", cmd.Args)
@@ -210,6 +262,11 @@ func (o *PodmanCli) VolumeLs() (map[string]bool, error) {
 }
 
 func (o *PodmanCli) CleanupPodResources(pod *corev1.Pod, cleanupVolumes bool) error {
+	return cleanupPodResources(o, pod, cleanupVolumes)
+}
+
+// cleanupPodResources stops and removes a pod and its associated resources (volumes), with the Podman command or the libpod API
+func cleanupPodResources(o Client, pod *corev1.Pod, cleanupVolumes bool) error {
 	err := o.PodStop(pod.GetName())
 	if err != nil {
 		return err
@@ -18,8 +18,19 @@ import (
 	"github.com/redhat-developer/odo/pkg/platform"
 )
 
+// podsReader is implemented by the clients reading the pods with the podman command or with the libpod API
+type podsReader interface {
+	getPodsFromSelector(selector string) ([]ListPodsReport, error)
+	KubeGenerate(name string) (*corev1.Pod, error)
+	PodInspect(podname string) (PodInspectData, error)
+}
+
 // GetPodsMatchingSelector returns all pods matching the given label selector.
 func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	return getPodsMatchingSelector(o, selector)
+}
+
+func getPodsMatchingSelector(o podsReader, selector string) (*corev1.PodList, error) {
 	podsReport, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -53,6 +64,10 @@ func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, e
 
 // GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
 func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	return getAllResourcesFromSelector(o, selector)
+}
+
+func getAllResourcesFromSelector(o podsReader, selector string) ([]unstructured.Unstructured, error) {
 	list, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -85,6 +100,10 @@ func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns st
 // GetRunningPodFromSelector returns any pod matching the given label selector.
 // If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
 func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	return getRunningPodFromSelector(o, selector)
+}
+
+func getRunningPodFromSelector(o podsReader, selector string) (*corev1.Pod, error) {
 	list, err := o.getPodsFromSelector(selector)
 	if err != nil {
 		return nil, err
@@ -147,38 +166,55 @@ type podWatcher struct {
 	stop   chan struct{}
 	pods   map[string]struct{}
 	events chan watch.Event
+	// listContainers returns the IDs of the running containers
+	listContainers func() ([]string, error)
 }
 
 func (o *PodmanCli) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	args := []string{"ps", "--quiet"}
+	args = append(o.containerRunGlobalExtraArgs, args...)
+	return NewPodWatcher(func() ([]string, error) {
+		cmd := exec.Command(o.podmanCmd, args...)
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, err
+		}
+		var containers []string
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			containers = append(containers, scanner.Text())
+		}
+		return containers, nil
+	}), nil
+}
 
+// NewPodWatcher returns a watcher sending an event when a container is added or deleted,
+// by listing the IDs of the running containers with listContainers every few seconds
+func NewPodWatcher(listContainers func() ([]string, error)) watch.Interface {
 	watcher := podWatcher{
-		stop:   make(chan struct{}),
-		pods:   make(map[string]struct{}),
-		events: make(chan watch.Event),
+		stop:           make(chan struct{}),
+		pods:           make(map[string]struct{}),
+		events:         make(chan watch.Event),
+		listContainers: listContainers,
 	}
-	go watcher.watch(o.podmanCmd, o.containerRunGlobalExtraArgs)
-	return watcher, nil
+	go watcher.watch()
+	return watcher
 }
 
-func (o podWatcher) watch(podmanCmd string, containerRunGlobalExtraArgs []string) {
-	args := []string{"ps", "--quiet"}
-	args = append(containerRunGlobalExtraArgs, args...)
+func (o podWatcher) watch() {
 	ticker := time.NewTicker(3 * time.Second)
 	for {
 		select {
 		case <-o.stop:
 			return
 		case <-ticker.C:
-			cmd := exec.Command(podmanCmd, args...)
-			out, err := cmd.Output()
+			containers, err := o.listContainers()
 			if err != nil {
-				klog.V(4).Infof("error getting containers from podman: %s", err)
+				klog.V(4).Infof("error getting containers: %s", err)
 				continue
 			}
-			scanner := bufio.NewScanner(bytes.NewReader(out))
 			currentPods := make(map[string]struct{})
-			for scanner.Scan() {
-				podName := scanner.Text()
+			for _, podName := range containers {
 				currentPods[podName] = struct{}{}
 				if _, ok := o.pods[podName]; !ok {
 					o.events <- watch.Event{
@@ -0,0 +1,31 @@
+package podman
+
+import (
+	"time"
+)
+
+// terminalSizePollInterval is the interval at which the size of the local terminal is checked during an interactive exec
+const terminalSizePollInterval = 250 * time.Millisecond
+
+// notifyTerminalResize returns a channel receiving a value at regular intervals, until done is closed.
+// The resize events of the console are not notified by a signal on Windows, the size of the terminal is polled instead.
+func notifyTerminalResize(done <-chan struct{}) <-chan struct{} {
+	resized := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(terminalSizePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				select {
+				case resized <- struct{}{}:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return resized
+}
@@ -0,0 +1,266 @@
+package port
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/segmentio/backo-go"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+const (
+	// ReadinessPathAttribute is the endpoint attribute defining the path of the HTTP request checking the readiness of the endpoint.
+	// Without this attribute, the readiness is checked by opening a TCP connection.
+	ReadinessPathAttribute = "dev.odo.readiness.path"
+	// ReadinessStatusAttribute is the endpoint attribute defining the status code expected in the response of the HTTP readiness check.
+	// By default, any status code between 200 and 399 is expected.
+	ReadinessStatusAttribute = "dev.odo.readiness.status"
+	// ReadinessTimeoutAttribute is the endpoint attribute defining the timeout of a readiness check, as a duration (for example "3s").
+	ReadinessTimeoutAttribute = "dev.odo.readiness.timeout"
+
+	// DefaultReadinessTimeout is the default timeout of a readiness check
+	DefaultReadinessTimeout = 1 * time.Second
+
+	// tcpCloseDelay is the delay during which a connection closed by the remote side makes a TCP readiness check fail.
+	// The local end of a port forwarding accepts connections even when the application is not listening,
+	// and closes them right after failing to connect to the application.
+	tcpCloseDelay = 200 * time.Millisecond
+)
+
+// ProbeType is the type of check made by a ReadinessProbe
+type ProbeType string
+
+const (
+	// ProbeHTTP checks the readiness by sending an HTTP GET request and checking the status code of the response
+	ProbeHTTP ProbeType = "http"
+	// ProbeTCP checks the readiness by opening a TCP connection
+	ProbeTCP ProbeType = "tcp"
+)
+
+// ReadinessProbe checks that the application responds on a forwarded endpoint
+type ReadinessProbe struct {
+	// Name of the endpoint
+	Name string
+	Type ProbeType
+	// Address is the local address, as host:port, on which the endpoint is forwarded
+	Address string
+	// Scheme is the scheme of the HTTP request, either http or https
+	Scheme string
+	// Path of the HTTP request
+	Path string
+	// ExpectedStatus is the status code expected in the HTTP response, or 0 for any status code between 200 and 399
+	ExpectedStatus int
+	Timeout        time.Duration
+}
+
+func (o ReadinessProbe) String() string {
+	if o.Type == ProbeHTTP {
+		return fmt.Sprintf("endpoint %q (%s)", o.Name, o.url())
+	}
+	return fmt.Sprintf("endpoint %q (tcp://%s)", o.Name, o.Address)
+}
+
+func (o ReadinessProbe) url() string {
+	return fmt.Sprintf("%s://%s/%s", o.Scheme, o.Address, strings.TrimPrefix(o.Path, "/"))
+}
+
+// GetReadinessProbes returns the probes checking the readiness of the forwarded ports, from the attributes of the matching endpoints
+// in containerEndpointMapping. The debug and UDP endpoints are not checked.
+func GetReadinessProbes(containerEndpointMapping map[string][]v1alpha2.Endpoint, fwPorts []api.ForwardedPort) ([]ReadinessProbe, error) {
+	var probes []ReadinessProbe
+	for _, fwPort := range fwPorts {
+		if fwPort.IsDebug {
+			continue
+		}
+		ep, found := findEndpoint(containerEndpointMapping[fwPort.ContainerName], fwPort.ContainerPort)
+		if !found || ep.Protocol == v1alpha2.UDPEndpointProtocol {
+			continue
+		}
+		probe, err := newReadinessProbe(ep, fwPort)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %q: %w", ep.Name, err)
+		}
+		probes = append(probes, probe)
+	}
+	sort.Slice(probes, func(i, j int) bool {
+		return probes[i].Address < probes[j].Address
+	})
+	return probes, nil
+}
+
+func findEndpoint(endpoints []v1alpha2.Endpoint, targetPort int) (v1alpha2.Endpoint, bool) {
+	for _, ep := range endpoints {
+		if ep.TargetPort == targetPort {
+			return ep, true
+		}
+	}
+	return v1alpha2.Endpoint{}, false
+}
+
+func newReadinessProbe(ep v1alpha2.Endpoint, fwPort api.ForwardedPort) (ReadinessProbe, error) {
+	address := fwPort.LocalAddress
+	if address == "" || address == "0.0.0.0" {
+		address = "127.0.0.1"
+	}
+	probe := ReadinessProbe{
+		Name:    ep.Name,
+		Type:    ProbeTCP,
+		Address: net.JoinHostPort(address, strconv.Itoa(fwPort.LocalPort)),
+		Timeout: DefaultReadinessTimeout,
+	}
+
+	var err error
+	if ep.Attributes.Exists(ReadinessTimeoutAttribute) {
+		timeout := ep.Attributes.GetString(ReadinessTimeoutAttribute, &err)
+		if err != nil {
+			return ReadinessProbe{}, fmt.Errorf("attribute %q must be a duration: %w", ReadinessTimeoutAttribute, err)
+		}
+		probe.Timeout, err = time.ParseDuration(timeout)
+		if err != nil || probe.Timeout <= 0 {
+			return ReadinessProbe{}, fmt.Errorf("attribute %q must be a positive duration, such as \"3s\"", ReadinessTimeoutAttribute)
+		}
+	}
+
+	if !ep.Attributes.Exists(ReadinessPathAttribute) {
+		if ep.Attributes.Exists(ReadinessStatusAttribute) {
+			return ReadinessProbe{}, fmt.Errorf("attribute %q requires the attribute %q", ReadinessStatusAttribute, ReadinessPathAttribute)
+		}
+		return probe, nil
+	}
+
+	probe.Type = ProbeHTTP
+	probe.Path = ep.Attributes.GetString(ReadinessPathAttribute, &err)
+	if err != nil {
+		return ReadinessProbe{}, fmt.Errorf("attribute %q must be a string: %w", ReadinessPathAttribute, err)
+	}
+	probe.Scheme = "http"
+	if ep.Protocol == v1alpha2.HTTPSEndpointProtocol || ep.Protocol == v1alpha2.WSSEndpointProtocol || ep.Secure != nil && *ep.Secure {
+		probe.Scheme = "https"
+	}
+	if ep.Attributes.Exists(ReadinessStatusAttribute) {
+		status := ep.Attributes.GetNumber(ReadinessStatusAttribute, &err)
+		if err != nil || status < 100 || status > 599 || status != float64(int(status)) {
+			return ReadinessProbe{}, fmt.Errorf("attribute %q must be an HTTP status code", ReadinessStatusAttribute)
+		}
+		probe.ExpectedStatus = int(status)
+	}
+	return probe, nil
+}
+
+// Check checks once that the application responds on the endpoint
+func (o ReadinessProbe) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+	switch o.Type {
+	case ProbeHTTP:
+		return o.checkHTTP(ctx)
+	default:
+		return o.checkTCP(ctx)
+	}
+}
+
+func (o ReadinessProbe) checkHTTP(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.url(), nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			// The certificates used during development are generally self-signed
+			//nolint:gosec
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if o.ExpectedStatus != 0 {
+		if resp.StatusCode != o.ExpectedStatus {
+			return fmt.Errorf("status code %d, expected %d", resp.StatusCode, o.ExpectedStatus)
+		}
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (o ReadinessProbe) checkTCP(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", o.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// A connection accepted by a port forwarding is closed right away when the application does not accept it
+	err = conn.SetReadDeadline(time.Now().Add(tcpCloseDelay))
+	if err != nil {
+		return err
+	}
+	_, err = conn.Read(make([]byte, 1))
+	var netErr net.Error
+	if err == nil || errors.As(err, &netErr) && netErr.Timeout() {
+		// The application sent some data, or keeps the connection open
+		return nil
+	}
+	if errors.Is(err, io.EOF) {
+		return errors.New("connection closed")
+	}
+	return err
+}
+
+// WaitForReadiness checks the probes periodically, until all of them succeed or the timeout has elapsed.
+// The returned error lists the endpoints not ready when the timeout has elapsed.
+func WaitForReadiness(ctx context.Context, probes []ReadinessProbe, timeout time.Duration) error {
+	if len(probes) == 0 {
+		return nil
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	b := backo.NewBacko(500*time.Millisecond, 2, 0, 5*time.Second)
+	for attempt := 0; ; attempt++ {
+		err := CheckReadiness(ctxWithTimeout, probes)
+		if err == nil {
+			return nil
+		}
+		klog.V(3).Infof("application not ready: %v", err)
+		select {
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("timeout while waiting for the application to respond: %w", err)
+		case <-time.After(b.Duration(attempt)):
+		}
+	}
+}
+
+// CheckReadiness checks once all the probes, and returns an error listing the endpoints not ready
+func CheckReadiness(ctx context.Context, probes []ReadinessProbe) error {
+	var notReady []string
+	for _, probe := range probes {
+		if err := probe.Check(ctx); err != nil {
+			notReady = append(notReady, fmt.Sprintf("%s: %v", probe, err))
+		}
+	}
+	if len(notReady) != 0 {
+		return errors.New(strings.Join(notReady, "; "))
+	}
+	return nil
+}
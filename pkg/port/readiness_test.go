@@ -0,0 +1,243 @@
+package port
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestGetReadinessProbes(t *testing.T) {
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000},
+		{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20002, ContainerPort: 5858, IsDebug: true},
+	}
+	tests := []struct {
+		name     string
+		endpoint v1alpha2.Endpoint
+		want     []ReadinessProbe
+		wantErr  bool
+	}{
+		{
+			name:     "TCP probe by default",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 3000},
+			want: []ReadinessProbe{
+				{Name: "http", Type: ProbeTCP, Address: "127.0.0.1:20001", Timeout: DefaultReadinessTimeout},
+			},
+		},
+		{
+			name: "HTTP probe",
+			endpoint: v1alpha2.Endpoint{
+				Name:       "http",
+				TargetPort: 3000,
+				Attributes: attributes.Attributes{}.
+					PutString(ReadinessPathAttribute, "/healthz").
+					PutInteger(ReadinessStatusAttribute, 204).
+					PutString(ReadinessTimeoutAttribute, "3s"),
+			},
+			want: []ReadinessProbe{
+				{Name: "http", Type: ProbeHTTP, Address: "127.0.0.1:20001", Scheme: "http", Path: "/healthz", ExpectedStatus: 204, Timeout: 3 * time.Second},
+			},
+		},
+		{
+			name: "HTTPS probe",
+			endpoint: v1alpha2.Endpoint{
+				Name:       "https",
+				TargetPort: 3000,
+				Protocol:   v1alpha2.HTTPSEndpointProtocol,
+				Attributes: attributes.Attributes{}.PutString(ReadinessPathAttribute, "/"),
+			},
+			want: []ReadinessProbe{
+				{Name: "https", Type: ProbeHTTP, Address: "127.0.0.1:20001", Scheme: "https", Path: "/", Timeout: DefaultReadinessTimeout},
+			},
+		},
+		{
+			name:     "UDP endpoint not probed",
+			endpoint: v1alpha2.Endpoint{Name: "dns", TargetPort: 3000, Protocol: v1alpha2.UDPEndpointProtocol},
+		},
+		{
+			name: "invalid status",
+			endpoint: v1alpha2.Endpoint{
+				Name:       "http",
+				TargetPort: 3000,
+				Attributes: attributes.Attributes{}.
+					PutString(ReadinessPathAttribute, "/").
+					PutString(ReadinessStatusAttribute, "ok"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "status without path",
+			endpoint: v1alpha2.Endpoint{
+				Name:       "http",
+				TargetPort: 3000,
+				Attributes: attributes.Attributes{}.PutInteger(ReadinessStatusAttribute, 200),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timeout",
+			endpoint: v1alpha2.Endpoint{
+				Name:       "http",
+				TargetPort: 3000,
+				Attributes: attributes.Attributes{}.PutString(ReadinessTimeoutAttribute, "3"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := map[string][]v1alpha2.Endpoint{
+				"runtime": {tt.endpoint, {Name: "debug", TargetPort: 5858}},
+			}
+			got, err := GetReadinessProbes(mapping, fwPorts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetReadinessProbes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetReadinessProbes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadinessProbe_Check_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	address := server.Listener.Addr().String()
+
+	tests := []struct {
+		name    string
+		probe   ReadinessProbe
+		wantErr bool
+	}{
+		{
+			name:  "any success status",
+			probe: ReadinessProbe{Name: "http", Type: ProbeHTTP, Address: address, Scheme: "http", Path: "/healthz", Timeout: time.Second},
+		},
+		{
+			name:  "expected status",
+			probe: ReadinessProbe{Name: "http", Type: ProbeHTTP, Address: address, Scheme: "http", Path: "healthz", ExpectedStatus: 204, Timeout: time.Second},
+		},
+		{
+			name:    "unexpected status",
+			probe:   ReadinessProbe{Name: "http", Type: ProbeHTTP, Address: address, Scheme: "http", Path: "/healthz", ExpectedStatus: 200, Timeout: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "error status",
+			probe:   ReadinessProbe{Name: "http", Type: ProbeHTTP, Address: address, Scheme: "http", Path: "/", Timeout: time.Second},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.probe.Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadinessProbe_Check_TCP(t *testing.T) {
+	// listen returns the address of a listener handling the connections with handle
+	listen := func(t *testing.T, handle func(conn net.Conn)) string {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			_ = l.Close()
+		})
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go handle(conn)
+			}
+		}()
+		return l.Addr().String()
+	}
+
+	t.Run("connection kept open", func(t *testing.T) {
+		address := listen(t, func(conn net.Conn) {
+			time.Sleep(time.Second)
+			_ = conn.Close()
+		})
+		probe := ReadinessProbe{Name: "tcp", Type: ProbeTCP, Address: address, Timeout: time.Second}
+		if err := probe.Check(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("connection closed right away", func(t *testing.T) {
+		address := listen(t, func(conn net.Conn) {
+			_ = conn.Close()
+		})
+		probe := ReadinessProbe{Name: "tcp", Type: ProbeTCP, Address: address, Timeout: time.Second}
+		if err := probe.Check(context.Background()); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("nothing listening", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port := l.Addr().(*net.TCPAddr).Port
+		_ = l.Close()
+		probe := ReadinessProbe{Name: "tcp", Type: ProbeTCP, Address: net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), Timeout: time.Second}
+		if err := probe.Check(context.Background()); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestWaitForReadiness(t *testing.T) {
+	ready := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-ready:
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	probes := []ReadinessProbe{
+		{Name: "http", Type: ProbeHTTP, Address: server.Listener.Addr().String(), Scheme: "http", Path: "/", Timeout: time.Second},
+	}
+
+	err := WaitForReadiness(context.Background(), probes, 200*time.Millisecond)
+	if err == nil {
+		t.Error("expected an error when the application does not respond")
+	}
+
+	time.AfterFunc(100*time.Millisecond, func() {
+		close(ready)
+	})
+	err = WaitForReadiness(context.Background(), probes, 10*time.Second)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -33,4 +33,21 @@ type Client interface {
 
 	// GetForwardedPorts returns the list of ports for each container currently forwarded.
 	GetForwardedPorts() map[string][]v1alpha2.Endpoint
+
+	// GetLocalForwardedPorts returns the local ports currently forwarded to the containers.
+	GetLocalForwardedPorts() []api.ForwardedPort
+
+	// StartReverseForwarding makes the local addresses defined by reversePorts reachable from the containers of the component,
+	// on ports of the network of the pod. It replaces the reverse forwarding previously started for the component, if any.
+	// The forwarded ports are written to out, and the errors happening while forwarding connections are written to errOut.
+	StartReverseForwarding(
+		ctx context.Context,
+		componentName string,
+		reversePorts []api.ReverseForwardedPort,
+		out io.Writer,
+		errOut io.Writer,
+	) error
+
+	// StopReverseForwarding stops the reverse forwarding for the specified component.
+	StopReverseForwarding(ctx context.Context, componentName string)
 }
@@ -7,6 +7,7 @@ import (
 	"io"
 	"reflect"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -28,10 +29,15 @@ var _ portForward.Client = (*PFClient)(nil)
 
 type PFClient struct {
 	kubernetesClient kclient.ClientInterface
-	stateClient      state.Client
+	stateClient      state.ComponentClient
 
 	appliedEndpoints map[string][]v1alpha2.Endpoint
 
+	// mu protects localPorts, updated each time the port forwarding is restarted
+	mu sync.Mutex
+	// localPorts are the local ports forwarded to the containers
+	localPorts []api.ForwardedPort
+
 	// stopChan on which to write to stop the port forwarding
 	stopChan chan struct{}
 	// finishedChan is written when the port forwarding is finished
@@ -41,9 +47,16 @@ type PFClient struct {
 
 	// indicates that the port forwarding is started, and not stopped
 	isRunning bool
+
+	// reverseCancel stops the reverse port forwarding, started on the pod reversePodName for appliedReversePorts
+	reverseCancel       context.CancelFunc
+	reversePodName      string
+	appliedReversePorts []api.ReverseForwardedPort
+	// reverseWg is done when all the goroutines of the reverse port forwarding are finished
+	reverseWg sync.WaitGroup
 }
 
-func NewPFClient(kubernetesClient kclient.ClientInterface, stateClient state.Client) *PFClient {
+func NewPFClient(kubernetesClient kclient.ClientInterface, stateClient state.ComponentClient) *PFClient {
 	return &PFClient{
 		kubernetesClient: kubernetesClient,
 		stateClient:      stateClient,
@@ -106,15 +119,21 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 
 	o.isRunning = true
 
+	if out == nil {
+		out = log.GetStdout()
+	}
 	devstateChan := make(chan error)
 	go func() {
 		backo := watch.NewExpBackoff()
 		for {
 			o.finishedChan = make(chan struct{}, 1)
-			portsBuf := NewPortWriter(log.GetStdout(), len(portPairsSlice), ceMapping, customAddress)
+			portsBuf := NewPortWriter(out, len(portPairsSlice), ceMapping, customAddress)
 
 			go func() {
 				portsBuf.Wait()
+				o.mu.Lock()
+				o.localPorts = portsBuf.GetForwardedPorts()
+				o.mu.Unlock()
 				err = o.stateClient.SetForwardedPorts(ctx, portsBuf.GetForwardedPorts())
 				if err != nil {
 					err = fmt.Errorf("unable to save forwarded ports to state file: %v", err)
@@ -169,6 +188,12 @@ func (o *PFClient) GetForwardedPorts() map[string][]v1alpha2.Endpoint {
 	return o.appliedEndpoints
 }
 
+func (o *PFClient) GetLocalForwardedPorts() []api.ForwardedPort {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.localPorts
+}
+
 // getCustomPortPairs assigns custom port on localhost to a container port if provided by the definedPorts config,
 // if not, it assigns a port starting from 20001 as done in portPairsFromContainerEndpoints
 func getCustomPortPairs(definedPorts []api.ForwardedPort, ceMapping map[string][]v1alpha2.Endpoint, address string) map[string][]string {
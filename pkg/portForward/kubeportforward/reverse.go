@@ -0,0 +1,264 @@
+package kubeportforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// See https://github.com/devfile/developer-images and https://quay.io/repository/devfile/base-developer-image?tab=tags
+const (
+	// ReverseHelperContainerName is the name of the container added to the pod of the component,
+	// listening on the ports of the reverse port forwarding
+	ReverseHelperContainerName = "odo-helper-reverse-port-forwarding"
+	reverseHelperImage         = "quay.io/devfile/base-developer-image@sha256:27d5ce66a259decb84770ea0d1ce8058a806f39dfcfeed8387f9cf2f29e76480"
+
+	// acceptedConnectionMessage is written by socat on stderr when it accepts a connection
+	acceptedConnectionMessage = "accepting connection from"
+
+	localDialTimeout = 10 * time.Second
+)
+
+// GetReverseHelperContainer returns the container to add to the pod of the component for the reverse port forwarding.
+// The container shares the network of the pod with the other containers, which reach the local addresses
+// on localhost or on the IP address of the pod.
+func GetReverseHelperContainer() corev1.Container {
+	return corev1.Container{
+		Name:    ReverseHelperContainerName,
+		Image:   reverseHelperImage,
+		Command: []string{"tail"},
+		Args:    []string{"-f", "/dev/null"},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+			RunAsNonRoot: pointer.Bool(true),
+			SeccompProfile: &corev1.SeccompProfile{
+				Type: corev1.SeccompProfileTypeRuntimeDefault,
+			},
+		},
+	}
+}
+
+// StartReverseForwarding listens on each remote port with socat in the helper container.
+// The connections accepted by socat are tunnelled through the streams of the exec API to the local addresses,
+// a socat process being started for each connection.
+func (o *PFClient) StartReverseForwarding(ctx context.Context, componentName string, reversePorts []api.ReverseForwardedPort, out io.Writer, errOut io.Writer) error {
+	if len(reversePorts) == 0 {
+		o.StopReverseForwarding(ctx, componentName)
+		return nil
+	}
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return err
+	}
+	if o.reverseCancel != nil && o.reversePodName == pod.GetName() && equalReversePorts(o.appliedReversePorts, reversePorts) {
+		return nil
+	}
+	o.StopReverseForwarding(ctx, componentName)
+
+	// The socat processes still listening from a previous session would accept connections which cannot be tunnelled anymore
+	var stderr bytes.Buffer
+	err = o.kubernetesClient.ExecCMDInContainer(ctx, ReverseHelperContainerName, pod.GetName(), []string{"sh", "-c", "pkill -x socat || true"}, io.Discard, &stderr, nil, false)
+	if err != nil {
+		return fmt.Errorf("unable to prepare the reverse port forwarding: %w: %s", err, stderr.String())
+	}
+
+	reverseCtx, cancel := context.WithCancel(ctx)
+	o.reverseCancel = cancel
+	o.reversePodName = pod.GetName()
+	o.appliedReversePorts = reversePorts
+	for _, port := range reversePorts {
+		port := port
+		o.reverseWg.Add(1)
+		go func() {
+			defer o.reverseWg.Done()
+			o.reverseForward(reverseCtx, pod.GetName(), port, errOut)
+		}()
+		fmt.Fprintf(out, " -  %s\n", log.SboldColor(color.FgGreen,
+			fmt.Sprintf("Forwarding from port %d of the pod -> %s", port.RemotePort, getLocalAddress(port))))
+	}
+	return nil
+}
+
+func (o *PFClient) StopReverseForwarding(ctx context.Context, componentName string) {
+	if o.reverseCancel == nil {
+		return
+	}
+	o.reverseCancel()
+	o.reverseWg.Wait()
+	o.reverseCancel = nil
+	o.reversePodName = ""
+	o.appliedReversePorts = nil
+}
+
+// reverseForward listens on the remote port until ctx is cancelled.
+// When a connection is accepted, it is tunnelled until it is closed, while a new socat process listens for the next connection.
+func (o *PFClient) reverseForward(ctx context.Context, podName string, port api.ReverseForwardedPort, errOut io.Writer) {
+	backo := watch.NewExpBackoff()
+	for ctx.Err() == nil {
+		session := newReverseSession(getLocalAddress(port), errOut)
+		done := make(chan error, 1)
+		go func() {
+			err := o.kubernetesClient.ExecCMDInContainer(ctx, ReverseHelperContainerName, podName, getReverseListenCommand(port.RemotePort),
+				reverseSessionStdout{session}, session, session.stdin, false)
+			session.close()
+			done <- err
+		}()
+
+		select {
+		case <-session.accepted:
+			backo.Reset()
+			o.reverseWg.Add(1)
+			go func() {
+				defer o.reverseWg.Done()
+				if err := <-done; err != nil {
+					klog.V(4).Infof("connection forwarded from port %d of the pod closed: %v", port.RemotePort, err)
+				}
+			}()
+		case err := <-done:
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(errOut, "Failed to setup reverse port-forwarding from port %d of the pod: %v: %s\n", port.RemotePort, err, session.getStderr())
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(backo.Delay()):
+			}
+		}
+	}
+}
+
+func getReverseListenCommand(remotePort int) []string {
+	return []string{"socat", "-d", "-d", fmt.Sprintf("TCP-LISTEN:%d,reuseaddr", remotePort), "STDIO"}
+}
+
+func getLocalAddress(port api.ReverseForwardedPort) string {
+	address := port.LocalAddress
+	if address == "" {
+		address = "localhost"
+	}
+	return net.JoinHostPort(address, strconv.Itoa(port.LocalPort))
+}
+
+func equalReversePorts(a, b []api.ReverseForwardedPort) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// reverseSession tunnels the single connection accepted by a socat process to the local address.
+// socat reports the accepted connection on its stderr, written to the session,
+// the data of the connection being transferred through its stdin and stdout.
+type reverseSession struct {
+	localAddress string
+	errOut       io.Writer
+
+	// accepted is closed when the connection to the local address has been attempted, after socat accepted a connection
+	accepted chan struct{}
+	// conn is the connection to the local address; it is nil if the local address cannot be reached
+	conn net.Conn
+
+	stdin       *io.PipeReader
+	stdinWriter *io.PipeWriter
+
+	mu     sync.Mutex
+	stderr bytes.Buffer
+	once   sync.Once
+}
+
+func newReverseSession(localAddress string, errOut io.Writer) *reverseSession {
+	stdin, stdinWriter := io.Pipe()
+	return &reverseSession{
+		localAddress: localAddress,
+		errOut:       errOut,
+		accepted:     make(chan struct{}),
+		stdin:        stdin,
+		stdinWriter:  stdinWriter,
+	}
+}
+
+// Write receives the stderr of socat
+func (o *reverseSession) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	o.stderr.Write(p)
+	isAccepted := bytes.Contains(o.stderr.Bytes(), []byte(acceptedConnectionMessage))
+	if isAccepted {
+		o.stderr.Reset()
+	}
+	o.mu.Unlock()
+	if isAccepted {
+		o.once.Do(o.connect)
+	}
+	return len(p), nil
+}
+
+func (o *reverseSession) getStderr() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return strings.TrimSpace(o.stderr.String())
+}
+
+func (o *reverseSession) connect() {
+	defer close(o.accepted)
+	conn, err := net.DialTimeout("tcp", o.localAddress, localDialTimeout)
+	if err != nil {
+		fmt.Fprintf(o.errOut, "Unable to forward a connection from the pod to %s: %v\n", o.localAddress, err)
+		// Closing the stdin of socat closes the connection accepted in the pod
+		_ = o.stdinWriter.Close()
+		return
+	}
+	o.conn = conn
+	go func() {
+		_, err := io.Copy(o.stdinWriter, conn)
+		_ = o.stdinWriter.CloseWithError(err)
+	}()
+}
+
+// close releases the resources of the session, once the socat process is terminated
+func (o *reverseSession) close() {
+	_ = o.stdinWriter.Close()
+	// Wait for the connection to the local address to be attempted, if socat accepted a connection
+	o.once.Do(func() {})
+	if o.conn != nil {
+		_ = o.conn.Close()
+	}
+}
+
+// reverseSessionStdout receives the stdout of socat, written to the connection to the local address
+type reverseSessionStdout struct {
+	session *reverseSession
+}
+
+func (o reverseSessionStdout) Write(p []byte) (int, error) {
+	<-o.session.accepted
+	if o.session.conn == nil {
+		return 0, errors.New("the local address cannot be reached")
+	}
+	return o.session.conn.Write(p)
+}
@@ -0,0 +1,96 @@
+package kubeportforward
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestReverseSession(t *testing.T) {
+	// Local service answering "pong" to "ping"
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil || line != "ping\n" {
+			return
+		}
+		_, _ = conn.Write([]byte("pong\n"))
+	}()
+
+	var errOut bytes.Buffer
+	session := newReverseSession(listener.Addr().String(), &errOut)
+
+	// Simulate the output of socat accepting a connection, and the data received on the connection
+	_, _ = session.Write([]byte("2023/01/01 00:00:00 socat[1] N listening on AF=2 0.0.0.0:8080\n"))
+	select {
+	case <-session.accepted:
+		t.Fatal("the connection should not be accepted before socat reports it")
+	default:
+	}
+	_, _ = session.Write([]byte("2023/01/01 00:00:01 socat[1] N accepting connection from AF=2 10.0.0.2:41000 on AF=2 10.0.0.1:8080\n"))
+	<-session.accepted
+	if _, err = (reverseSessionStdout{session}).Write([]byte("ping\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := bufio.NewReader(session.stdin).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "pong\n" {
+		t.Errorf("unexpected data sent to socat: %q", got)
+	}
+	session.close()
+	if errOut.Len() != 0 {
+		t.Errorf("unexpected errors: %s", errOut.String())
+	}
+}
+
+func TestReverseSessionUnreachable(t *testing.T) {
+	// Get a local port on which nothing listens
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	var errOut bytes.Buffer
+	session := newReverseSession(address, &errOut)
+	_, _ = session.Write([]byte("N accepting connection from AF=2 10.0.0.2:41000 on AF=2 10.0.0.1:8080\n"))
+	<-session.accepted
+
+	if _, err = (reverseSessionStdout{session}).Write([]byte("ping\n")); err == nil {
+		t.Error("an error is expected when writing to an unreachable local address")
+	}
+	// The stdin of socat is closed, to close the connection accepted in the pod
+	if _, err = io.ReadAll(session.stdin); err != nil {
+		t.Errorf("unexpected error reading stdin: %v", err)
+	}
+	if errOut.Len() == 0 {
+		t.Error("an error should be reported")
+	}
+	session.close()
+}
+
+func TestGetLocalAddress(t *testing.T) {
+	if got := getLocalAddress(api.ReverseForwardedPort{RemotePort: 8080, LocalPort: 9090}); got != "localhost:9090" {
+		t.Errorf("getLocalAddress() = %q", got)
+	}
+	if got := getLocalAddress(api.ReverseForwardedPort{RemotePort: 8080, LocalAddress: "::1", LocalPort: 9090}); got != "[::1]:9090" {
+		t.Errorf("getLocalAddress() = %q", got)
+	}
+}
@@ -2,6 +2,7 @@ package podmanportforward
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
@@ -138,3 +139,20 @@ func getCommandDefinition(port api.ForwardedPort) remotecmd.CommandDefinition {
 		CmdLine:      fmt.Sprintf("socat -d %[1]s-listen:%[2]d,reuseaddr,fork %[1]s:localhost:%[3]d", proto, port.LocalPort, port.ContainerPort),
 	}
 }
+
+func (o *PFClient) GetLocalForwardedPorts() []api.ForwardedPort {
+	result := make([]api.ForwardedPort, 0, len(o.appliedPorts))
+	for port := range o.appliedPorts {
+		result = append(result, port)
+	}
+	return result
+}
+
+func (o *PFClient) StartReverseForwarding(ctx context.Context, componentName string, reversePorts []api.ReverseForwardedPort, out io.Writer, errOut io.Writer) error {
+	if len(reversePorts) != 0 {
+		return errors.New("reverse port forwarding is not supported on Podman")
+	}
+	return nil
+}
+
+func (o *PFClient) StopReverseForwarding(ctx context.Context, componentName string) {}
@@ -11,7 +11,13 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/storage"
-	"github.com/redhat-developer/odo/pkg/task"
+)
+
+const (
+	// stopPollInterval is the interval at which the processes being stopped are checked
+	stopPollInterval = 500 * time.Millisecond
+	// killTimeout is the duration given to the processes to exit after being killed
+	killTimeout = 5 * time.Second
 )
 
 // kubeExecProcessHandler implements RemoteProcessHandler by executing Devfile commands right away in the container
@@ -141,37 +147,20 @@ func (k *kubeExecProcessHandler) StartProcessForCommand(ctx context.Context, def
 
 // StopProcessForCommand stops the process representing the specified Devfile command.
 // Because of the way this process is launched and its PID stored (see StartProcessForCommand),
-// we need to determine the process children (there should be only one child which is the sub-shell running the command passed to StartProcessForCommand).
-// Then killing those children will exit the parent 'sh' process.
+// we need to determine the process children (the sub-shell running the command passed to StartProcessForCommand and its own children).
+// The stop signal of the command is sent to the parent 'sh' process and all its children, which are given the grace period of the command to exit.
+// The processes still running after the grace period are then killed, along with the process groups led by any of them,
+// so that the processes detached from the tree (for example grandchildren whose parent already exited) are killed too.
 func (k *kubeExecProcessHandler) StopProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) error {
 	klog.V(4).Infof("StopProcessForCommand for %q", def.Id)
 
-	kill := func(p int) error {
-		_, _, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", fmt.Sprintf("kill %d || true", p)}, podName, containerName, false, nil, nil)
-		if err != nil {
-			return err
-		}
-
-		//Because the process we just stopped might take longer to exit (it might have caught the signal and is performing additional cleanup),
-		//retry detecting its actual state till it is stopped or timeout expires
-		var processInfo interface{}
-		processInfo, err = task.NewRetryable(fmt.Sprintf("status for remote process %d", p), func() (bool, interface{}, error) {
-			pInfo, e := k.getProcessInfoFromPid(ctx, p, 0, podName, containerName)
-			return e == nil && (pInfo.Status == Stopped || pInfo.Status == Errored), pInfo, e
-		}).RetryWithSchedule([]time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second}, true)
-		if err != nil {
-			return err
-		}
-
-		pInfo, ok := processInfo.(RemoteProcessInfo)
-		if !ok {
-			klog.V(2).Infof("invalid type for remote process (%d) info, expected RemoteProcessInfo", p)
-			return fmt.Errorf("internal error while checking remote process status: %d", p)
-		}
-		if pInfo.Status != Stopped && pInfo.Status != Errored {
-			return fmt.Errorf("invalid status for remote process %d: %+v", p, processInfo)
-		}
-		return nil
+	stopSignal := def.StopSignal
+	if stopSignal == "" {
+		stopSignal = DefaultStopSignal
+	}
+	gracePeriod := def.StopGracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultStopGracePeriod
 	}
 
 	ppid, _, err := k.getRemoteProcessPID(ctx, def, podName, containerName)
@@ -181,18 +170,22 @@ func (k *kubeExecProcessHandler) StopProcessForCommand(ctx context.Context, def
 	if ppid == 0 {
 		return nil
 	}
-	defer func() {
-		if kErr := kill(ppid); kErr != nil {
-			klog.V(3).Infof("could not kill parent process %d: %v", ppid, kErr)
-		}
-	}()
 
-	children, err := k.getProcessChildren(ctx, ppid, podName, containerName)
+	allProcesses, err := k.getAllProcesses(ctx, podName, containerName)
 	if err != nil {
+		// parent process should be stopped in all cases
+		if kErr := k.signalProcesses(ctx, stopSignal, []int{ppid}, nil, podName, containerName); kErr != nil {
+			klog.V(3).Infof("could not stop parent process %d: %v", ppid, kErr)
+		}
 		return err
 	}
 
-	klog.V(3).Infof("Found %d children (either direct and indirect) for parent process %d: %v", len(children), ppid, children)
+	// All the processes of the tree, the parent process included, are signaled by a single kill command.
+	// The processes of the groups led by a process of the tree are signaled through their group,
+	// so that the processes detached from the tree (for example grandchildren whose parent already exited) get the signal too.
+	tree := append(processChildren(allProcesses, ppid), ppid)
+	groups := processGroupsLedBy(allProcesses, tree)
+	klog.V(3).Infof("Found %d processes for command %q (parent process %d): %v, process groups: %v", len(tree), def.Id, ppid, tree, groups)
 
 	pidFile := getPidFileForCommand(def)
 	_, _, err = k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", fmt.Sprintf("rm -f %s", pidFile)}, podName, containerName, false, nil, nil)
@@ -200,15 +193,72 @@ func (k *kubeExecProcessHandler) StopProcessForCommand(ctx context.Context, def
 		klog.V(2).Infof("Could not remove file %q: %v", pidFile, err)
 	}
 
-	for _, child := range children {
-		if err = kill(child); err != nil {
-			return err
-		}
+	err = k.signalProcesses(ctx, stopSignal, processesOutsideGroups(allProcesses, tree, groups), groups, podName, containerName)
+	if err != nil {
+		return err
+	}
+	remaining, err := k.waitForProcessesToExit(ctx, tree, groups, gracePeriod, podName, containerName)
+	if err != nil {
+		return err
+	}
+	if len(remaining) == 0 {
+		return nil
 	}
 
+	klog.V(2).Infof("processes %v of command %q still running %s after the %s signal, killing them", pidsOf(remaining), def.Id, gracePeriod, stopSignal)
+	err = k.signalProcesses(ctx, "KILL", processesOutsideGroups(remaining, pidsOf(remaining), groups), groups, podName, containerName)
+	if err != nil {
+		return err
+	}
+	remaining, err = k.waitForProcessesToExit(ctx, tree, groups, killTimeout, podName, containerName)
+	if err != nil {
+		return err
+	}
+	if len(remaining) != 0 {
+		return fmt.Errorf("unable to stop processes %v of command %q", pidsOf(remaining), def.Id)
+	}
 	return nil
 }
 
+// signalProcesses sends the signal to the given processes and to all the processes of the given process groups
+func (k *kubeExecProcessHandler) signalProcesses(ctx context.Context, signal string, pids []int, groups []int, podName string, containerName string) error {
+	targets := make([]string, 0, len(pids)+len(groups))
+	for _, g := range groups {
+		// A negative PID denotes all the processes of the process group
+		targets = append(targets, strconv.Itoa(-g))
+	}
+	for _, p := range pids {
+		targets = append(targets, strconv.Itoa(p))
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	_, _, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", fmt.Sprintf("kill -s %s -- %s || true", signal, strings.Join(targets, " "))}, podName, containerName, false, nil, nil)
+	return err
+}
+
+// waitForProcessesToExit waits until none of the processes in pids, nor any process of the given process groups, is running anymore.
+// It returns the processes still running after timeout.
+func (k *kubeExecProcessHandler) waitForProcessesToExit(ctx context.Context, pids []int, groups []int, timeout time.Duration, podName string, containerName string) ([]processStat, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		processes, err := k.getAllProcesses(ctx, podName, containerName)
+		if err != nil {
+			return nil, err
+		}
+		remaining := runningProcesses(processes, pids, groups)
+		if len(remaining) == 0 || !time.Now().Before(deadline) {
+			return remaining, nil
+		}
+		klog.V(4).Infof("waiting for processes %v to exit", pidsOf(remaining))
+		select {
+		case <-ctx.Done():
+			return remaining, ctx.Err()
+		case <-time.After(stopPollInterval):
+		}
+	}
+}
+
 func (k *kubeExecProcessHandler) getRemoteProcessPID(ctx context.Context, def CommandDefinition, podName string, containerName string) (int, int, error) {
 	pidFile := getPidFileForCommand(def)
 	stdout, stderr, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", pidFile)}, podName, containerName, false, nil, nil)
@@ -291,24 +341,17 @@ func (k *kubeExecProcessHandler) getProcessInfoFromPid(ctx context.Context, pid
 	return process, nil
 }
 
-// getProcessChildren returns all the children (either direct or indirect) of the specified process in the given container.
-// It works by reading the /proc/<pid>/stat files, giving PPID for each PID.
-// The overall result is an ordered list of children PIDs obtained via a recursive post-order traversal algorithm,
-// so that the returned list can start with the deepest children processes.
-func (k *kubeExecProcessHandler) getProcessChildren(ctx context.Context, pid int, podName string, containerName string) ([]int, error) {
-	if pid <= 0 {
-		return nil, fmt.Errorf("invalid pid: %d", pid)
-	}
-
-	allProcesses, err := k.getAllProcesses(ctx, podName, containerName)
-	if err != nil {
-		return nil, err
+// processChildren returns all the children (either direct or indirect) of pid found in allProcesses, the deepest children first.
+func processChildren(allProcesses []processStat, pid int) []int {
+	children := make(map[int][]int)
+	for _, p := range allProcesses {
+		children[p.ppid] = append(children[p.ppid], p.pid)
 	}
 
 	var getProcessChildrenRec func(int) []int
 	getProcessChildrenRec = func(p int) []int {
 		var result []int
-		for _, child := range allProcesses[p] {
+		for _, child := range children[p] {
 			result = append(result, getProcessChildrenRec(child)...)
 		}
 		if p != pid {
@@ -318,14 +361,93 @@ func (k *kubeExecProcessHandler) getProcessChildren(ctx context.Context, pid int
 		return result
 	}
 
-	return getProcessChildrenRec(pid), nil
+	return getProcessChildrenRec(pid)
+}
+
+// processGroupsLedBy returns the process groups whose leader is one of pids.
+// The process group of PID 1 is never returned, as it would stop the container.
+func processGroupsLedBy(allProcesses []processStat, pids []int) []int {
+	leaders := make(map[int]bool, len(pids))
+	for _, p := range pids {
+		leaders[p] = true
+	}
+	var groups []int
+	seen := make(map[int]bool)
+	for _, p := range allProcesses {
+		if p.pgrp <= 1 || !leaders[p.pgrp] || seen[p.pgrp] {
+			continue
+		}
+		seen[p.pgrp] = true
+		groups = append(groups, p.pgrp)
+	}
+	return groups
+}
+
+// processesOutsideGroups returns the processes in pids which do not belong to any of the process groups.
+// The processes not found in allProcesses are considered outside the groups.
+func processesOutsideGroups(allProcesses []processStat, pids []int, groups []int) []int {
+	pgrps := make(map[int]int, len(allProcesses))
+	for _, p := range allProcesses {
+		pgrps[p.pid] = p.pgrp
+	}
+	inGroups := make(map[int]bool, len(groups))
+	for _, g := range groups {
+		inGroups[g] = true
+	}
+	var result []int
+	for _, p := range pids {
+		if pgrp, ok := pgrps[p]; ok && inGroups[pgrp] {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// runningProcesses returns the processes of allProcesses still running (i.e., not zombies) which are in pids,
+// or belong to one of the process groups.
+func runningProcesses(allProcesses []processStat, pids []int, groups []int) []processStat {
+	wanted := make(map[int]bool, len(pids))
+	for _, p := range pids {
+		wanted[p] = true
+	}
+	inGroups := make(map[int]bool, len(groups))
+	for _, g := range groups {
+		inGroups[g] = true
+	}
+	var result []processStat
+	seen := make(map[int]bool)
+	for _, p := range allProcesses {
+		if p.state == 'Z' || p.state == 'X' || seen[p.pid] {
+			continue
+		}
+		if wanted[p.pid] || inGroups[p.pgrp] {
+			seen[p.pid] = true
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func pidsOf(processes []processStat) []int {
+	result := make([]int, 0, len(processes))
+	for _, p := range processes {
+		result = append(result, p.pid)
+	}
+	return result
+}
+
+// processStat is the information about a process read from its /proc/<pid>/stat file
+type processStat struct {
+	pid   int
+	ppid  int
+	pgrp  int
+	state byte
 }
 
-// getAllProcesses returns a map of all the processes and their direct children:
-// i) the key is the process PID;
-// ii) and the value is a list of all its direct children.
+// getAllProcesses returns all the processes running in the container, and the PIDs of their parent and process group.
 // It does so by reading all /proc/<pid>/stat files. More details on https://man7.org/linux/man-pages/man5/proc.5.html.
-func (k *kubeExecProcessHandler) getAllProcesses(ctx context.Context, podName string, containerName string) (map[int][]int, error) {
+func (k *kubeExecProcessHandler) getAllProcesses(ctx context.Context, podName string, containerName string) ([]processStat, error) {
 	stdout, stderr, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", "cat /proc/*/stat || true"}, podName, containerName, false, nil, nil)
 	if err != nil {
 		klog.V(7).Infof("stdout: %s\n", strings.Join(stdout, "\n"))
@@ -333,10 +455,10 @@ func (k *kubeExecProcessHandler) getAllProcesses(ctx context.Context, podName st
 		return nil, err
 	}
 
-	allProcesses := make(map[int][]int)
+	var allProcesses []processStat
 	for _, line := range stdout {
-		var pid int
-		_, err = fmt.Sscanf(line, "%d ", &pid)
+		var p processStat
+		_, err = fmt.Sscanf(line, "%d ", &p.pid)
 		if err != nil {
 			return nil, err
 		}
@@ -351,15 +473,13 @@ func (k *kubeExecProcessHandler) getAllProcesses(ctx context.Context, podName st
 		// At this point, "i" is the index of the last ")" character, and we have an additional space before the process state character, hence the "i+2".
 		// For example:
 		// 87 (main) S 0 81 81 0 -1 ...
-		// This is required to scan the ppid correctly.
-		var state byte
-		var ppid int
-		_, err = fmt.Sscanf(line[i+2:], "%c %d", &state, &ppid)
+		// This is required to scan the state, ppid and process group correctly.
+		_, err = fmt.Sscanf(line[i+2:], "%c %d %d", &p.state, &p.ppid, &p.pgrp)
 		if err != nil {
 			return nil, err
 		}
 
-		allProcesses[ppid] = append(allProcesses[ppid], pid)
+		allProcesses = append(allProcesses, p)
 	}
 
 	return allProcesses, nil
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -303,34 +304,81 @@ func Test_kubeExecProcessHandler_StopProcessForCommand(t *testing.T) {
 	retrieveChildrenCmdProvider := func() []string {
 		return []string{ShellExecutable, "-c", "cat /proc/*/stat || true"}
 	}
-	killCmdProvider := func(p int) []string {
-		return []string{ShellExecutable, "-c", fmt.Sprintf("kill %d || true", p)}
+	killCmdProvider := func(signal string, targets string) []string {
+		return []string{ShellExecutable, "-c", fmt.Sprintf("kill -s %s -- %s || true", signal, targets)}
 	}
-	kill0CmdProvider := func(p int) []string {
-		return []string{ShellExecutable, "-c", fmt.Sprintf("kill -0 %d; echo $?", p)}
+	// Processes not related to the command: tail (PID 1) and bash (118)
+	otherProcesses := strings.Join(strings.Split(statFile, "\n")[:2], "\n")
+	// A grandchild of the command, reparented to PID 1 after its parent exited, but still in the process group of the command
+	detachedGrandchild := "400 (my-cmd) S 1 81 81 0 -1 4210688 541 0 0 0 0 0 0 0 20 0 5 0 172022 1032048640 1892 18446744073709551615 4194304 6405776 140730311069152 0 0 0 0 0 2143420159 0 0 0 17 1 0 0 0 0 0 8507392 8757920 34906112 140730311072280 140730311072287 140730311072287 140730311073777 0"
+
+	type transition struct {
+		killCmd   []string
+		afterStat string
+	}
+	// expectProcesses makes the stat files of the container return stat, then the afterStat of each transition
+	// once its kill command is executed
+	expectProcesses := func(kclient *kclient.MockClientInterface, stat string, transitions ...transition) {
+		var mu sync.Mutex
+		kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(retrieveChildrenCmdProvider()),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				mu.Lock()
+				defer mu.Unlock()
+				_, err := stdout.Write([]byte(stat))
+				return err
+			}).MinTimes(len(transitions) + 1)
+		for _, tr := range transitions {
+			tr := tr
+			kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(tr.killCmd),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+					mu.Lock()
+					defer mu.Unlock()
+					stat = tr.afterStat
+					return nil
+				})
+		}
+	}
+	expectPid := func(kclient *kclient.MockClientInterface, def CommandDefinition, pid string) {
+		kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(def))}),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+				_, err := stdout.Write([]byte(pid))
+				return err
+			})
+	}
+	expectPidFileRemoved := func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+		kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("rm -f %s", getPidFileForCommand(def))}),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("an error which should be ignored"))
 	}
 
 	for _, tt := range []struct {
 		name                 string
-		kubeClientCustomizer func(*kclient.MockClientInterface)
-		pid                  int
+		cmdDef               CommandDefinition
+		kubeClientCustomizer func(*kclient.MockClientInterface, CommandDefinition)
 		wantErr              bool
 	}{
 		{
-			name: "error returned when checking pid file",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
+			name:   "error returned when checking pid file",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
+					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(def))}),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("an error"))
 			},
 			wantErr: true,
 		},
 		{
-			name: "nothing to do if PID file missing",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
+			name:   "nothing to do if PID file missing",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
+					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(def))}),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
 						_, err := stderr.Write([]byte("no such file or directory"))
@@ -339,132 +387,73 @@ func Test_kubeExecProcessHandler_StopProcessForCommand(t *testing.T) {
 			},
 		},
 		{
-			name: "error while determining process children",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stdout.Write([]byte("123"))
-						return err
-					})
+			name:   "error while determining process children",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "123")
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(retrieveChildrenCmdProvider()),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("an error"))
-				// parent process should still be killed.
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider(123)),
+				// parent process should still be stopped.
+				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider("TERM", "123")),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(kill0CmdProvider(123)),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, _ = stderr.Write([]byte("no such process"))
-						_, err := stdout.Write([]byte("1"))
-						return err
-					})
 			},
 			wantErr: true,
 		},
 		{
-			name: "no process children killed if no children file found",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(errors.New("an error which should be ignored"))
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stdout.Write([]byte("123"))
-						return err
-					})
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(retrieveChildrenCmdProvider()),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stderr.Write([]byte("no such file or directory"))
-						return err
-					})
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider(123)),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil)
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(kill0CmdProvider(123)),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, _ = stderr.Write([]byte("no such process"))
-						_, err := stdout.Write([]byte("1"))
-						return err
-					})
+			name:   "only parent process stopped if no children file found",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "123")
+				expectPidFileRemoved(kclient, def)
+				expectProcesses(kclient, "", transition{killCmdProvider("TERM", "123"), ""})
 			},
 		},
 		{
-			name: "process children should get killed",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(errors.New("an error which should be ignored"))
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stdout.Write([]byte("81"))
-						return err
-					})
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(retrieveChildrenCmdProvider()),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stdout.Write([]byte(statFile))
-						return err
-					})
-				for _, p := range []int{333, 334, 222, 223, 87, 81} {
-					kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider(p)),
-						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(nil)
-					kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(kill0CmdProvider(p)),
-						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-						DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-							_, _ = stderr.Write([]byte("no such process"))
-							_, err := stdout.Write([]byte("1"))
-							return err
-						})
-				}
-			},
-		},
-		{
-			name: "error if any child process could not be killed",
-			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(errors.New("an error which should be ignored"))
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
-					gomock.Eq([]string{ShellExecutable, "-c", fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef))}),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, err := stdout.Write([]byte("81"))
-						return err
-					})
+			name:   "process group of the command stopped with the default signal",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "81")
+				expectPidFileRemoved(kclient, def)
+				expectProcesses(kclient, statFile, transition{killCmdProvider("TERM", "-81"), otherProcesses})
+			},
+		},
+		{
+			name:   "process group of the command stopped with the signal of the command",
+			cmdDef: CommandDefinition{Id: "my-run", StopSignal: "INT"},
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "81")
+				expectPidFileRemoved(kclient, def)
+				expectProcesses(kclient, statFile, transition{killCmdProvider("INT", "-81"), otherProcesses})
+			},
+		},
+		{
+			name:   "detached processes of the group killed after the grace period",
+			cmdDef: CommandDefinition{Id: "my-run", StopGracePeriod: time.Millisecond},
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "81")
+				expectPidFileRemoved(kclient, def)
+				expectProcesses(kclient, statFile,
+					transition{killCmdProvider("TERM", "-81"), otherProcesses + "\n" + detachedGrandchild},
+					transition{killCmdProvider("KILL", "-81"), otherProcesses})
+			},
+		},
+		{
+			name:   "error if processes could not be signaled",
+			cmdDef: cmdDef,
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface, def CommandDefinition) {
+				expectPid(kclient, def, "81")
+				expectPidFileRemoved(kclient, def)
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(retrieveChildrenCmdProvider()),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
 						_, err := stdout.Write([]byte(statFile))
 						return err
 					})
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider(333)),
+				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider("TERM", "-81")),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(errors.New("error killing process 333"))
-				// parent process should be stopped in all cases
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(killCmdProvider(81)),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil)
-				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName), gomock.Eq(kill0CmdProvider(81)),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
-						_, _ = stderr.Write([]byte("no such process"))
-						_, err := stdout.Write([]byte("1"))
-						return err
-					})
+					Return(errors.New("error killing process group 81"))
 			},
 			wantErr: true,
 		},
@@ -473,12 +462,12 @@ func Test_kubeExecProcessHandler_StopProcessForCommand(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			kubeClient := kclient.NewMockClientInterface(ctrl)
 			if tt.kubeClientCustomizer != nil {
-				tt.kubeClientCustomizer(kubeClient)
+				tt.kubeClientCustomizer(kubeClient, tt.cmdDef)
 			}
 
 			execClient := exec.NewExecClient(kubeClient)
 			k := NewKubeExecProcessHandler(execClient)
-			err := k.StopProcessForCommand(context.Background(), cmdDef, _podName, _containerName)
+			err := k.StopProcessForCommand(context.Background(), tt.cmdDef, _podName, _containerName)
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("unexpected error %v, wantErr %v", err, tt.wantErr)
@@ -752,7 +741,7 @@ func Test_kubeExecProcessHandler_getRemoteProcessPID(t *testing.T) {
 	}
 }
 
-func Test_kubeExecProcessHandler_getProcessChildren(t *testing.T) {
+func Test_processChildren(t *testing.T) {
 	const ppid = 123
 	cmdProvider := func() []string {
 		return []string{ShellExecutable, "-c", "cat /proc/*/stat || true"}
@@ -765,15 +754,6 @@ func Test_kubeExecProcessHandler_getProcessChildren(t *testing.T) {
 		want                 []int
 		wantErr              bool
 	}{
-		{
-			name:    "pid < 0",
-			ppid:    -1,
-			wantErr: true,
-		},
-		{
-			name:    "pid = 0",
-			wantErr: true,
-		},
 		{
 			name: "error returned at command execution",
 			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
@@ -828,6 +808,21 @@ func Test_kubeExecProcessHandler_getProcessChildren(t *testing.T) {
 			ppid: 81,
 			want: []int{333, 334, 222, 223, 87},
 		},
+		{
+			name: "stat file without children",
+			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
+				cmd := cmdProvider()
+				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+					gomock.Eq(cmd),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+						_, err := stdout.Write([]byte(statFile))
+						return err
+					})
+			},
+			ppid: 333,
+			want: nil,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -838,12 +833,13 @@ func Test_kubeExecProcessHandler_getProcessChildren(t *testing.T) {
 
 			execClient := exec.NewExecClient(kubeClient)
 			kubeExecClient := NewKubeExecProcessHandler(execClient)
-			got, err := kubeExecClient.getProcessChildren(context.Background(), tt.ppid, _podName, _containerName)
+			allProcesses, err := kubeExecClient.getAllProcesses(context.Background(), _podName, _containerName)
 			if tt.wantErr != (err != nil) {
 				t.Errorf("unexpected error %v, wantErr %v", err, tt.wantErr)
 			}
+			got := processChildren(allProcesses, tt.ppid)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("kubeExecProcessHandler.getProcessChildren() mismatch (-want +got):\n%s", diff)
+				t.Errorf("processChildren() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
@@ -0,0 +1,21 @@
+package remotecmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// stopSignals lists the signals which can be used to stop the process of a command
+var stopSignals = []string{"HUP", "INT", "QUIT", "KILL", "USR1", "USR2", "TERM"}
+
+// ParseSignal returns the name of the signal s, as understood by the kill command.
+// The name is not case-sensitive, and can be prefixed with "SIG" (for example "SIGINT" or "int").
+func ParseSignal(s string) (string, error) {
+	name := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "SIG")
+	for _, sig := range stopSignals {
+		if sig == name {
+			return sig, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported signal %q, supported signals are %v", s, stopSignals)
+}
@@ -0,0 +1,25 @@
+package remotecmd
+
+import "testing"
+
+func TestParseSignal(t *testing.T) {
+	for s, want := range map[string]string{
+		"TERM":    "TERM",
+		"SIGINT":  "INT",
+		"sigquit": "QUIT",
+		" usr1 ":  "USR1",
+	} {
+		got, err := ParseSignal(s)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", s, err)
+		}
+		if got != want {
+			t.Errorf("ParseSignal(%q) = %q, want %q", s, got, want)
+		}
+	}
+	for _, s := range []string{"", "15", "SIGSTOP", "TERMINATE"} {
+		if _, err := ParseSignal(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
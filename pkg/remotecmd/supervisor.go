@@ -0,0 +1,271 @@
+package remotecmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+)
+
+// RestartPolicy defines when the process of a command supervised by a Supervisor is restarted after it exits.
+type RestartPolicy string
+
+const (
+	// RestartNever never restarts the process.
+	RestartNever RestartPolicy = "never"
+
+	// RestartOnFailure restarts the process when it exits with a non-zero status code.
+	RestartOnFailure RestartPolicy = "on-failure"
+
+	// RestartAlways restarts the process whatever its exit status.
+	RestartAlways RestartPolicy = "always"
+)
+
+// RestartPolicies lists the supported restart policies
+var RestartPolicies = []RestartPolicy{RestartNever, RestartOnFailure, RestartAlways}
+
+// ParseRestartPolicy returns the restart policy named s
+func ParseRestartPolicy(s string) (RestartPolicy, error) {
+	for _, p := range RestartPolicies {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("unknown restart policy %q, supported policies are %v", s, RestartPolicies)
+}
+
+// shouldRestart returns true if a process exited with the given status needs to be restarted
+func (p RestartPolicy) shouldRestart(status RemoteProcessStatus) bool {
+	switch p {
+	case RestartAlways:
+		return status == Stopped || status == Errored
+	case RestartOnFailure:
+		return status == Errored
+	default:
+		return false
+	}
+}
+
+// Backoff computes the delays between the restarts of a process. It is implemented by watch.ExpBackoff.
+type Backoff interface {
+	// Delay returns the delay to wait before the next restart
+	Delay() time.Duration
+	// Reset restarts the computation of the delays from the first one
+	Reset()
+}
+
+// SupervisedProcessInfo represents the status of a process supervised by a Supervisor
+type SupervisedProcessInfo struct {
+	// Id of the command the process belongs to
+	Id string
+
+	// Status of the process
+	Status RemoteProcessStatus
+
+	// Restarts is the number of times the process has been restarted by the Supervisor
+	Restarts int
+
+	// NextRestart is the delay after which the process will be restarted, or 0 if no restart is planned
+	NextRestart time.Duration
+
+	// RetriesExhausted is true when the process exited and is not restarted,
+	// because it has already been restarted the maximum number of consecutive times
+	RetriesExhausted bool
+}
+
+// RestartOptions configures how a Supervisor restarts the processes
+type RestartOptions struct {
+	// Policy defines when a process is restarted
+	Policy RestartPolicy
+
+	// MaxRetries is the maximum number of consecutive restarts of a process, before giving up.
+	// The counter is reset each time the process is started by the caller. 0 means no limit.
+	MaxRetries int
+
+	// NewBackoff returns the backoff computing the delays between the restarts of a process
+	NewBackoff func() Backoff
+
+	// OnStatusChange, if set, is called each time the status of a process changes, or a restart is planned
+	OnStatusChange func(info SupervisedProcessInfo)
+}
+
+// Supervisor is a RemoteProcessHandler tracking the status of the processes started by another RemoteProcessHandler,
+// and restarting them when they exit, according to a restart policy.
+// The processes stopped with StopProcessForCommand are not restarted.
+type Supervisor struct {
+	handler RemoteProcessHandler
+	options RestartOptions
+
+	mu       sync.Mutex
+	commands map[string]*supervisedCommand
+}
+
+// supervisedCommand is the state of the process of a command supervised by a Supervisor
+type supervisedCommand struct {
+	// startMu serializes the starts and stops of the process by the caller and its restarts by the Supervisor,
+	// so a restart is not executed concurrently with a start or a stop by the caller
+	startMu sync.Mutex
+	// generation is incremented each time the process is started or stopped by the caller,
+	// so the exit of a previous process and the restarts planned for it are ignored
+	generation int
+	// restarts is the total number of restarts of the process
+	restarts int
+	// retries is the number of consecutive restarts since the process has been started by the caller
+	retries int
+	backoff Backoff
+}
+
+var _ RemoteProcessHandler = (*Supervisor)(nil)
+
+// NewSupervisor returns a Supervisor restarting the processes started with handler, according to options
+func NewSupervisor(handler RemoteProcessHandler, options RestartOptions) *Supervisor {
+	return &Supervisor{
+		handler:  handler,
+		options:  options,
+		commands: make(map[string]*supervisedCommand),
+	}
+}
+
+// GetProcessInfoForCommand returns information about the process representing the given command.
+func (o *Supervisor) GetProcessInfoForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) (RemoteProcessInfo, error) {
+	return o.handler.GetProcessInfoForCommand(ctx, def, podName, containerName)
+}
+
+// StartProcessForCommand starts a process for the command, and supervises it.
+// The counter of consecutive restarts and the backoff of the command are reset.
+func (o *Supervisor) StartProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string, outputHandler CommandOutputHandler) error {
+	cmd := o.lockStart(def.Id)
+	defer cmd.startMu.Unlock()
+
+	o.mu.Lock()
+	cmd.generation++
+	cmd.retries = 0
+	if cmd.backoff != nil {
+		cmd.backoff.Reset()
+	}
+	generation := cmd.generation
+	o.mu.Unlock()
+
+	return o.handler.StartProcessForCommand(ctx, def, podName, containerName, o.supervise(ctx, def, podName, containerName, generation, outputHandler))
+}
+
+// StopProcessForCommand stops the process of the command. The process is not restarted.
+func (o *Supervisor) StopProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) error {
+	cmd := o.lockStart(def.Id)
+	defer cmd.startMu.Unlock()
+
+	o.mu.Lock()
+	cmd.generation++
+	o.mu.Unlock()
+
+	return o.handler.StopProcessForCommand(ctx, def, podName, containerName)
+}
+
+// getCommand returns the state of the command, creating it if necessary. It must be called with o.mu locked.
+func (o *Supervisor) getCommand(id string) *supervisedCommand {
+	cmd, ok := o.commands[id]
+	if !ok {
+		cmd = &supervisedCommand{}
+		if o.options.NewBackoff != nil {
+			cmd.backoff = o.options.NewBackoff()
+		}
+		o.commands[id] = cmd
+	}
+	return cmd
+}
+
+// lockStart returns the state of the command, with its startMu locked, waiting for a restart in progress to be done
+func (o *Supervisor) lockStart(id string) *supervisedCommand {
+	o.mu.Lock()
+	cmd := o.getCommand(id)
+	o.mu.Unlock()
+	cmd.startMu.Lock()
+	return cmd
+}
+
+// supervise returns an output handler calling outputHandler, notifying the status changes of the process
+// and planning its restart when it exits
+func (o *Supervisor) supervise(ctx context.Context, def CommandDefinition, podName string, containerName string, generation int, outputHandler CommandOutputHandler) CommandOutputHandler {
+	return func(status RemoteProcessStatus, stdout []string, stderr []string, err error) {
+		if outputHandler != nil {
+			outputHandler(status, stdout, stderr, err)
+		}
+
+		o.mu.Lock()
+		cmd := o.commands[def.Id]
+		if cmd.generation != generation || ctx.Err() != nil {
+			// The process has been stopped or restarted by the caller, or the caller is exiting
+			o.mu.Unlock()
+			return
+		}
+		info := SupervisedProcessInfo{
+			Id:       def.Id,
+			Status:   status,
+			Restarts: cmd.restarts,
+		}
+		restart := o.options.Policy.shouldRestart(status)
+		if restart && o.options.MaxRetries > 0 && cmd.retries >= o.options.MaxRetries {
+			restart = false
+			info.RetriesExhausted = true
+		}
+		if restart {
+			cmd.retries++
+			if cmd.backoff != nil {
+				info.NextRestart = cmd.backoff.Delay()
+			}
+			// A zero delay would not allow to distinguish a planned restart
+			if info.NextRestart <= 0 {
+				info.NextRestart = time.Nanosecond
+			}
+		}
+		o.mu.Unlock()
+
+		o.notify(info)
+		if restart {
+			klog.V(2).Infof("process for command %q exited with status %s, restarting it in %s", def.Id, status, info.NextRestart)
+			go o.restart(ctx, def, podName, containerName, generation, info.NextRestart)
+		}
+	}
+}
+
+// restart starts again the process of the command after delay, if it has not been started or stopped by the caller meanwhile.
+// The caller cannot start or stop the process while it is restarted.
+func (o *Supervisor) restart(ctx context.Context, def CommandDefinition, podName string, containerName string, generation int, delay time.Duration) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(delay):
+	}
+
+	cmd := o.lockStart(def.Id)
+	defer cmd.startMu.Unlock()
+
+	o.mu.Lock()
+	if cmd.generation != generation || ctx.Err() != nil {
+		o.mu.Unlock()
+		return
+	}
+	cmd.restarts++
+	o.mu.Unlock()
+
+	err := o.handler.StartProcessForCommand(ctx, def, podName, containerName, o.supervise(ctx, def, podName, containerName, generation, nil))
+	if err != nil {
+		klog.V(2).Infof("unable to restart process for command %q: %v", def.Id, err)
+		o.mu.Lock()
+		restarts := cmd.restarts
+		o.mu.Unlock()
+		o.notify(SupervisedProcessInfo{
+			Id:       def.Id,
+			Status:   Errored,
+			Restarts: restarts,
+		})
+	}
+}
+
+func (o *Supervisor) notify(info SupervisedProcessInfo) {
+	if o.options.OnStatusChange != nil {
+		o.options.OnStatusChange(info)
+	}
+}
@@ -0,0 +1,325 @@
+package remotecmd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeProcessHandler records the processes started, so the tests can make them exit
+type fakeProcessHandler struct {
+	mu       sync.Mutex
+	handlers []CommandOutputHandler
+	started  chan struct{}
+}
+
+func newFakeProcessHandler() *fakeProcessHandler {
+	return &fakeProcessHandler{
+		started: make(chan struct{}, 10),
+	}
+}
+
+func (o *fakeProcessHandler) GetProcessInfoForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) (RemoteProcessInfo, error) {
+	return RemoteProcessInfo{}, nil
+}
+
+func (o *fakeProcessHandler) StartProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string, outputHandler CommandOutputHandler) error {
+	o.mu.Lock()
+	o.handlers = append(o.handlers, outputHandler)
+	o.mu.Unlock()
+	outputHandler(Starting, nil, nil, nil)
+	outputHandler(Running, nil, nil, nil)
+	o.started <- struct{}{}
+	return nil
+}
+
+func (o *fakeProcessHandler) StopProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) error {
+	return nil
+}
+
+// exit makes the last process started exit with the given status
+func (o *fakeProcessHandler) exit(status RemoteProcessStatus) {
+	o.mu.Lock()
+	handler := o.handlers[len(o.handlers)-1]
+	o.mu.Unlock()
+	handler(status, nil, nil, nil)
+}
+
+func (o *fakeProcessHandler) startCount() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.handlers)
+}
+
+// fakeBackoff returns increasing delays of a few milliseconds
+type fakeBackoff struct {
+	attempt int
+}
+
+func (o *fakeBackoff) Delay() time.Duration {
+	o.attempt++
+	return time.Duration(o.attempt) * time.Millisecond
+}
+
+func (o *fakeBackoff) Reset() {
+	o.attempt = 0
+}
+
+func TestSupervisor(t *testing.T) {
+	type exit struct {
+		status      RemoteProcessStatus
+		wantRestart bool
+	}
+	tests := []struct {
+		name         string
+		policy       RestartPolicy
+		maxRetries   int
+		exits        []exit
+		wantLastInfo SupervisedProcessInfo
+	}{
+		{
+			name:   "never restarted",
+			policy: RestartNever,
+			exits: []exit{
+				{status: Errored},
+			},
+			wantLastInfo: SupervisedProcessInfo{Id: "run", Status: Errored},
+		},
+		{
+			name:   "restarted on failure",
+			policy: RestartOnFailure,
+			exits: []exit{
+				{status: Errored, wantRestart: true},
+				{status: Errored, wantRestart: true},
+				{status: Stopped},
+			},
+			wantLastInfo: SupervisedProcessInfo{Id: "run", Status: Stopped, Restarts: 2},
+		},
+		{
+			name:   "always restarted",
+			policy: RestartAlways,
+			exits: []exit{
+				{status: Stopped, wantRestart: true},
+				{status: Errored, wantRestart: true},
+			},
+			wantLastInfo: SupervisedProcessInfo{Id: "run", Status: Running, Restarts: 2},
+		},
+		{
+			name:       "maximum number of retries",
+			policy:     RestartOnFailure,
+			maxRetries: 2,
+			exits: []exit{
+				{status: Errored, wantRestart: true},
+				{status: Errored, wantRestart: true},
+				{status: Errored},
+			},
+			wantLastInfo: SupervisedProcessInfo{Id: "run", Status: Errored, Restarts: 2, RetriesExhausted: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var (
+				mu       sync.Mutex
+				lastInfo SupervisedProcessInfo
+			)
+			handler := newFakeProcessHandler()
+			supervisor := NewSupervisor(handler, RestartOptions{
+				Policy:     tt.policy,
+				MaxRetries: tt.maxRetries,
+				NewBackoff: func() Backoff {
+					return &fakeBackoff{}
+				},
+				OnStatusChange: func(info SupervisedProcessInfo) {
+					mu.Lock()
+					defer mu.Unlock()
+					info.NextRestart = 0
+					lastInfo = info
+				},
+			})
+
+			def := CommandDefinition{Id: "run"}
+			err := supervisor.StartProcessForCommand(ctx, def, "pod", "runtime", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			<-handler.started
+
+			for i, e := range tt.exits {
+				handler.exit(e.status)
+				if e.wantRestart {
+					select {
+					case <-handler.started:
+					case <-time.After(5 * time.Second):
+						t.Fatalf("process not restarted after exit %d", i)
+					}
+				}
+			}
+			// Let a restart happen, if any is wrongly planned
+			time.Sleep(50 * time.Millisecond)
+
+			wantStarts := 1
+			for _, e := range tt.exits {
+				if e.wantRestart {
+					wantStarts++
+				}
+			}
+			if got := handler.startCount(); got != wantStarts {
+				t.Errorf("process started %d times, want %d", got, wantStarts)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if diff := cmp.Diff(tt.wantLastInfo, lastInfo); diff != "" {
+				t.Errorf("last status mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSupervisor_StopProcessForCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newFakeProcessHandler()
+	supervisor := NewSupervisor(handler, RestartOptions{
+		Policy: RestartAlways,
+		NewBackoff: func() Backoff {
+			return &fakeBackoff{}
+		},
+	})
+
+	def := CommandDefinition{Id: "run"}
+	err := supervisor.StartProcessForCommand(ctx, def, "pod", "runtime", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-handler.started
+
+	err = supervisor.StopProcessForCommand(ctx, def, "pod", "runtime")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The process killed by the stop exits
+	handler.exit(Errored)
+	time.Sleep(50 * time.Millisecond)
+
+	if got := handler.startCount(); got != 1 {
+		t.Errorf("process started %d times, want 1", got)
+	}
+}
+
+// blockingProcessHandler counts the processes running, and blocks the start of the process
+// of its blockAt-th call until unblock is closed
+type blockingProcessHandler struct {
+	fakeProcessHandler
+	running int
+	calls   int
+	blockAt int
+	entered chan struct{}
+	unblock chan struct{}
+}
+
+func (o *blockingProcessHandler) StartProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string, outputHandler CommandOutputHandler) error {
+	o.mu.Lock()
+	o.calls++
+	call := o.calls
+	o.mu.Unlock()
+	if call == o.blockAt {
+		close(o.entered)
+		<-o.unblock
+	}
+	o.mu.Lock()
+	o.running++
+	o.mu.Unlock()
+	return o.fakeProcessHandler.StartProcessForCommand(ctx, def, podName, containerName, outputHandler)
+}
+
+func (o *blockingProcessHandler) StopProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.running = 0
+	return nil
+}
+
+func (o *blockingProcessHandler) exit(status RemoteProcessStatus) {
+	o.mu.Lock()
+	o.running--
+	o.mu.Unlock()
+	o.fakeProcessHandler.exit(status)
+}
+
+func (o *blockingProcessHandler) runningCount() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.running
+}
+
+func TestSupervisor_restartDuringCallerRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := &blockingProcessHandler{
+		fakeProcessHandler: *newFakeProcessHandler(),
+		// the first restart by the supervisor
+		blockAt: 2,
+		entered: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	supervisor := NewSupervisor(handler, RestartOptions{
+		Policy: RestartAlways,
+		NewBackoff: func() Backoff {
+			return &fakeBackoff{}
+		},
+	})
+
+	def := CommandDefinition{Id: "run"}
+	err := supervisor.StartProcessForCommand(ctx, def, "pod", "runtime", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-handler.started
+
+	handler.exit(Errored)
+	// The supervisor is restarting the process
+	<-handler.entered
+
+	// The caller restarts the process meanwhile
+	done := make(chan error)
+	go func() {
+		if err := supervisor.StopProcessForCommand(ctx, def, "pod", "runtime"); err != nil {
+			done <- err
+			return
+		}
+		done <- supervisor.StartProcessForCommand(ctx, def, "pod", "runtime", nil)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(handler.unblock)
+	if err = <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if got := handler.runningCount(); got != 1 {
+		t.Errorf("%d processes running, want 1", got)
+	}
+}
+
+func TestParseRestartPolicy(t *testing.T) {
+	for _, s := range []string{"never", "on-failure", "always"} {
+		p, err := ParseRestartPolicy(s)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", s, err)
+		}
+		if string(p) != s {
+			t.Errorf("ParseRestartPolicy(%q) = %q", s, p)
+		}
+	}
+	if _, err := ParseRestartPolicy("sometimes"); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+}
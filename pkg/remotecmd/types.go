@@ -1,5 +1,7 @@
 package remotecmd
 
+import "time"
+
 // RemoteProcessStatus is an enum type for representing process statuses.
 type RemoteProcessStatus string
 
@@ -23,6 +25,12 @@ const (
 const (
 	// ShellExecutable is the shell executable
 	ShellExecutable = "/bin/sh"
+
+	// DefaultStopSignal is the signal sent to the processes of a command to stop them gracefully
+	DefaultStopSignal = "TERM"
+
+	// DefaultStopGracePeriod is the duration given to the processes of a command to exit after receiving the stop signal
+	DefaultStopGracePeriod = 10 * time.Second
 )
 
 // RemoteProcessInfo represents a given remote process linked to a given Devfile command
@@ -51,6 +59,14 @@ type CommandDefinition struct {
 
 	// CmdLine is the command-line that will get executed.
 	CmdLine string
+
+	// StopSignal is the name of the signal sent to the processes of the command to stop them gracefully.
+	// DefaultStopSignal is used if empty.
+	StopSignal string
+
+	// StopGracePeriod is the duration given to the processes of the command to exit after receiving StopSignal,
+	// before they are killed. DefaultStopGracePeriod is used if zero.
+	StopGracePeriod time.Duration
 }
 
 // CommandEnvVar represents an environment variable used as part of running any CommandDefinition.
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/podman"
@@ -123,6 +124,8 @@ func SetPlatform(ctx context.Context, client platform.Client) {
 	switch client := client.(type) {
 	case kclient.ClientInterface:
 		setPlatformCluster(ctx, client)
+	case *docker.DockerCli:
+		setPlatformDocker(ctx, client)
 	case podman.Client:
 		setPlatformPodman(ctx, client)
 	}
@@ -173,6 +176,16 @@ func setPlatformPodman(ctx context.Context, client podman.Client) {
 	setContextProperty(ctx, PlatformVersion, version.Client.Version)
 }
 
+func setPlatformDocker(ctx context.Context, client *docker.DockerCli) {
+	setContextProperty(ctx, Platform, "docker")
+	version, err := client.Version(ctx)
+	if err != nil {
+		klog.V(3).Info(fmt.Errorf("unable to get docker version: %w", err))
+		return
+	}
+	setContextProperty(ctx, PlatformVersion, version.Client.Version)
+}
+
 // SetPreviousTelemetryStatus sets telemetry status before a command is run
 func SetPreviousTelemetryStatus(ctx context.Context, isEnabled bool) {
 	setContextProperty(ctx, PreviousTelemetryStatus, isEnabled)
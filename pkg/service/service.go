@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 
@@ -146,7 +147,8 @@ func SplitServiceKindName(serviceName string) (string, string, error) {
 }
 
 // PushKubernetesResources updates service(s) from Kubernetes Inlined component in a devfile by creating new ones or removing old ones
-func PushKubernetesResources(client kclient.ClientInterface, devfileObj parser.DevfileObj, k8sComponents []devfile.Component, labels map[string]string, annotations map[string]string, context, mode string, reference metav1.OwnerReference) error {
+// The pull policy of the containers using one of the loadedImages is set to IfNotPresent
+func PushKubernetesResources(client kclient.ClientInterface, devfileObj parser.DevfileObj, k8sComponents []devfile.Component, labels map[string]string, annotations map[string]string, context, mode string, reference metav1.OwnerReference, loadedImages []string) error {
 	// check csv support before proceeding
 	csvSupported, err := client.IsCSVSupported()
 	if err != nil {
@@ -187,6 +189,7 @@ func PushKubernetesResources(client kclient.ClientInterface, devfileObj parser.D
 				currentOwnerReferences = append(currentOwnerReferences, reference)
 				u.SetOwnerReferences(currentOwnerReferences)
 			}
+			image.SetPullPolicy(&u, loadedImages)
 			er = PushKubernetesResource(client, u, labels, annotations, mode)
 			if er != nil {
 				return er
@@ -228,16 +231,17 @@ func PushKubernetesResource(client kclient.ClientInterface, u unstructured.Unstr
 	}
 
 	// Add all passed in labels to the k8s resource regardless if it's an operator or not
-	u.SetLabels(mergeMaps(u.GetLabels(), labels))
+	u.SetLabels(MergeMaps(u.GetLabels(), labels))
 
 	// Pass in all annotations to the k8s resource
-	u.SetAnnotations(mergeMaps(u.GetAnnotations(), annotations))
+	u.SetAnnotations(MergeMaps(u.GetAnnotations(), annotations))
 
 	_, err = updateOperatorService(client, u)
 	return err
 }
 
-func mergeMaps(maps ...map[string]string) map[string]string {
+// MergeMaps returns a new map containing the entries of all the maps, the entries of the last maps overriding the ones of the first maps
+func MergeMaps(maps ...map[string]string) map[string]string {
 	mergedMaps := map[string]string{}
 
 	for _, l := range maps {
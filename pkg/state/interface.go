@@ -26,4 +26,30 @@ type Client interface {
 	GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, error)
 
 	GetOrphanFiles(ctx context.Context) ([]string, error)
+
+	// SetLogFile sets the file containing the output of the session running in the background, and saves it to the file, updating the metadata
+	SetLogFile(ctx context.Context, logFile string) error
+
+	// GetRunningSessions returns the odo dev sessions running in the directory, ordered by platform, possibly filtered by the platform in context
+	GetRunningSessions(ctx context.Context) ([]api.DevSession, error)
+
+	// SetCommandStatus sets the status of a run or debug command in the state file and saves it to the file, updating the metadata
+	SetCommandStatus(ctx context.Context, status api.DevCommandStatus) error
+
+	// GetCommandStatuses returns the statuses of the run or debug commands executed by the odo dev sessions, possibly per platform
+	GetCommandStatuses(ctx context.Context) ([]api.DevCommandStatus, error)
+
+	// ForComponent returns a client saving the ports forwarded and the statuses of the commands of the component
+	// into the same state file, along with the ones of the other components of the session
+	ForComponent(componentName string) ComponentClient
+}
+
+// ComponentClient saves the state of a component into the state file of the session.
+// Client is also a ComponentClient, for a session developing a single component.
+type ComponentClient interface {
+	// SetForwardedPorts sets the ports forwarded for the component in the state file and saves it to the file, updating the metadata
+	SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPort) error
+
+	// SetCommandStatus sets the status of a run or debug command of the component in the state file and saves it to the file, updating the metadata
+	SetCommandStatus(ctx context.Context, status api.DevCommandStatus) error
 }
@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"sync"
 
 	"github.com/mitchellh/go-ps"
 	"k8s.io/klog"
@@ -26,10 +28,25 @@ type State struct {
 	content Content
 	fs      filesystem.Filesystem
 	system  system.System
+
+	// mu protects the content, which can be modified concurrently by the components of a same session
+	mu sync.Mutex
+	// componentsForwardedPorts are the ports forwarded for each component of the session, indexed by component name.
+	// The ports forwarded by a session developing a single component are indexed by the empty string.
+	componentsForwardedPorts map[string][]api.ForwardedPort
 }
 
 var _ Client = (*State)(nil)
 
+// componentState is a state client saving the forwarded ports and the statuses of the commands of a specific component
+// into the state of the session
+type componentState struct {
+	session       *State
+	componentName string
+}
+
+var _ ComponentClient = (*componentState)(nil)
+
 func NewStateClient(fs filesystem.Filesystem, system system.System) *State {
 	return &State{
 		fs:     fs,
@@ -42,6 +59,8 @@ func (o *State) Init(ctx context.Context) error {
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	o.content.PID = pid
 	o.content.Platform = platform
 	return o.save(ctx, pid)
@@ -49,12 +68,55 @@ func (o *State) Init(ctx context.Context) error {
 }
 
 func (o *State) SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPort) error {
+	return o.setComponentForwardedPorts(ctx, "", fwPorts)
+}
+
+func (o *componentState) SetForwardedPorts(ctx context.Context, fwPorts []api.ForwardedPort) error {
+	return o.session.setComponentForwardedPorts(ctx, o.componentName, fwPorts)
+}
+
+func (o *componentState) SetCommandStatus(ctx context.Context, status api.DevCommandStatus) error {
+	status.Component = o.componentName
+	return o.session.SetCommandStatus(ctx, status)
+}
+
+func (o *State) ForComponent(componentName string) ComponentClient {
+	return &componentState{
+		session:       o,
+		componentName: componentName,
+	}
+}
+
+// setComponentForwardedPorts sets the ports forwarded for the component, and saves the ports forwarded for all the components
+// into the state file, ordered by component name
+func (o *State) setComponentForwardedPorts(ctx context.Context, componentName string, fwPorts []api.ForwardedPort) error {
 	var (
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.componentsForwardedPorts == nil {
+		o.componentsForwardedPorts = make(map[string][]api.ForwardedPort)
+	}
+	o.componentsForwardedPorts[componentName] = fwPorts
+
+	componentNames := make([]string, 0, len(o.componentsForwardedPorts))
+	for name := range o.componentsForwardedPorts {
+		componentNames = append(componentNames, name)
+	}
+	sort.Strings(componentNames)
+	var allPorts []api.ForwardedPort
+	for _, name := range componentNames {
+		allPorts = append(allPorts, o.componentsForwardedPorts[name]...)
+	}
+	// an empty list of forwarded ports is not persisted the same way as a missing one
+	if allPorts == nil && fwPorts != nil {
+		allPorts = []api.ForwardedPort{}
+	}
+
 	// TODO(feloy) When other data is persisted into the state file, it will be needed to read the file first
-	o.content.ForwardedPorts = fwPorts
+	o.content.ForwardedPorts = allPorts
 	o.content.PID = pid
 	o.content.Platform = platform
 	return o.save(ctx, pid)
@@ -67,7 +129,7 @@ func (o *State) GetForwardedPorts(ctx context.Context) ([]api.ForwardedPort, err
 		platform  = fcontext.GetPlatform(ctx, "")
 	)
 	if platform == "" {
-		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman}
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
 	} else {
 		platforms = []string{platform}
 	}
@@ -89,10 +151,15 @@ func (o *State) SaveExit(ctx context.Context) error {
 	var (
 		pid = odocontext.GetPID(ctx)
 	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.componentsForwardedPorts = nil
 	o.content.ForwardedPorts = nil
 	o.content.PID = 0
 	o.content.Platform = ""
 	o.content.APIServerPort = 0
+	o.content.LogFile = ""
+	o.content.Commands = nil
 	err := o.delete(pid)
 	if err != nil {
 		return err
@@ -105,7 +172,8 @@ func (o *State) SetAPIServerPort(ctx context.Context, port int) error {
 		pid      = odocontext.GetPID(ctx)
 		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
-
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	o.content.APIServerPort = port
 	o.content.Platform = platform
 	return o.save(ctx, pid)
@@ -118,7 +186,7 @@ func (o *State) GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, e
 		platform  = fcontext.GetPlatform(ctx, "")
 	)
 	if platform == "" {
-		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman}
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
 	} else {
 		platforms = []string{platform}
 	}
@@ -147,6 +215,155 @@ func (o *State) GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, e
 	return result, nil
 }
 
+func (o *State) SetLogFile(ctx context.Context, logFile string) error {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.content.LogFile = logFile
+	o.content.Platform = platform
+	return o.save(ctx, pid)
+}
+
+// SetCommandStatus replaces the status of the command with the same component and name, and saves the statuses
+// of all the commands into the state file, ordered by component and name
+func (o *State) SetCommandStatus(ctx context.Context, status api.DevCommandStatus) error {
+	var (
+		pid      = odocontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+	status.Platform = ""
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	commands := make([]api.DevCommandStatus, 0, len(o.content.Commands)+1)
+	for _, command := range o.content.Commands {
+		if command.Component == status.Component && command.Name == status.Name {
+			continue
+		}
+		commands = append(commands, command)
+	}
+	commands = append(commands, status)
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].Component != commands[j].Component {
+			return commands[i].Component < commands[j].Component
+		}
+		return commands[i].Name < commands[j].Name
+	})
+	o.content.Commands = commands
+	o.content.Platform = platform
+	return o.save(ctx, pid)
+}
+
+func (o *State) GetCommandStatuses(ctx context.Context) ([]api.DevCommandStatus, error) {
+	var (
+		result    []api.DevCommandStatus
+		platforms []string
+		platform  = fcontext.GetPlatform(ctx, "")
+	)
+	if platform == "" {
+		platforms = []string{commonflags.PlatformCluster, commonflags.PlatformPodman, commonflags.PlatformDocker}
+	} else {
+		platforms = []string{platform}
+	}
+
+	for _, platform = range platforms {
+		content, err := o.read(platform)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // if the state file does not exist, no commands are executed
+			}
+			return nil, err
+		}
+		for _, command := range content.Commands {
+			command.Platform = platform
+			result = append(result, command)
+		}
+	}
+	return result, nil
+}
+
+func (o *State) GetRunningSessions(ctx context.Context) ([]api.DevSession, error) {
+	var (
+		result   []api.DevSession
+		platform = fcontext.GetPlatform(ctx, "")
+	)
+
+	re := regexp.MustCompile(`^devstate\.[0-9]+\.json$`)
+	entries, err := o.fs.ReadDir(_dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// No file found => no session
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		jsonContent, err := o.fs.ReadFile(filepath.Join(_dirpath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+
+		if content.PID == 0 || content.Platform == "" {
+			continue
+		}
+		if platform != "" && content.Platform != platform {
+			continue
+		}
+		running, err := o.isOdoRunning(content.PID)
+		if err != nil {
+			return nil, err
+		}
+		if !running {
+			klog.V(4).Infof("process %d is not running odo, ignoring", content.PID)
+			continue
+		}
+		result = append(result, api.DevSession{
+			PID:            content.PID,
+			Platform:       content.Platform,
+			APIServerPort:  content.APIServerPort,
+			ForwardedPorts: content.ForwardedPorts,
+			LogFile:        content.LogFile,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Platform != result[j].Platform {
+			return result[i].Platform < result[j].Platform
+		}
+		return result[i].PID < result[j].PID
+	})
+	return result, nil
+}
+
+// GetLogFilename returns the file in which the output of an odo dev session running in the background on platform is written
+func GetLogFilename(platform string) string {
+	return fmt.Sprintf(_logFilepath, platform)
+}
+
+// isOdoRunning returns true if the process pid exists and is an odo process
+func (o *State) isOdoRunning(pid int) (bool, error) {
+	exists, err := o.system.PidExists(pid)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+	process, err := o.system.FindProcess(pid)
+	if err != nil || process == nil {
+		klog.V(4).Infof("process %d exists but is not accessible", pid)
+		return false, nil
+	}
+	return process.Executable() == "odo" || process.Executable() == "odo.exe", nil
+}
+
 // save writes the content structure in json format in file
 func (o *State) save(ctx context.Context, pid int) error {
 
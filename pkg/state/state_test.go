@@ -9,8 +9,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/testingutil/system"
 )
 
 func TestState_SetForwardedPorts(t *testing.T) {
@@ -91,6 +93,136 @@ func TestState_SetForwardedPorts(t *testing.T) {
 	}
 }
 
+func TestState_ForComponent(t *testing.T) {
+	frontendPort := api.ForwardedPort{
+		ContainerName: "runtime",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20001,
+		ContainerPort: 3000,
+	}
+	backendPort := api.ForwardedPort{
+		ContainerName: "runtime",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20002,
+		ContainerPort: 8080,
+	}
+	newBackendPort := api.ForwardedPort{
+		ContainerName: "runtime",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20003,
+		ContainerPort: 8081,
+	}
+
+	tests := []struct {
+		name string
+		// setPorts sets the ports forwarded for the components of the session
+		setPorts func(ctx context.Context, o *State) error
+		want     []api.ForwardedPort
+	}{
+		{
+			name: "ports of all components are saved, ordered by component name",
+			setPorts: func(ctx context.Context, o *State) error {
+				if err := o.ForComponent("frontend").SetForwardedPorts(ctx, []api.ForwardedPort{frontendPort}); err != nil {
+					return err
+				}
+				return o.ForComponent("backend").SetForwardedPorts(ctx, []api.ForwardedPort{backendPort})
+			},
+			want: []api.ForwardedPort{backendPort, frontendPort},
+		},
+		{
+			name: "ports of a component are replaced",
+			setPorts: func(ctx context.Context, o *State) error {
+				backend := o.ForComponent("backend")
+				if err := backend.SetForwardedPorts(ctx, []api.ForwardedPort{backendPort}); err != nil {
+					return err
+				}
+				if err := o.ForComponent("frontend").SetForwardedPorts(ctx, []api.ForwardedPort{frontendPort}); err != nil {
+					return err
+				}
+				return backend.SetForwardedPorts(ctx, []api.ForwardedPort{newBackendPort})
+			},
+			want: []api.ForwardedPort{newBackendPort, frontendPort},
+		},
+		{
+			name: "ports of a component are removed",
+			setPorts: func(ctx context.Context, o *State) error {
+				if err := o.ForComponent("frontend").SetForwardedPorts(ctx, []api.ForwardedPort{frontendPort}); err != nil {
+					return err
+				}
+				backend := o.ForComponent("backend")
+				if err := backend.SetForwardedPorts(ctx, []api.ForwardedPort{backendPort}); err != nil {
+					return err
+				}
+				return backend.SetForwardedPorts(ctx, nil)
+			},
+			want: []api.ForwardedPort{frontendPort},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			o := &State{
+				fs: fs,
+			}
+			ctx := context.Background()
+			ctx = odocontext.WithPID(ctx, 1)
+			if err := tt.setPorts(ctx, o); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			jsonContent, err := fs.ReadFile(_filepath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var content Content
+			err = json.Unmarshal(jsonContent, &content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, content.ForwardedPorts); diff != "" {
+				t.Errorf("State.ForComponent() forwarded ports mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestState_SetCommandStatus(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	o := &State{
+		fs: fs,
+	}
+	ctx := context.Background()
+	ctx = odocontext.WithPID(ctx, 1)
+	if err := o.Init(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	statuses := []struct {
+		client ComponentClient
+		status api.DevCommandStatus
+	}{
+		{client: o.ForComponent("frontend"), status: api.DevCommandStatus{Name: "run", Status: "running"}},
+		{client: o.ForComponent("backend"), status: api.DevCommandStatus{Name: "run", Status: "running"}},
+		{client: o.ForComponent("frontend"), status: api.DevCommandStatus{Name: "run", Status: "errored", Restarts: 1}},
+	}
+	for _, s := range statuses {
+		if err := s.client.SetCommandStatus(ctx, s.status); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	got, err := o.GetCommandStatuses(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []api.DevCommandStatus{
+		{Platform: "cluster", Component: "backend", Name: "run", Status: "running"},
+		{Platform: "cluster", Component: "frontend", Name: "run", Status: "errored", Restarts: 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("State.GetCommandStatuses() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestState_SaveExit(t *testing.T) {
 	type fields struct {
 		fs                  func() filesystem.Filesystem
@@ -281,3 +413,71 @@ func TestState_GetForwardedPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestState_GetRunningSessions(t *testing.T) {
+	contents := map[int]Content{
+		// a session running in the background on the cluster
+		1: {PID: 1, Platform: "cluster", APIServerPort: 20000, LogFile: GetLogFilename("cluster")},
+		// a session running in the foreground on podman
+		2: {PID: 2, Platform: "podman", APIServerPort: 20010},
+		// a session whose process does not exist anymore
+		3: {PID: 3, Platform: "podman"},
+		// a session whose process is not odo anymore
+		4: {PID: 4, Platform: "cluster"},
+	}
+	sys := system.Fake{
+		PidTable: map[int]string{
+			1: "odo",
+			2: "odo.exe",
+			4: "bash",
+		},
+	}
+	session1 := api.DevSession{PID: 1, Platform: "cluster", APIServerPort: 20000, LogFile: "./.odo/devsession.cluster.log"}
+	session2 := api.DevSession{PID: 2, Platform: "podman", APIServerPort: 20010}
+
+	tests := []struct {
+		name     string
+		platform string
+		want     []api.DevSession
+	}{
+		{
+			name: "all platforms",
+			want: []api.DevSession{session1, session2},
+		},
+		{
+			name:     "podman only",
+			platform: "podman",
+			want:     []api.DevSession{session2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			for pid, content := range contents {
+				jsonContent, err := json.Marshal(content)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = fs.WriteFile(getFilename(pid), jsonContent, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			o := &State{
+				fs:     fs,
+				system: sys,
+			}
+			ctx := context.Background()
+			if tt.platform != "" {
+				ctx = fcontext.WithPlatform(ctx, tt.platform)
+			}
+			got, err := o.GetRunningSessions(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("State.GetRunningSessions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -12,4 +12,8 @@ type Content struct {
 	// ForwardedPorts are the ports forwarded during odo dev session
 	ForwardedPorts []api.ForwardedPort `json:"forwardedPorts"`
 	APIServerPort  int                 `json:"apiServerPort,omitempty"`
+	// Commands are the statuses of the run or debug commands executed during odo dev session
+	Commands []api.DevCommandStatus `json:"commands,omitempty"`
+	// LogFile is the file containing the output of the session, when the session runs in the background
+	LogFile string `json:"logFile,omitempty"`
 }
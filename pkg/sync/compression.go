@@ -0,0 +1,103 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"k8s.io/klog"
+)
+
+// Compression is the codec used to compress the archives streamed to the container
+type Compression string
+
+const (
+	// CompressionUnknown indicates that the codecs supported by the container have not been detected yet
+	CompressionUnknown Compression = ""
+	// CompressionNone indicates that archives are sent uncompressed
+	CompressionNone Compression = "none"
+	// CompressionGzip indicates that archives are compressed with gzip
+	CompressionGzip Compression = "gzip"
+	// CompressionZstd indicates that archives are compressed with zstd
+	CompressionZstd Compression = "zstd"
+)
+
+// compressionsByPreference lists the codecs that can be used, from the most to the least preferred one
+var compressionsByPreference = []Compression{CompressionZstd, CompressionGzip}
+
+// getCmdToDetectCompression returns the command used to list the decompression tools available in the container
+func getCmdToDetectCompression() []string {
+	return []string{"sh", "-c", "for c in zstd gzip; do command -v $c >/dev/null 2>&1 && echo $c; done; true"}
+}
+
+// getCmdToExtract returns the command used to extract an archive compressed with compression, read from stdin, into targetPath
+func getCmdToExtract(targetPath string, compression Compression) []string {
+	switch compression {
+	case CompressionGzip:
+		return []string{"sh", "-c", `gzip -dc | tar xf - -C "$1" --no-same-owner`, "sh", targetPath}
+	case CompressionZstd:
+		return []string{"sh", "-c", `zstd -dcq | tar xf - -C "$1" --no-same-owner`, "sh", targetPath}
+	default:
+		return []string{"tar", "xf", "-", "-C", targetPath, "--no-same-owner"}
+	}
+}
+
+// parseDetectedCompressions returns the most preferred compression listed in the output of the command returned by getCmdToDetectCompression
+func parseDetectedCompressions(output []byte) Compression {
+	available := make(map[Compression]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		available[Compression(strings.TrimSpace(scanner.Text()))] = true
+	}
+	for _, compression := range compressionsByPreference {
+		if available[compression] {
+			return compression
+		}
+	}
+	return CompressionNone
+}
+
+// GetSupportedCompression probes the container for the decompression tools it provides,
+// and returns the preferred compression to use when streaming archives to this container.
+// CompressionNone is returned if no tool is found or if the detection fails.
+func (a SyncClient) GetSupportedCompression(ctx context.Context, compInfo ComponentInfo) Compression {
+	var stdout, stderr bytes.Buffer
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToDetectCompression(), &stdout, &stderr, nil, false)
+	if err != nil {
+		klog.V(2).Infof("unable to detect compression tools in container %q, archives will not be compressed: %v", compInfo.ContainerName, err)
+		return CompressionNone
+	}
+	compression := parseDetectedCompressions(stdout.Bytes())
+	klog.V(4).Infof("compression used to sync files to container %q: %s", compInfo.ContainerName, compression)
+	return compression
+}
+
+// newCompressionWriter returns a writer compressing with compression the data written to writer.
+// The returned writer must be closed to flush the compressed data.
+func newCompressionWriter(writer io.Writer, compression Compression) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewWriterLevel(writer, gzip.BestSpeed)
+	case CompressionZstd:
+		return zstd.NewWriter(writer, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	case CompressionNone, CompressionUnknown:
+		return nopWriteCloser{writer}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// nopWriteCloser is a writer with a no-op Close method
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,168 @@
+package sync
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+func TestSyncClient_GetSupportedCompression(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		execErr error
+		want    Compression
+	}{
+		{
+			name:   "zstd and gzip available",
+			output: "zstd\ngzip\n",
+			want:   CompressionZstd,
+		},
+		{
+			name:   "only gzip available",
+			output: "gzip\n",
+			want:   CompressionGzip,
+		},
+		{
+			name:   "no tool available",
+			output: "",
+			want:   CompressionNone,
+		},
+		{
+			name:    "detection fails",
+			execErr: errors.New("sh: not found"),
+			want:    CompressionNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "a-pod", getCmdToDetectCompression(), gomock.Any(), gomock.Any(), nil, false).
+				DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+					_, _ = stdout.Write([]byte(tt.output))
+					return tt.execErr
+				})
+
+			syncClient := NewSyncClient(platformClient, nil)
+			got := syncClient.GetSupportedCompression(context.Background(), ComponentInfo{PodName: "a-pod", ContainerName: "runtime"})
+			if got != tt.want {
+				t.Errorf("GetSupportedCompression() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getCmdToExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression Compression
+		want        []string
+	}{
+		{
+			name:        "no compression",
+			compression: CompressionNone,
+			want:        []string{"tar", "xf", "-", "-C", "/projects", "--no-same-owner"},
+		},
+		{
+			name:        "unknown compression",
+			compression: CompressionUnknown,
+			want:        []string{"tar", "xf", "-", "-C", "/projects", "--no-same-owner"},
+		},
+		{
+			name:        "gzip",
+			compression: CompressionGzip,
+			want:        []string{"sh", "-c", `gzip -dc | tar xf - -C "$1" --no-same-owner`, "sh", "/projects"},
+		},
+		{
+			name:        "zstd",
+			compression: CompressionZstd,
+			want:        []string{"sh", "-c", `zstd -dcq | tar xf - -C "$1" --no-same-owner`, "sh", "/projects"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getCmdToExtract("/projects", tt.compression)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getCmdToExtract() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_newCompressionWriter(t *testing.T) {
+	content := bytes.Repeat([]byte("some content to compress\n"), 1000)
+
+	tests := []struct {
+		name        string
+		compression Compression
+		decompress  func(io.Reader) (io.Reader, error)
+		wantErr     bool
+	}{
+		{
+			name:        "no compression",
+			compression: CompressionNone,
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return r, nil
+			},
+		},
+		{
+			name:        "gzip",
+			compression: CompressionGzip,
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			name:        "zstd",
+			compression: CompressionZstd,
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return zstd.NewReader(r)
+			},
+		},
+		{
+			name:        "unsupported compression",
+			compression: "lz4",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer, err := newCompressionWriter(&buf, tt.compression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newCompressionWriter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if _, err = writer.Write(content); err != nil {
+				t.Fatal(err)
+			}
+			if err = writer.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			reader, err := tt.decompress(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(content, got) {
+				t.Errorf("decompressed content differs from original content")
+			}
+		})
+	}
+}
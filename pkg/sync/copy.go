@@ -26,7 +26,8 @@ import (
 // During copying binary components, localPath represent base directory path to binary and copyFiles contains path of binary
 // During copying local source components, localPath represent base directory path whereas copyFiles is empty
 // During `odo watch`, localPath represent base directory path whereas copyFiles contains list of changed Files
-func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet) error {
+// The archive is compressed with compression while being streamed to the container.
+func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet, compression Compression) error {
 
 	// Destination is set to "ToSlash" as all containers being ran within OpenShift / S2I are all
 	// Linux based and thus: "\opt\app-root\src" would not work correctly.
@@ -39,14 +40,24 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	go func() {
 		defer writer.Close()
 
-		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
+		compressionWriter, err := newCompressionWriter(writer, compression)
+		if err != nil {
+			log.Errorf("Error while compressing tar: %#v", err)
+			os.Exit(1)
+		}
+		err = makeTar(localPath, dest, compressionWriter, copyFiles, globExps, ret, filesystem.DefaultFs{})
 		if err != nil {
 			log.Errorf("Error while creating tar: %#v", err)
 			os.Exit(1)
 		}
+		err = compressionWriter.Close()
+		if err != nil {
+			log.Errorf("Error while compressing tar: %#v", err)
+			os.Exit(1)
+		}
 	}()
 
-	err := a.ExtractProjectToComponent(ctx, compInfo.ContainerName, compInfo.PodName, targetPath, reader)
+	err := a.ExtractProjectToComponent(ctx, compInfo.ContainerName, compInfo.PodName, targetPath, reader, compression)
 	if err != nil {
 		return err
 	}
@@ -54,10 +65,10 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	return nil
 }
 
-// ExtractProjectToComponent extracts the project archive(tar) to the target path from the reader stdin
-func (a SyncClient) ExtractProjectToComponent(ctx context.Context, containerName, podName, targetPath string, stdin io.Reader) error {
+// ExtractProjectToComponent extracts the project archive(tar), compressed with compression, to the target path from the reader stdin
+func (a SyncClient) ExtractProjectToComponent(ctx context.Context, containerName, podName, targetPath string, stdin io.Reader, compression Compression) error {
 	// cmdArr will run inside container
-	cmdArr := []string{"tar", "xf", "-", "-C", targetPath, "--no-same-owner"}
+	cmdArr := getCmdToExtract(targetPath, compression)
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
@@ -83,7 +94,6 @@ func checkFileExistWithFS(fileName string, fs filesystem.Filesystem) bool {
 // makeTar function is copied from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L309
 // srcPath is ignored if files is set
 func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, ret util.IndexerRet, fs filesystem.Filesystem) error {
-	// TODO: use compression here?
 	tarWriter := taro.NewWriter(writer)
 	defer tarWriter.Close()
 	srcPath = filepath.Clean(srcPath)
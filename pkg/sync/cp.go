@@ -0,0 +1,137 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// CopyParameters is a struct containing the parameters to be used when copying a file or directory between the local machine and a container
+type CopyParameters struct {
+	LocalPath  string // LocalPath is the path of the file or directory on the local machine
+	RemotePath string // RemotePath is the path of the file or directory in the container. A relative path is relative to the sync folder of the component
+	CompInfo   ComponentInfo
+}
+
+// remotePath returns the absolute path of the file or directory in the container
+func (o CopyParameters) remotePath() string {
+	remotePath := filepath.ToSlash(o.RemotePath)
+	if path.IsAbs(remotePath) {
+		return path.Clean(remotePath)
+	}
+	return path.Join(o.CompInfo.SyncFolder, remotePath)
+}
+
+// getCmdToTestRemoteDirectory returns the command used to check that remotePath is an existing directory in the container
+func getCmdToTestRemoteDirectory(remotePath string) []string {
+	return []string{"test", "-d", remotePath}
+}
+
+// CopyToContainer copies the local file or directory into the container.
+// If the remote path is an existing directory, the file or directory is copied into it,
+// otherwise it is copied at the remote path, the parent directories being created if necessary.
+func (a SyncClient) CopyToContainer(ctx context.Context, copyParameters CopyParameters) error {
+	compInfo := copyParameters.CompInfo
+	localPath := filepath.Clean(copyParameters.LocalPath)
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	remotePath := copyParameters.remotePath()
+	targetDir, name := path.Dir(remotePath), path.Base(remotePath)
+	if a.isRemoteDirectory(ctx, compInfo, remotePath) {
+		targetDir, name = remotePath, filepath.Base(localPath)
+	}
+
+	var (
+		srcPath string
+		files   []string
+		ret     util.IndexerRet
+	)
+	if stat.IsDir() {
+		// The content of the directory is extracted into a directory with the expected name
+		srcPath = localPath
+		targetDir = path.Join(targetDir, name)
+		err = filepath.Walk(localPath, func(file string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if file != localPath {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		// The file is renamed in the archive
+		srcPath = filepath.Dir(localPath)
+		files = []string{localPath}
+		ret.NewFileMap = map[string]util.FileData{
+			filepath.Base(localPath): {RemoteAttribute: name},
+		}
+	}
+
+	var stderr strings.Builder
+	err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToCreateSyncFolder(targetDir), io.Discard, &stderr, nil, false)
+	if err != nil {
+		return fmt.Errorf("unable to create directory %q in container %q: %w: %s", targetDir, compInfo.ContainerName, err, stderr.String())
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	klog.V(4).Infof("Copying %s to %s in container %q", localPath, path.Join(targetDir, name), compInfo.ContainerName)
+	return a.CopyFile(ctx, srcPath, compInfo, targetDir, files, nil, ret, a.GetSupportedCompression(ctx, compInfo))
+}
+
+// CopyFromContainer copies the file or directory of the container to the local machine, and returns the list of copied files.
+// If the local path is an existing directory, the file or directory is copied into it,
+// otherwise it is copied at the local path, the parent directories being created if necessary.
+func (a SyncClient) CopyFromContainer(ctx context.Context, copyParameters CopyParameters) ([]string, error) {
+	compInfo := copyParameters.CompInfo
+	localPath := filepath.Clean(copyParameters.LocalPath)
+	remotePath := copyParameters.remotePath()
+	if remotePath == "/" {
+		return nil, fmt.Errorf("copying the root directory of container %q is not supported", compInfo.ContainerName)
+	}
+
+	remoteName := path.Base(remotePath)
+	destination, localName := filepath.Dir(localPath), filepath.Base(localPath)
+	if stat, err := os.Stat(localPath); err == nil && stat.IsDir() {
+		destination, localName = localPath, remoteName
+	}
+
+	klog.V(4).Infof("Copying %s from container %q to %s", remotePath, compInfo.ContainerName, filepath.Join(destination, localName))
+	reader, writer := io.Pipe()
+	go func() {
+		var stderr strings.Builder
+		err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToArchiveRemoteFiles(path.Dir(remotePath), []string{remoteName}), writer, &stderr, nil, false)
+		if err != nil {
+			err = fmt.Errorf("%w: %s", err, stderr.String())
+		}
+		writer.CloseWithError(err)
+	}()
+	files, err := extractTarAs(reader, destination, remoteName, localName)
+	_ = reader.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy %q from container %q: %w", remotePath, compInfo.ContainerName, err)
+	}
+	return files, nil
+}
+
+// isRemoteDirectory returns true if remotePath is an existing directory in the container
+func (a SyncClient) isRemoteDirectory(ctx context.Context, compInfo ComponentInfo, remotePath string) bool {
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToTestRemoteDirectory(remotePath), io.Discard, io.Discard, nil, false)
+	return err == nil
+}
@@ -0,0 +1,239 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// fakeContainer simulates the execution of the copy commands in a container
+type fakeContainer struct {
+	// directories are the existing directories of the container
+	directories map[string]bool
+	// files is the content of the files of the container, indexed by absolute path
+	files map[string]string
+}
+
+func (o *fakeContainer) exec(_ context.Context, _, _ string, cmd []string, stdout, _ io.Writer, stdin io.Reader, _ bool) error {
+	switch {
+	case cmd[0] == "test":
+		if !o.directories[cmd[2]] {
+			return errors.New("exit status 1")
+		}
+		return nil
+	case cmd[0] == "mkdir":
+		o.directories[cmd[2]] = true
+		return nil
+	case cmd[0] == "sh":
+		// no compression tool available
+		return nil
+	case cmd[0] == "tar" && cmd[1] == "xf":
+		tr := taro.NewReader(stdin)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			o.files[cmd[4]+"/"+header.Name] = string(content)
+		}
+	case cmd[0] == "tar" && cmd[1] == "cf":
+		tw := taro.NewWriter(stdout)
+		prefix := cmd[4] + "/"
+		var names []string
+		for file := range o.files {
+			names = append(names, file)
+		}
+		sort.Strings(names)
+		for _, file := range names {
+			name := strings.TrimPrefix(file, prefix)
+			if name != cmd[5] && !strings.HasPrefix(name, cmd[5]+"/") {
+				continue
+			}
+			content := o.files[file]
+			if err := tw.WriteHeader(&taro.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: taro.TypeReg}); err != nil {
+				return err
+			}
+			if _, err := tw.Write([]byte(content)); err != nil {
+				return err
+			}
+		}
+		return tw.Close()
+	}
+	return fmt.Errorf("unexpected command %v", cmd)
+}
+
+func TestSyncClient_CopyToContainer(t *testing.T) {
+	localDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(localDir, "data", "sub"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	for file, content := range map[string]string{"data/a.txt": "a", "data/sub/b.txt": "b"} {
+		if err := os.WriteFile(filepath.Join(localDir, filepath.FromSlash(file)), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		localPath  string
+		remotePath string
+		want       map[string]string
+	}{
+		{
+			name:       "file into an existing directory",
+			localPath:  "data/a.txt",
+			remotePath: "/tmp",
+			want:       map[string]string{"/tmp/a.txt": "a"},
+		},
+		{
+			name:       "file renamed",
+			localPath:  "data/a.txt",
+			remotePath: "/tmp/new/c.txt",
+			want:       map[string]string{"/tmp/new/c.txt": "a"},
+		},
+		{
+			name:       "file relative to the sync folder",
+			localPath:  "data/a.txt",
+			remotePath: "config/c.txt",
+			want:       map[string]string{"/projects/config/c.txt": "a"},
+		},
+		{
+			name:       "directory into an existing directory",
+			localPath:  "data",
+			remotePath: "/tmp",
+			want:       map[string]string{"/tmp/data/a.txt": "a", "/tmp/data/sub/b.txt": "b"},
+		},
+		{
+			name:       "directory renamed",
+			localPath:  "data",
+			remotePath: "/tmp/copy",
+			want:       map[string]string{"/tmp/copy/a.txt": "a", "/tmp/copy/sub/b.txt": "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := &fakeContainer{
+				directories: map[string]bool{"/tmp": true, "/projects": true},
+				files:       make(map[string]string),
+			}
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(container.exec).AnyTimes()
+
+			syncClient := NewSyncClient(platformClient, nil)
+			err := syncClient.CopyToContainer(context.Background(), CopyParameters{
+				LocalPath:  filepath.Join(localDir, filepath.FromSlash(tt.localPath)),
+				RemotePath: tt.remotePath,
+				CompInfo:   ComponentInfo{SyncFolder: "/projects"},
+			})
+			if err != nil {
+				t.Fatalf("CopyToContainer() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, container.files); diff != "" {
+				t.Errorf("CopyToContainer() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncClient_CopyFromContainer(t *testing.T) {
+	tests := []struct {
+		name       string
+		remotePath string
+		// localPath is relative to a temporary directory containing an existing "existing" directory
+		localPath  string
+		wantCopied []string
+		wantLocal  map[string]string
+	}{
+		{
+			name:       "file into an existing directory",
+			remotePath: "/tmp/heap.hprof",
+			localPath:  "existing",
+			wantCopied: []string{"heap.hprof"},
+			wantLocal:  map[string]string{"existing/heap.hprof": "heap"},
+		},
+		{
+			name:       "file renamed",
+			remotePath: "/tmp/heap.hprof",
+			localPath:  "dumps/dump.hprof",
+			wantCopied: []string{"dump.hprof"},
+			wantLocal:  map[string]string{"dumps/dump.hprof": "heap"},
+		},
+		{
+			name:       "directory relative to the sync folder",
+			remotePath: "reports",
+			localPath:  "existing",
+			wantCopied: []string{filepath.Join("reports", "a.xml"), filepath.Join("reports", "b.xml")},
+			wantLocal:  map[string]string{"existing/reports/a.xml": "a", "existing/reports/b.xml": "b"},
+		},
+		{
+			name:       "directory renamed",
+			remotePath: "/projects/reports",
+			localPath:  "results",
+			wantCopied: []string{filepath.Join("results", "a.xml"), filepath.Join("results", "b.xml")},
+			wantLocal:  map[string]string{"results/a.xml": "a", "results/b.xml": "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localDir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(localDir, "existing"), 0750); err != nil {
+				t.Fatal(err)
+			}
+			container := &fakeContainer{
+				directories: map[string]bool{"/tmp": true, "/projects": true, "/projects/reports": true},
+				files: map[string]string{
+					"/tmp/heap.hprof":         "heap",
+					"/projects/reports/a.xml": "a",
+					"/projects/reports/b.xml": "b",
+				},
+			}
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(container.exec).AnyTimes()
+
+			syncClient := NewSyncClient(platformClient, nil)
+			copied, err := syncClient.CopyFromContainer(context.Background(), CopyParameters{
+				LocalPath:  filepath.Join(localDir, filepath.FromSlash(tt.localPath)),
+				RemotePath: tt.remotePath,
+				CompInfo:   ComponentInfo{SyncFolder: "/projects"},
+			})
+			if err != nil {
+				t.Fatalf("CopyFromContainer() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantCopied, copied); diff != "" {
+				t.Errorf("CopyFromContainer() mismatch (-want +got):\n%s", diff)
+			}
+			for file, want := range tt.wantLocal {
+				content, err := os.ReadFile(filepath.Join(localDir, filepath.FromSlash(file)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(content) != want {
+					t.Errorf("content of %s = %q, want %q", file, string(content), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+
+	"github.com/redhat-developer/odo/pkg/util"
+
+	"k8s.io/klog"
+)
+
+const (
+	// deltaBlockSize is the size of the blocks compared between the local and the remote copies of a file
+	deltaBlockSize = 256 * 1024
+	// deltaMinFileSize is the minimal size of a file for which a delta sync is attempted.
+	// Smaller files are always sent as a whole in the tar archive.
+	deltaMinFileSize = 2 * 1024 * 1024
+	// deltaMaxChangedRatio is the ratio of changed blocks above which the file is sent as a whole instead
+	deltaMaxChangedRatio = 0.5
+)
+
+// getCmdToCheckDeltaTools returns the command used to check that the tools needed to compute the remote checksums are present in the container
+func getCmdToCheckDeltaTools() []string {
+	return []string{"sh", "-c", "command -v dd && command -v sha256sum && command -v cut && command -v wc"}
+}
+
+// getCmdToComputeBlockChecksums returns the command used to compute the checksums of the blocks of a remote file.
+// The first line of the output is the size of the file, followed by its permission bits in octal if stat is available in the container,
+// each following line is the checksum of a block.
+// Nothing is output if the file does not exist.
+func getCmdToComputeBlockChecksums(remoteFile string, blockSize int) []string {
+	script := `f="$1"; bs="$2"; [ -f "$f" ] || exit 0
+size=$(($(wc -c < "$f")))
+echo "$size $(stat -c %a "$f" 2>/dev/null)"
+n=0
+while [ $((n * bs)) -lt "$size" ]; do
+  dd if="$f" bs="$bs" skip="$n" count=1 2>/dev/null | sha256sum | cut -d' ' -f1
+  n=$((n + 1))
+done`
+	return []string{"sh", "-c", script, "sh", remoteFile, strconv.Itoa(blockSize)}
+}
+
+// getCmdToWriteBlocks returns the command used to write the content of stdin into the remote file, starting at block startBlock
+func getCmdToWriteBlocks(remoteFile string, blockSize int, startBlock int) []string {
+	return []string{"dd", "of=" + remoteFile, "bs=" + strconv.Itoa(blockSize), "seek=" + strconv.Itoa(startBlock), "conv=notrunc"}
+}
+
+// getCmdToTruncateFile returns the command used to truncate the remote file to the given size
+func getCmdToTruncateFile(remoteFile string, size int64) []string {
+	return []string{"dd", "if=/dev/null", "of=" + remoteFile, "bs=1", "seek=" + strconv.FormatInt(size, 10), "count=0"}
+}
+
+// getCmdToChangeMode returns the command used to set the permission bits of the remote file, as the extraction of the tar archive does
+func getCmdToChangeMode(remoteFile string, perm os.FileMode) []string {
+	return []string{"chmod", strconv.FormatUint(uint64(perm), 8), remoteFile}
+}
+
+// blockRange is a range of contiguous blocks [start, end)
+type blockRange struct {
+	start int
+	end   int
+}
+
+// remoteBlocks describes a remote file and the checksums of its blocks
+type remoteBlocks struct {
+	size int64
+	// perm are the permission bits of the file, if hasPerm is true
+	perm      os.FileMode
+	hasPerm   bool
+	checksums []string
+}
+
+// parseBlockChecksums parses the output of the command returned by getCmdToComputeBlockChecksums.
+// It returns false if the remote file does not exist.
+func parseBlockChecksums(output []byte) (remote remoteBlocks, exists bool, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	if !scanner.Scan() {
+		return remoteBlocks{}, false, scanner.Err()
+	}
+	fields := strings.Fields(scanner.Text())
+	if len(fields) == 0 {
+		return remoteBlocks{}, false, errors.New("unable to parse remote file size: empty line")
+	}
+	remote.size, err = strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return remoteBlocks{}, false, fmt.Errorf("unable to parse remote file size: %w", err)
+	}
+	if len(fields) > 1 {
+		var perm uint64
+		perm, err = strconv.ParseUint(fields[1], 8, 32)
+		if err != nil {
+			return remoteBlocks{}, false, fmt.Errorf("unable to parse remote file mode: %w", err)
+		}
+		remote.perm = os.FileMode(perm).Perm()
+		remote.hasPerm = true
+	}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		remote.checksums = append(remote.checksums, line)
+	}
+	return remote, true, scanner.Err()
+}
+
+// computeBlockChecksums returns the checksums of the consecutive blocks of blockSize bytes read from reader
+func computeBlockChecksums(reader io.Reader, blockSize int) ([]string, error) {
+	var checksums []string
+	buf := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(reader, buf)
+		if n > 0 {
+			sum := sha256.Sum256(buf[:n])
+			checksums = append(checksums, hex.EncodeToString(sum[:]))
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return checksums, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// getChangedBlockRanges compares the local and remote checksums and returns the ranges of blocks
+// to send to the remote file, along with the number of changed blocks
+func getChangedBlockRanges(local []string, remote []string) (ranges []blockRange, changed int) {
+	current := -1
+	for i := range local {
+		if i < len(remote) && local[i] == remote[i] {
+			if current != -1 {
+				ranges = append(ranges, blockRange{start: current, end: i})
+				current = -1
+			}
+			continue
+		}
+		changed++
+		if current == -1 {
+			current = i
+		}
+	}
+	if current != -1 {
+		ranges = append(ranges, blockRange{start: current, end: len(local)})
+	}
+	return ranges, changed
+}
+
+// deltaSyncFiles syncs the large files of the files list which already exist in the container,
+// by sending only the blocks that differ from the remote copies.
+// It returns the list of files which were not synced this way and need to be sent as a whole.
+func (a SyncClient) deltaSyncFiles(ctx context.Context, localPath string, files []string, compInfo ComponentInfo, ret util.IndexerRet) []string {
+	var candidates, remaining []string
+	for _, file := range files {
+		stat, err := os.Lstat(file)
+		if err != nil || !stat.Mode().IsRegular() || stat.Size() < deltaMinFileSize {
+			remaining = append(remaining, file)
+			continue
+		}
+		candidates = append(candidates, file)
+	}
+	if len(candidates) == 0 {
+		return files
+	}
+
+	var stdout, stderr bytes.Buffer
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToCheckDeltaTools(), &stdout, &stderr, nil, false)
+	if err != nil {
+		klog.V(2).Infof("delta sync not available in container %q, sending whole files: %v", compInfo.ContainerName, err)
+		return files
+	}
+
+	for _, file := range candidates {
+		var remoteFile string
+		remoteFile, err = getRemoteFilePath(localPath, file, compInfo.SyncFolder, ret)
+		if err != nil {
+			klog.V(4).Infof("unable to compute remote path of %s: %v", file, err)
+			remaining = append(remaining, file)
+			continue
+		}
+		var synced bool
+		synced, err = a.deltaSyncFile(ctx, file, remoteFile, compInfo)
+		if err != nil {
+			klog.V(4).Infof("delta sync of %s failed, sending whole file: %v", file, err)
+		}
+		if !synced {
+			remaining = append(remaining, file)
+		}
+	}
+	return remaining
+}
+
+// deltaSyncFile sends the blocks of localFile differing from the blocks of remoteFile into the container.
+// It returns false if the file has not been synced and needs to be sent as a whole.
+func (a SyncClient) deltaSyncFile(ctx context.Context, localFile string, remoteFile string, compInfo ComponentInfo) (bool, error) {
+	var stdout, stderr bytes.Buffer
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToComputeBlockChecksums(remoteFile, deltaBlockSize), &stdout, &stderr, nil, false)
+	if err != nil {
+		return false, fmt.Errorf("unable to compute remote checksums: %w: %s", err, stderr.String())
+	}
+	remote, exists, err := parseBlockChecksums(stdout.Bytes())
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+
+	f, err := os.Open(localFile)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close() // #nosec G307
+
+	stat, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	localChecksums, err := computeBlockChecksums(f, deltaBlockSize)
+	if err != nil {
+		return false, err
+	}
+
+	ranges, changed := getChangedBlockRanges(localChecksums, remote.checksums)
+	if len(localChecksums) > 0 && float64(changed)/float64(len(localChecksums)) > deltaMaxChangedRatio {
+		return false, nil
+	}
+	klog.V(4).Infof("delta sync of %s: %d/%d blocks changed", localFile, changed, len(localChecksums))
+
+	for _, r := range ranges {
+		offset := int64(r.start) * deltaBlockSize
+		section := io.NewSectionReader(f, offset, int64(r.end-r.start)*deltaBlockSize)
+		stdout.Reset()
+		stderr.Reset()
+		err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToWriteBlocks(remoteFile, deltaBlockSize, r.start), &stdout, &stderr, section, false)
+		if err != nil {
+			return false, fmt.Errorf("unable to write blocks %d-%d: %w: %s", r.start, r.end, err, stderr.String())
+		}
+	}
+
+	if stat.Size() < remote.size {
+		stdout.Reset()
+		stderr.Reset()
+		err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToTruncateFile(remoteFile, stat.Size()), &stdout, &stderr, nil, false)
+		if err != nil {
+			return false, fmt.Errorf("unable to truncate remote file: %w: %s", err, stderr.String())
+		}
+	}
+
+	// The mode is always set when it cannot be read in the container
+	if perm := stat.Mode().Perm(); !remote.hasPerm || remote.perm != perm {
+		stdout.Reset()
+		stderr.Reset()
+		err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToChangeMode(remoteFile, perm), &stdout, &stderr, nil, false)
+		if err != nil {
+			return false, fmt.Errorf("unable to change mode of remote file: %w: %s", err, stderr.String())
+		}
+	}
+	return true, nil
+}
+
+// getRemoteFilePath returns the path in the container of the local file, the same way makeTar computes the destination of the file
+func getRemoteFilePath(localPath string, file string, syncFolder string, ret util.IndexerRet) (string, error) {
+	fileAbsolutePath, err := dfutil.GetAbsPath(file)
+	if err != nil {
+		return "", err
+	}
+	localAbsolutePath, err := dfutil.GetAbsPath(localPath)
+	if err != nil {
+		return "", err
+	}
+	destFile, err := filepath.Rel(localAbsolutePath, fileAbsolutePath)
+	if err != nil {
+		return "", err
+	}
+	if value, ok := ret.NewFileMap[destFile]; ok && value.RemoteAttribute != "" {
+		destFile = value.RemoteAttribute
+	}
+	return path.Join(filepath.ToSlash(syncFolder), filepath.ToSlash(destFile)), nil
+}
@@ -0,0 +1,264 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// fakeRemoteFile simulates the execution of the delta sync commands on a single file in a container
+type fakeRemoteFile struct {
+	content []byte
+	// perm are the permission bits of the file, not output if zero, as when stat is not available in the container
+	perm      os.FileMode
+	exists    bool
+	noTools   bool
+	blocksSet int
+	chmods    int
+}
+
+func (o *fakeRemoteFile) exec(_ context.Context, _, _ string, cmd []string, stdout, _ io.Writer, stdin io.Reader, _ bool) error {
+	switch {
+	case cmd[0] == "sh" && strings.HasPrefix(cmd[2], "command -v"):
+		if o.noTools {
+			return errors.New("command not found")
+		}
+		return nil
+	case cmd[0] == "sh":
+		if !o.exists {
+			return nil
+		}
+		bs, _ := strconv.Atoi(cmd[5])
+		if o.perm != 0 {
+			fmt.Fprintf(stdout, "%d %o\n", len(o.content), o.perm)
+		} else {
+			fmt.Fprintf(stdout, "%d \n", len(o.content))
+		}
+		for i := 0; i < len(o.content); i += bs {
+			end := i + bs
+			if end > len(o.content) {
+				end = len(o.content)
+			}
+			sum := sha256.Sum256(o.content[i:end])
+			fmt.Fprintln(stdout, hex.EncodeToString(sum[:]))
+		}
+		return nil
+	case cmd[0] == "dd" && cmd[1] == "if=/dev/null":
+		size, _ := strconv.Atoi(strings.TrimPrefix(cmd[4], "seek="))
+		o.content = o.content[:size]
+		return nil
+	case cmd[0] == "dd":
+		bs, _ := strconv.Atoi(strings.TrimPrefix(cmd[2], "bs="))
+		seek, _ := strconv.Atoi(strings.TrimPrefix(cmd[3], "seek="))
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		offset := seek * bs
+		if missing := offset + len(data) - len(o.content); missing > 0 {
+			o.content = append(o.content, make([]byte, missing)...)
+		}
+		copy(o.content[offset:], data)
+		o.blocksSet += (len(data) + bs - 1) / bs
+		return nil
+	case cmd[0] == "chmod":
+		perm, err := strconv.ParseUint(cmd[1], 8, 32)
+		if err != nil {
+			return err
+		}
+		o.perm = os.FileMode(perm)
+		o.chmods++
+		return nil
+	}
+	return fmt.Errorf("unexpected command %v", cmd)
+}
+
+func Test_getChangedBlockRanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		local       []string
+		remote      []string
+		wantRanges  []blockRange
+		wantChanged int
+	}{
+		{
+			name:   "no change",
+			local:  []string{"a", "b", "c"},
+			remote: []string{"a", "b", "c"},
+		},
+		{
+			name:        "changed blocks in the middle and at the end",
+			local:       []string{"a", "x", "y", "d", "z"},
+			remote:      []string{"a", "b", "c", "d", "e"},
+			wantRanges:  []blockRange{{start: 1, end: 3}, {start: 4, end: 5}},
+			wantChanged: 3,
+		},
+		{
+			name:        "local file is larger",
+			local:       []string{"a", "b", "c", "d"},
+			remote:      []string{"a", "b"},
+			wantRanges:  []blockRange{{start: 2, end: 4}},
+			wantChanged: 2,
+		},
+		{
+			name:   "local file is smaller",
+			local:  []string{"a"},
+			remote: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRanges, gotChanged := getChangedBlockRanges(tt.local, tt.remote)
+			if diff := cmp.Diff(tt.wantRanges, gotRanges, cmp.AllowUnexported(blockRange{})); diff != "" {
+				t.Errorf("getChangedBlockRanges() ranges mismatch (-want +got):\n%s", diff)
+			}
+			if gotChanged != tt.wantChanged {
+				t.Errorf("getChangedBlockRanges() changed = %d, want %d", gotChanged, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestSyncClient_deltaSyncFiles(t *testing.T) {
+	original := bytes.Repeat([]byte("0123456789abcdef"), 10*deltaBlockSize/16)
+
+	modifyBlock := func(content []byte, block int) []byte {
+		result := append([]byte{}, content...)
+		result[block*deltaBlockSize] = 'X'
+		return result
+	}
+
+	tests := []struct {
+		name          string
+		local         []byte
+		remote        *fakeRemoteFile
+		wantRemaining bool
+		wantBlocksSet int
+		wantChmod     bool
+	}{
+		{
+			name:          "unchanged file",
+			local:         original,
+			remote:        &fakeRemoteFile{content: original, perm: 0600, exists: true},
+			wantBlocksSet: 0,
+		},
+		{
+			name:          "mode changed",
+			local:         original,
+			remote:        &fakeRemoteFile{content: original, perm: 0755, exists: true},
+			wantBlocksSet: 0,
+			wantChmod:     true,
+		},
+		{
+			name:          "one block changed, mode not readable in the container",
+			local:         modifyBlock(original, 3),
+			remote:        &fakeRemoteFile{content: original, exists: true},
+			wantBlocksSet: 1,
+			wantChmod:     true,
+		},
+		{
+			name:          "one block changed",
+			local:         modifyBlock(original, 3),
+			remote:        &fakeRemoteFile{content: original, perm: 0600, exists: true},
+			wantBlocksSet: 1,
+		},
+		{
+			name:          "file grew",
+			local:         append(append([]byte{}, original...), []byte("more content")...),
+			remote:        &fakeRemoteFile{content: original, perm: 0600, exists: true},
+			wantBlocksSet: 1,
+		},
+		{
+			name:          "file shrunk",
+			local:         original[:len(original)-100],
+			remote:        &fakeRemoteFile{content: original, perm: 0600, exists: true},
+			wantBlocksSet: 1,
+		},
+		{
+			name:          "most of the file changed",
+			local:         bytes.Repeat([]byte("z"), len(original)),
+			remote:        &fakeRemoteFile{content: original, exists: true},
+			wantRemaining: true,
+		},
+		{
+			name:          "file does not exist in the container",
+			local:         original,
+			remote:        &fakeRemoteFile{},
+			wantRemaining: true,
+		},
+		{
+			name:          "tools missing in the container",
+			local:         modifyBlock(original, 3),
+			remote:        &fakeRemoteFile{content: original, exists: true, noTools: true},
+			wantRemaining: true,
+		},
+		{
+			name:          "small file",
+			local:         []byte("small content"),
+			remote:        &fakeRemoteFile{content: []byte("small"), exists: true},
+			wantRemaining: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directory := t.TempDir()
+			file := filepath.Join(directory, "app.jar")
+			if err := os.WriteFile(file, tt.local, 0600); err != nil {
+				t.Fatal(err)
+			}
+			stat, err := os.Stat(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			localPerm := stat.Mode().Perm()
+
+			// the fake remote file is modified in place, so it must not share its content with the other test cases
+			tt.remote.content = append([]byte{}, tt.remote.content...)
+
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(tt.remote.exec).AnyTimes()
+
+			syncClient := NewSyncClient(platformClient, nil)
+			remaining := syncClient.deltaSyncFiles(context.Background(), directory, []string{file}, ComponentInfo{SyncFolder: "/projects"}, util.IndexerRet{})
+
+			if tt.wantRemaining {
+				if diff := cmp.Diff([]string{file}, remaining); diff != "" {
+					t.Errorf("deltaSyncFiles() mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if len(remaining) != 0 {
+				t.Errorf("deltaSyncFiles() should have synced all files, remaining: %v", remaining)
+			}
+			if tt.remote.blocksSet != tt.wantBlocksSet {
+				t.Errorf("deltaSyncFiles() wrote %d blocks, want %d", tt.remote.blocksSet, tt.wantBlocksSet)
+			}
+			if !bytes.Equal(tt.local, tt.remote.content) {
+				t.Errorf("deltaSyncFiles() remote content differs from local content")
+			}
+			if got := tt.remote.chmods > 0; got != tt.wantChmod {
+				t.Errorf("deltaSyncFiles() changed remote mode: %v, want %v", got, tt.wantChmod)
+			}
+			if tt.remote.perm != localPerm {
+				t.Errorf("deltaSyncFiles() remote mode is %o, want %o", tt.remote.perm, localPerm)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 	"io"
+
+	"github.com/redhat-developer/odo/pkg/api"
 )
 
 // ComponentInfo is a struct that holds information about a component i.e.; component name, pod name, container name, and source mount (if applicable)
@@ -23,10 +25,33 @@ type SyncParameters struct {
 	IgnoredFiles             []string // IgnoredFiles is the list of files to not push up to a component
 	DevfileScanIndexForWatch bool     // DevfileScanIndexForWatch is true if watch's push should regenerate the index file during SyncFiles, false otherwise. See 'pkg/sync/adapter.go' for details
 	ForcePush                bool
+	DeltaSync                bool        // DeltaSync is true if only the changed blocks of large files already present in the container should be sent
+	ContentHash              bool        // ContentHash is true if the files whose modification date changed should be compared by content before being sent
+	Compression              Compression // Optional: Compression is the codec used to compress the archives sent to the container. If empty, the container is probed for the supported codecs. See Client.GetSupportedCompression
 	CompInfo                 ComponentInfo
 	Files                    map[string]string
 }
 
 type Client interface {
 	SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error)
+
+	// GetSupportedCompression returns the preferred compression supported by the container described by compInfo.
+	// The result is intended to be passed in the Compression field of the SyncParameters of the next calls to SyncFiles,
+	// so the container is not probed again on every sync.
+	GetSupportedCompression(ctx context.Context, compInfo ComponentInfo) Compression
+
+	// PullFiles copies the files generated or modified in the container under the paths defined in pullParameters
+	// back into the local directory, and returns the list of pulled files, relative to the local directory.
+	PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error)
+
+	// GetFilesStatus compares the local files with the files in the container, and returns the files
+	// added, modified and deleted locally since they have been synced into the container.
+	GetFilesStatus(ctx context.Context, parameters FilesStatusParameters) (api.FilesStatus, error)
+
+	// CopyToContainer copies the local file or directory defined in copyParameters into the container.
+	CopyToContainer(ctx context.Context, copyParameters CopyParameters) error
+
+	// CopyFromContainer copies the file or directory of the container defined in copyParameters to the local machine,
+	// and returns the list of copied files, relative to the local directory they are copied into.
+	CopyFromContainer(ctx context.Context, copyParameters CopyParameters) ([]string, error)
 }
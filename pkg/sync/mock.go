@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	api "github.com/redhat-developer/odo/pkg/api"
 )
 
 // MockClient is a mock of Client interface.
@@ -34,6 +35,79 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CopyFromContainer mocks base method.
+func (m *MockClient) CopyFromContainer(ctx context.Context, copyParameters CopyParameters) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFromContainer", ctx, copyParameters)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyFromContainer indicates an expected call of CopyFromContainer.
+func (mr *MockClientMockRecorder) CopyFromContainer(ctx, copyParameters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFromContainer", reflect.TypeOf((*MockClient)(nil).CopyFromContainer), ctx, copyParameters)
+}
+
+// CopyToContainer mocks base method.
+func (m *MockClient) CopyToContainer(ctx context.Context, copyParameters CopyParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToContainer", ctx, copyParameters)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyToContainer indicates an expected call of CopyToContainer.
+func (mr *MockClientMockRecorder) CopyToContainer(ctx, copyParameters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToContainer", reflect.TypeOf((*MockClient)(nil).CopyToContainer), ctx, copyParameters)
+}
+
+// GetFilesStatus mocks base method.
+func (m *MockClient) GetFilesStatus(ctx context.Context, parameters FilesStatusParameters) (api.FilesStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesStatus", ctx, parameters)
+	ret0, _ := ret[0].(api.FilesStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesStatus indicates an expected call of GetFilesStatus.
+func (mr *MockClientMockRecorder) GetFilesStatus(ctx, parameters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesStatus", reflect.TypeOf((*MockClient)(nil).GetFilesStatus), ctx, parameters)
+}
+
+// GetSupportedCompression mocks base method.
+func (m *MockClient) GetSupportedCompression(ctx context.Context, compInfo ComponentInfo) Compression {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedCompression", ctx, compInfo)
+	ret0, _ := ret[0].(Compression)
+	return ret0
+}
+
+// GetSupportedCompression indicates an expected call of GetSupportedCompression.
+func (mr *MockClientMockRecorder) GetSupportedCompression(ctx, compInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedCompression", reflect.TypeOf((*MockClient)(nil).GetSupportedCompression), ctx, compInfo)
+}
+
+// PullFiles mocks base method.
+func (m *MockClient) PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullFiles", ctx, pullParameters)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullFiles indicates an expected call of PullFiles.
+func (mr *MockClientMockRecorder) PullFiles(ctx, pullParameters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFiles", reflect.TypeOf((*MockClient)(nil).PullFiles), ctx, pullParameters)
+}
+
 // SyncFiles mocks base method.
 func (m *MockClient) SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error) {
 	m.ctrl.T.Helper()
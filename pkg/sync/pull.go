@@ -0,0 +1,288 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	"k8s.io/klog"
+)
+
+// PullParameters is a struct containing the parameters to be used when pulling files from a devfile component
+type PullParameters struct {
+	Path        string   // Path refers to the local folder containing the source code, into which the files are pulled
+	RemotePaths []string // RemotePaths is the list of paths, relative to the sync folder of the component, to pull from the component
+	CompInfo    ComponentInfo
+	// RemoteChecksums holds the checksums of the remote files already handled by previous calls to PullFiles, indexed by relative path.
+	// It is updated by PullFiles, so that unchanged remote files are not compared again with local files.
+	RemoteChecksums map[string]string
+}
+
+// getCmdToChecksumRemoteFiles returns the command used to compute the checksums of all the files under the paths, relative to syncFolder
+func getCmdToChecksumRemoteFiles(syncFolder string, paths []string) []string {
+	cmd := []string{"sh", "-c", `cd "$1" && shift && find "$@" -type f -exec sha256sum {} + 2>/dev/null; true`, "sh", syncFolder}
+	return append(cmd, paths...)
+}
+
+// getCmdToArchiveRemoteFiles returns the command used to create a tar archive of the files, relative to syncFolder
+func getCmdToArchiveRemoteFiles(syncFolder string, files []string) []string {
+	cmd := []string{"tar", "cf", "-", "-C", syncFolder}
+	return append(cmd, files...)
+}
+
+// parseRemoteChecksums parses the output of the command returned by getCmdToChecksumRemoteFiles,
+// and returns the checksums of the files, indexed by their path relative to the sync folder
+func parseRemoteChecksums(output []byte) map[string]string {
+	result := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.SplitN(strings.TrimSpace(scanner.Text()), " ", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		file := path.Clean(strings.TrimLeft(strings.TrimSpace(fields[1]), "*"))
+		result[file] = fields[0]
+	}
+	return result
+}
+
+// PullFiles copies the files under the parameters' remote paths which changed in the container back into the local directory.
+// Files modified locally since they were last pushed to the container, according to the file index, are considered in conflict
+// and are not overwritten.
+// It returns the list of files that have been pulled, relative to the local directory.
+func (a SyncClient) PullFiles(ctx context.Context, pullParameters PullParameters) ([]string, error) {
+	if len(pullParameters.RemotePaths) == 0 {
+		return nil, nil
+	}
+	compInfo := pullParameters.CompInfo
+
+	var stdout, stderr bytes.Buffer
+	cmd := getCmdToChecksumRemoteFiles(compInfo.SyncFolder, pullParameters.RemotePaths)
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, cmd, &stdout, &stderr, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list files to pull from container %q: %w: %s", compInfo.ContainerName, err, stderr.String())
+	}
+	remoteChecksums := parseRemoteChecksums(stdout.Bytes())
+
+	indexFilePath, err := util.ResolveIndexFilePath(pullParameters.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve path: %s: %w", pullParameters.Path, err)
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+	}
+
+	var toPull []string
+	for file, checksum := range remoteChecksums {
+		if pullParameters.RemoteChecksums != nil && pullParameters.RemoteChecksums[file] == checksum {
+			continue
+		}
+
+		localFile := filepath.Join(pullParameters.Path, filepath.FromSlash(file))
+		localChecksum, exists, err := getLocalChecksum(localFile)
+		if err != nil {
+			return nil, err
+		}
+		if exists && localChecksum == checksum {
+			pullParameters.recordRemoteChecksum(file, checksum)
+			continue
+		}
+		if exists && isModifiedSinceIndexed(localFile, filepath.FromSlash(file), fileIndex) {
+			log.Warningf("File %s has been modified both locally and in the container, keeping the local version", file)
+			pullParameters.recordRemoteChecksum(file, checksum)
+			continue
+		}
+		toPull = append(toPull, file)
+	}
+	if len(toPull) == 0 {
+		return nil, nil
+	}
+
+	klog.V(4).Infof("Pulling files %s from container %q", strings.Join(toPull, " "), compInfo.ContainerName)
+	reader, writer := io.Pipe()
+	go func() {
+		stderr.Reset()
+		err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToArchiveRemoteFiles(compInfo.SyncFolder, toPull), writer, &stderr, nil, false)
+		if err != nil {
+			err = fmt.Errorf("%w: %s", err, stderr.String())
+		}
+		writer.CloseWithError(err)
+	}()
+	pulled, err := extractTar(reader, pullParameters.Path)
+	_ = reader.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to pull files from container %q: %w", compInfo.ContainerName, err)
+	}
+
+	for _, file := range toPull {
+		pullParameters.recordRemoteChecksum(file, remoteChecksums[file])
+	}
+
+	// Update the index with the pulled files, so they are not considered as modified locally at the next pull
+	for _, file := range pulled {
+		relativePath, fileData, err := util.GenerateNewFileDataEntry(filepath.Join(pullParameters.Path, file), pullParameters.Path)
+		if err != nil {
+			klog.V(4).Infof("Error occurred for %s: %v", file, err)
+			continue
+		}
+		fileData.RemoteAttribute = fileIndex.Files[relativePath].RemoteAttribute
+		fileIndex.Files[relativePath] = *fileData
+	}
+	if _, err = os.Stat(filepath.Dir(indexFilePath)); err == nil {
+		err = util.WriteFile(fileIndex.Files, indexFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write file: %w", err)
+		}
+	}
+	return pulled, nil
+}
+
+// recordRemoteChecksum records the checksum of the remote file, so the file is not handled again until it changes in the container
+func (o PullParameters) recordRemoteChecksum(file string, checksum string) {
+	if o.RemoteChecksums != nil {
+		o.RemoteChecksums[file] = checksum
+	}
+}
+
+// getLocalChecksum returns the checksum of the local file, if it exists
+func getLocalChecksum(file string) (string, bool, error) {
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close() // #nosec G307
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), true, nil
+}
+
+// isModifiedSinceIndexed returns true if the local file has changed since it was recorded in the file index,
+// or if it has never been recorded in the index
+func isModifiedSinceIndexed(localFile string, relativePath string, fileIndex *util.FileIndex) bool {
+	data, found := fileIndex.Files[relativePath]
+	if !found {
+		return true
+	}
+	stat, err := os.Stat(localFile)
+	if err != nil {
+		return true
+	}
+	return stat.Size() != data.Size || !stat.ModTime().Equal(data.LastModifiedDate)
+}
+
+// extractTar extracts the regular files and directories of the tar archive read from reader into the destination directory.
+// It returns the list of extracted files, relative to destination.
+func extractTar(reader io.Reader, destination string) ([]string, error) {
+	return extractTarAs(reader, destination, "", "")
+}
+
+// extractTarAs is similar to extractTar, the top-level file or directory named from in the archive being extracted with the name to.
+func extractTarAs(reader io.Reader, destination string, from string, to string) ([]string, error) {
+	var files []string
+	tarReader := taro.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(header.Name)
+		if from != "" && (name == from || strings.HasPrefix(name, from+"/")) {
+			name = to + strings.TrimPrefix(name, from)
+		}
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid file path in archive: %q", header.Name)
+		}
+		target := filepath.Join(destination, filepath.FromSlash(name))
+		if header.Typeflag == taro.TypeDir || header.Typeflag == taro.TypeReg {
+			// The files are not written through the symbolic links existing locally, which could point outside of destination
+			link, err := findSymlink(destination, name)
+			if err != nil {
+				return nil, err
+			}
+			if link != "" {
+				log.Warningf("File %s is not written, as %s is a symbolic link", filepath.FromSlash(name), link)
+				continue
+			}
+		}
+
+		switch header.Typeflag {
+		case taro.TypeDir:
+			if err = os.MkdirAll(target, 0750); err != nil {
+				return nil, err
+			}
+		case taro.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+				return nil, err
+			}
+			if err = writeFileFromReader(target, tarReader, os.FileMode(header.Mode).Perm()); err != nil {
+				return nil, err
+			}
+			files = append(files, filepath.FromSlash(name))
+		default:
+			klog.V(4).Infof("skipping %q of type %c from archive", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// findSymlink returns the first path, among the file name relative to destination and its parent directories under destination,
+// which is a symbolic link, or an empty string if none is a symbolic link
+func findSymlink(destination string, name string) (string, error) {
+	current := destination
+	for _, part := range strings.Split(name, "/") {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			rel, err := filepath.Rel(destination, current)
+			if err != nil {
+				return "", err
+			}
+			return rel, nil
+		}
+	}
+	return "", nil
+}
+
+// writeFileFromReader writes the content of reader into the file, creating or truncating it
+func writeFileFromReader(file string, reader io.Reader, perm os.FileMode) error {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // #nosec G307
+
+	// #nosec G110 -- the archive comes from the component container the files have been pushed to
+	if _, err = io.Copy(f, reader); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,267 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// fakeRemoteTree simulates the execution of the pull commands on the files of a container
+type fakeRemoteTree map[string]string
+
+func (o fakeRemoteTree) exec(_ context.Context, _, _ string, cmd []string, stdout, _ io.Writer, _ io.Reader, _ bool) error {
+	switch cmd[0] {
+	case "sh":
+		for _, file := range o.sortedFiles() {
+			sum := sha256.Sum256([]byte(o[file]))
+			fmt.Fprintf(stdout, "%s  %s\n", hex.EncodeToString(sum[:]), file)
+		}
+		return nil
+	case "tar":
+		tw := taro.NewWriter(stdout)
+		for _, file := range cmd[5:] {
+			content := o[file]
+			if err := tw.WriteHeader(&taro.Header{Name: file, Mode: 0644, Size: int64(len(content)), Typeflag: taro.TypeReg}); err != nil {
+				return err
+			}
+			if _, err := tw.Write([]byte(content)); err != nil {
+				return err
+			}
+		}
+		return tw.Close()
+	}
+	return fmt.Errorf("unexpected command %v", cmd)
+}
+
+func (o fakeRemoteTree) sortedFiles() []string {
+	var files []string
+	for file := range o {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func TestSyncClient_PullFiles(t *testing.T) {
+	const file = "generated/api.go"
+
+	checksum := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	tests := []struct {
+		name string
+		// local is the content of the local file, if any
+		local *string
+		// indexed indicates that the local file is recorded in the index as it is
+		indexed bool
+		// modifiedAfterIndexed indicates that the local file has been modified after being recorded in the index
+		modifiedAfterIndexed bool
+		remote               string
+		knownChecksums       map[string]string
+		wantPulled           []string
+		wantLocal            string
+	}{
+		{
+			name:       "file only in the container",
+			remote:     "generated",
+			wantPulled: []string{filepath.FromSlash(file)},
+			wantLocal:  "generated",
+		},
+		{
+			name:      "file identical locally",
+			local:     pointer.String("generated"),
+			remote:    "generated",
+			wantLocal: "generated",
+		},
+		{
+			name:       "file modified in the container only",
+			local:      pointer.String("generated v1"),
+			indexed:    true,
+			remote:     "generated v2",
+			wantPulled: []string{filepath.FromSlash(file)},
+			wantLocal:  "generated v2",
+		},
+		{
+			name:                 "file modified locally and in the container",
+			local:                pointer.String("generated v1"),
+			indexed:              true,
+			modifiedAfterIndexed: true,
+			remote:               "generated v2",
+			wantLocal:            "edited locally",
+		},
+		{
+			name:      "file never synced but present locally",
+			local:     pointer.String("created locally"),
+			remote:    "generated",
+			wantLocal: "created locally",
+		},
+		{
+			name:           "file already handled",
+			remote:         "generated",
+			knownChecksums: map[string]string{file: checksum("generated")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directory := t.TempDir()
+			localFile := filepath.Join(directory, filepath.FromSlash(file))
+			if tt.local != nil {
+				if err := os.MkdirAll(filepath.Dir(localFile), 0750); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(localFile, []byte(*tt.local), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := os.MkdirAll(filepath.Join(directory, util.DotOdoDirectory), 0750); err != nil {
+				t.Fatal(err)
+			}
+			indexFiles := make(map[string]util.FileData)
+			if tt.indexed {
+				relativePath, fileData, err := util.GenerateNewFileDataEntry(localFile, directory)
+				if err != nil {
+					t.Fatal(err)
+				}
+				indexFiles[relativePath] = *fileData
+			}
+			indexFilePath, err := util.ResolveIndexFilePath(directory)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = util.WriteFile(indexFiles, indexFilePath); err != nil {
+				t.Fatal(err)
+			}
+			if tt.modifiedAfterIndexed {
+				if err = os.WriteFile(localFile, []byte("edited locally"), 0600); err != nil {
+					t.Fatal(err)
+				}
+				later := time.Now().Add(time.Minute)
+				if err = os.Chtimes(localFile, later, later); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(fakeRemoteTree{file: tt.remote}.exec).AnyTimes()
+
+			knownChecksums := tt.knownChecksums
+			if knownChecksums == nil {
+				knownChecksums = make(map[string]string)
+			}
+			syncClient := NewSyncClient(platformClient, nil)
+			pulled, err := syncClient.PullFiles(context.Background(), PullParameters{
+				Path:            directory,
+				RemotePaths:     []string{"generated"},
+				CompInfo:        ComponentInfo{SyncFolder: "/projects"},
+				RemoteChecksums: knownChecksums,
+			})
+			if err != nil {
+				t.Fatalf("PullFiles() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPulled, pulled); diff != "" {
+				t.Errorf("PullFiles() mismatch (-want +got):\n%s", diff)
+			}
+			if knownChecksums[file] != checksum(tt.remote) {
+				t.Errorf("PullFiles() should record the checksum of the remote file")
+			}
+
+			content, err := os.ReadFile(localFile)
+			if tt.wantLocal == "" {
+				if !os.IsNotExist(err) {
+					t.Errorf("local file should not exist, err = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != tt.wantLocal {
+				t.Errorf("local content = %q, want %q", string(content), tt.wantLocal)
+			}
+
+			if len(tt.wantPulled) > 0 {
+				index, err := util.ReadFileIndex(indexFilePath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, found := index.Files[filepath.FromSlash(file)]; !found {
+					t.Errorf("pulled file should be recorded in the index")
+				}
+			}
+		})
+	}
+}
+
+func Test_extractTar(t *testing.T) {
+	reader, writer := io.Pipe()
+	go func() {
+		tw := taro.NewWriter(writer)
+		_ = tw.WriteHeader(&taro.Header{Name: "../outside", Mode: 0644, Typeflag: taro.TypeReg})
+		_ = tw.Close()
+		_ = writer.Close()
+	}()
+	_, err := extractTar(reader, t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "invalid file path") {
+		t.Errorf("extractTar() should reject files outside of the destination, got err = %v", err)
+	}
+}
+
+func Test_extractTar_symlinks(t *testing.T) {
+	destination := t.TempDir()
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outside, "secret"), []byte("original"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// A link to a file and a link to a directory outside of the destination
+	if err := os.Symlink(filepath.Join(outside, "secret"), filepath.Join(destination, "file-link")); err != nil {
+		t.Skipf("unable to create symbolic links: %v", err)
+	}
+	if err := os.Symlink(outside, filepath.Join(destination, "dir-link")); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		tw := taro.NewWriter(writer)
+		for _, name := range []string{"file-link", "dir-link/secret", "regular"} {
+			_ = tw.WriteHeader(&taro.Header{Name: name, Mode: 0644, Size: 8, Typeflag: taro.TypeReg})
+			_, _ = tw.Write([]byte("modified"))
+		}
+		_ = tw.Close()
+		_ = writer.Close()
+	}()
+	got, err := extractTar(reader, destination)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"regular"}, got); diff != "" {
+		t.Errorf("extractTar() mismatch (-want +got):\n%s", diff)
+	}
+	content, err := os.ReadFile(filepath.Join(outside, "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "original" {
+		t.Errorf("the file outside of the destination has been overwritten through a symbolic link")
+	}
+}
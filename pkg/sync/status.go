@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	gitignore "github.com/sabhiram/go-gitignore"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// FilesStatusParameters is a struct containing the parameters to be used when comparing the local files with the files of a devfile component
+type FilesStatusParameters struct {
+	Path         string   // Path refers to the local folder containing the source code
+	IgnoredFiles []string // IgnoredFiles is the list of files to not compare
+	CompInfo     ComponentInfo
+}
+
+// getCmdToChecksumFiles returns the command used to compute the checksums of the files whose paths are read from stdin, separated by NUL characters.
+// Files which do not exist are omitted from the output.
+func getCmdToChecksumFiles() []string {
+	return []string{"sh", "-c", "xargs -0 sha256sum 2>/dev/null; true"}
+}
+
+// GetFilesStatus compares the checksums of the local files with the checksums of the files in the container.
+// The local files are the ones not ignored by the parameters' ignore rules; the files deleted locally are
+// the ones recorded in the file index, as having been synced previously.
+func (a SyncClient) GetFilesStatus(ctx context.Context, parameters FilesStatusParameters) (api.FilesStatus, error) {
+	status := api.FilesStatus{
+		Added:    []string{},
+		Modified: []string{},
+		Deleted:  []string{},
+	}
+
+	indexFilePath, err := util.ResolveIndexFilePath(parameters.Path)
+	if err != nil {
+		return status, fmt.Errorf("unable to resolve path: %s: %w", parameters.Path, err)
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return status, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+	}
+
+	// The indexer is only used to list the local files matching none of the ignore rules
+	ret, err := util.RunIndexerWithRemote(parameters.Path, parameters.IgnoredFiles, nil)
+	if err != nil {
+		return status, fmt.Errorf("unable to run indexer: %w", err)
+	}
+
+	// remoteFiles are the relative paths of the files to compare, indexed by their absolute path in the container
+	remoteFiles := make(map[string]string)
+	localFiles := make(map[string]bool)
+	for file := range ret.NewFileMap {
+		stat, statErr := os.Stat(filepath.Join(parameters.Path, file))
+		if statErr != nil || !stat.Mode().IsRegular() {
+			continue
+		}
+		localFiles[file] = true
+		remoteFiles[getRemoteIndexedFilePath(file, parameters.CompInfo.SyncFolder, fileIndex)] = file
+	}
+	ignoreMatcher := gitignore.CompileIgnoreLines(parameters.IgnoredFiles...)
+	for file := range fileIndex.Files {
+		if _, found := ret.NewFileMap[file]; !found && !ignoreMatcher.MatchesPath(file) {
+			remoteFiles[getRemoteIndexedFilePath(file, parameters.CompInfo.SyncFolder, fileIndex)] = file
+		}
+	}
+	if len(remoteFiles) == 0 {
+		return status, nil
+	}
+
+	var stdin bytes.Buffer
+	for remoteFile := range remoteFiles {
+		stdin.WriteString(remoteFile)
+		stdin.WriteByte(0)
+	}
+	var stdout, stderr bytes.Buffer
+	compInfo := parameters.CompInfo
+	err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, getCmdToChecksumFiles(), &stdout, &stderr, &stdin, false)
+	if err != nil {
+		return status, fmt.Errorf("unable to compute checksums of files in container %q: %w: %s", compInfo.ContainerName, err, stderr.String())
+	}
+	remoteChecksums := parseRemoteChecksums(stdout.Bytes())
+
+	for remoteFile, file := range remoteFiles {
+		remoteChecksum, inContainer := remoteChecksums[path.Clean(remoteFile)]
+		slashFile := filepath.ToSlash(file)
+		switch {
+		case !localFiles[file] && inContainer:
+			status.Deleted = append(status.Deleted, slashFile)
+		case !localFiles[file]:
+			continue
+		case !inContainer:
+			status.Added = append(status.Added, slashFile)
+		default:
+			localChecksum, _, checksumErr := getLocalChecksum(filepath.Join(parameters.Path, file))
+			if checksumErr != nil {
+				return status, checksumErr
+			}
+			if localChecksum != remoteChecksum {
+				status.Modified = append(status.Modified, slashFile)
+			}
+		}
+	}
+	sort.Strings(status.Added)
+	sort.Strings(status.Modified)
+	sort.Strings(status.Deleted)
+	return status, nil
+}
+
+// getRemoteIndexedFilePath returns the absolute path in the container of the file, relative to the local directory,
+// taking into account the remote path recorded in the file index, if any
+func getRemoteIndexedFilePath(file string, syncFolder string, fileIndex *util.FileIndex) string {
+	destFile := file
+	if value, ok := fileIndex.Files[file]; ok && value.RemoteAttribute != "" {
+		destFile = value.RemoteAttribute
+	}
+	destFile = filepath.ToSlash(destFile)
+	if strings.HasPrefix(destFile, "/") {
+		return path.Clean(destFile)
+	}
+	return path.Join(filepath.ToSlash(syncFolder), destFile)
+}
@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestSyncClient_GetFilesStatus(t *testing.T) {
+	directory := t.TempDir()
+	local := map[string]string{
+		"same.txt":            "same content",
+		"modified.txt":        "local content",
+		"added.txt":           "added content",
+		"sub/nested.txt":      "nested content",
+		"ignored.log":         "ignored content",
+		"mapped/relocated.sh": "relocated content",
+	}
+	for file, content := range local {
+		localFile := filepath.Join(directory, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(localFile), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(localFile, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(directory, util.DotOdoDirectory), 0750); err != nil {
+		t.Fatal(err)
+	}
+	indexFilePath, err := util.ResolveIndexFilePath(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = util.WriteFile(map[string]util.FileData{
+		"same.txt":               {},
+		"modified.txt":           {},
+		"deleted.txt":            {},
+		"deleted-everywhere.txt": {},
+		"old.log":                {},
+		filepath.FromSlash("mapped/relocated.sh"):   {RemoteAttribute: "/opt/scripts/relocated.sh"},
+		filepath.FromSlash("sub/nested.txt"):        {},
+		filepath.FromSlash("sub/deleted-nested.go"): {},
+	}, indexFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote := map[string]string{
+		"/projects/same.txt":              "same content",
+		"/projects/modified.txt":          "remote content",
+		"/projects/deleted.txt":           "deleted content",
+		"/projects/old.log":               "ignored content",
+		"/projects/sub/nested.txt":        "nested content",
+		"/projects/sub/deleted-nested.go": "deleted content",
+		"/projects/not-synced-by-odo.txt": "generated content",
+		"/opt/scripts/relocated.sh":       "relocated content",
+	}
+
+	ctrl := gomock.NewController(t)
+	platformClient := platform.NewMockClient(ctrl)
+	platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "a-pod", getCmdToChecksumFiles(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, _, _ string, _ []string, stdout, _ io.Writer, stdin io.Reader, _ bool) error {
+			input, err := io.ReadAll(stdin)
+			if err != nil {
+				return err
+			}
+			for _, file := range strings.Split(strings.TrimSuffix(string(input), "\x00"), "\x00") {
+				content, found := remote[file]
+				if !found {
+					continue
+				}
+				sum := sha256.Sum256([]byte(content))
+				fmt.Fprintf(stdout, "%s  %s\n", hex.EncodeToString(sum[:]), file)
+			}
+			return nil
+		})
+
+	syncClient := NewSyncClient(platformClient, nil)
+	got, err := syncClient.GetFilesStatus(context.Background(), FilesStatusParameters{
+		Path:         directory,
+		IgnoredFiles: []string{"*.log", ".odo/odo-file-index.json"},
+		CompInfo:     ComponentInfo{PodName: "a-pod", ContainerName: "runtime", SyncFolder: "/projects"},
+	})
+	if err != nil {
+		t.Fatalf("GetFilesStatus() unexpected error: %v", err)
+	}
+	want := api.FilesStatus{
+		Added:    []string{"added.txt"},
+		Modified: []string{"modified.txt"},
+		Deleted:  []string{"deleted.txt", "sub/deleted-nested.go"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetFilesStatus() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSyncClient_GetFilesStatus_noFile(t *testing.T) {
+	directory := t.TempDir()
+	ctrl := gomock.NewController(t)
+	platformClient := platform.NewMockClient(ctrl)
+
+	syncClient := NewSyncClient(platformClient, nil)
+	got, err := syncClient.GetFilesStatus(context.Background(), FilesStatusParameters{
+		Path:     directory,
+		CompInfo: ComponentInfo{SyncFolder: "/projects"},
+	})
+	if err != nil {
+		t.Fatalf("GetFilesStatus() unexpected error: %v", err)
+	}
+	if got.HasDrift() {
+		t.Errorf("GetFilesStatus() should not report any drift, got %v", got)
+	}
+	// empty lists, and not null values, are expected in the JSON output
+	if got.Added == nil || got.Modified == nil || got.Deleted == nil {
+		t.Errorf("GetFilesStatus() should return empty lists, got %#v", got)
+	}
+}
@@ -116,7 +116,9 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 
 		// Run the indexer and find the modified/added/deleted/renamed files
 		var err error
-		ret, err = util.RunIndexerWithRemote(syncParameters.Path, syncParameters.IgnoredFiles, syncParameters.Files)
+		ret, err = util.RunIndexerWithOptions(syncParameters.Path, syncParameters.IgnoredFiles, syncParameters.Files, util.IndexerOptions{
+			ContentHash: syncParameters.ContentHash,
+		})
 
 		if err != nil {
 			return false, fmt.Errorf("unable to run indexer: %w", err)
@@ -148,20 +150,42 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 		}
 	}
 
-	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret)
+	if syncParameters.DeltaSync && !syncParameters.ForcePush && len(changedFiles) > 0 {
+		changedFiles = a.deltaSyncFiles(ctx, syncParameters.Path, changedFiles, syncParameters.CompInfo, ret)
+		if len(changedFiles) == 0 && len(deletedFiles) == 0 {
+			return true, a.writeIndex(forceWrite, ret)
+		}
+	}
+
+	compression := syncParameters.Compression
+	if compression == CompressionUnknown {
+		compression = a.GetSupportedCompression(ctx, syncParameters.CompInfo)
+	}
+
+	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret, compression)
 	if err != nil {
 		return false, fmt.Errorf("failed to sync to component with name %s: %w", syncParameters.CompInfo.ComponentName, err)
 	}
-	if forceWrite {
-		err = util.WriteFile(ret.NewFileMap, ret.ResolvedPath)
-		if err != nil {
-			return false, fmt.Errorf("failed to write file: %w", err)
-		}
+	err = a.writeIndex(forceWrite, ret)
+	if err != nil {
+		return false, err
 	}
 
 	return true, nil
 }
 
+// writeIndex writes the file index computed by the indexer, if forceWrite is true
+func (a SyncClient) writeIndex(forceWrite bool, ret util.IndexerRet) error {
+	if !forceWrite {
+		return nil
+	}
+	err := util.WriteFile(ret.NewFileMap, ret.ResolvedPath)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
 // filterIgnores applies the gitignore rules on the filesChanged and filesDeleted and filters them
 // returns the filtered results which match any of the gitignore rules
 func filterIgnores(path string, filesChanged, filesDeleted, absIgnoreRules []string) (filesChangedFiltered, filesDeletedFiltered []string, err error) {
@@ -189,7 +213,7 @@ func filterIgnores(path string, filesChanged, filesDeleted, absIgnoreRules []str
 }
 
 // pushLocal syncs source code from the user's disk to the component
-func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet) error {
+func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet, compression Compression) error {
 	klog.V(4).Infof("Push: componentName: %s, path: %s, files: %s, delFiles: %s, isForcePush: %+v", compInfo.ComponentName, path, files, delFiles, isForcePush)
 
 	// Edge case: check to see that the path is NOT empty.
@@ -231,7 +255,7 @@ func (a SyncClient) pushLocal(ctx context.Context, path string, files []string,
 
 	if isForcePush || len(files) > 0 {
 		klog.V(4).Infof("Copying files %s to pod", strings.Join(files, " "))
-		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, ret)
+		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, ret, compression)
 		if err != nil {
 			return fmt.Errorf("unable push files to pod: %w", err)
 		}
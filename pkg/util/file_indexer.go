@@ -1,11 +1,16 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	gosync "sync"
 	"time"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
@@ -43,6 +48,8 @@ type FileData struct {
 	Size             int64
 	LastModifiedDate time.Time
 	RemoteAttribute  string `json:"RemoteAttribute,omitempty"`
+	// Checksum is the SHA-256 checksum of the content of the file, computed only when the indexer runs with content hashes enabled
+	Checksum string `json:"Checksum,omitempty"`
 }
 
 // ReadFileIndex tries to read the odo index file from the given location and returns the data from the file
@@ -209,10 +216,25 @@ func WriteFile(newFileMap map[string]FileData, resolvedPath string) error {
 	return err
 }
 
+// IndexerOptions are the options used to run the file indexer
+type IndexerOptions struct {
+	// ContentHash enables the computation of the checksums of the files contents.
+	// A file whose modification date changed but whose size and checksum did not change is then not considered as changed,
+	// as it happens when switching between git branches.
+	ContentHash bool
+	// Concurrency is the maximum number of directories walked concurrently. The number of CPUs is used if not set.
+	Concurrency int
+}
+
 // RunIndexerWithRemote reads the existing index from the given directory and runs the indexer on it
 // with the given ignore rules
 // it also adds the file index to the .gitignore file and resolves the path
 func RunIndexerWithRemote(directory string, originalIgnoreRules []string, remoteDirectories map[string]string) (ret IndexerRet, err error) {
+	return RunIndexerWithOptions(directory, originalIgnoreRules, remoteDirectories, IndexerOptions{})
+}
+
+// RunIndexerWithOptions is the same as RunIndexerWithRemote, running the indexer with the given options
+func RunIndexerWithOptions(directory string, originalIgnoreRules []string, remoteDirectories map[string]string, options IndexerOptions) (ret IndexerRet, err error) {
 	directory = filepath.FromSlash(directory)
 	ret.ResolvedPath, err = ResolveIndexFilePath(directory)
 	if err != nil {
@@ -225,7 +247,7 @@ func RunIndexerWithRemote(directory string, originalIgnoreRules []string, remote
 		return ret, err
 	}
 
-	returnedIndex, err := runIndexerWithExistingFileIndex(directory, originalIgnoreRules, remoteDirectories, existingFileIndex)
+	returnedIndex, err := runIndexerWithExistingFileIndex(directory, originalIgnoreRules, remoteDirectories, existingFileIndex, options)
 	if err != nil {
 		return IndexerRet{}, err
 	}
@@ -235,8 +257,9 @@ func RunIndexerWithRemote(directory string, originalIgnoreRules []string, remote
 
 // runIndexerWithExistingFileIndex visits the given directory and creates the new index data
 // it ignores the files and folders satisfying the ignoreRules
-func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, remoteDirectories map[string]string, existingFileIndex *FileIndex) (ret IndexerRet, err error) {
+func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, remoteDirectories map[string]string, existingFileIndex *FileIndex, options IndexerOptions) (ret IndexerRet, err error) {
 	destPath := ""
+	walker := newIndexer(ignoreRules, options)
 	srcPath := directory
 
 	ret.NewFileMap = make(map[string]FileData)
@@ -248,7 +271,7 @@ func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, rem
 	if len(remoteDirectories) == 0 {
 		// The file could be a regular file or even a folder, so use recursiveTar which handles symlinks, regular files and folders
 		pathOptions := recursiveCheckerPathOptions{directory, filepath.Dir(srcPath), filepath.Base(srcPath), filepath.Dir(destPath), filepath.Base(destPath)}
-		innerRet, err := recursiveChecker(pathOptions, ignoreRules, remoteDirectories, *existingFileIndex)
+		innerRet, err := walker.recursiveChecker(pathOptions, remoteDirectories, *existingFileIndex)
 
 		if err != nil {
 			return IndexerRet{}, err
@@ -312,7 +335,7 @@ func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, rem
 
 				// The file could be a regular file or even a folder, so use recursiveTar which handles symlinks, regular files and folders
 				pathOptions := recursiveCheckerPathOptions{directory, filepath.Dir(srcPath), srcFile, filepath.Dir(destPath), destFile}
-				innerRet, err := recursiveChecker(pathOptions, ignoreRules, remoteDirectories, *existingFileIndex)
+				innerRet, err := walker.recursiveChecker(pathOptions, remoteDirectories, *existingFileIndex)
 				if err != nil {
 					return IndexerRet{}, err
 				}
@@ -391,12 +414,46 @@ type recursiveCheckerPathOptions struct {
 	directory, srcBase, srcFile, destBase, destFile string
 }
 
+// indexer walks the files and folders of a component to create the new file index
+type indexer struct {
+	options       IndexerOptions
+	ignoreMatcher *gitignore.GitIgnore
+	// slots limits the number of goroutines walking directories, in addition to the calling one
+	slots chan struct{}
+}
+
+func newIndexer(ignoreRules []string, options IndexerOptions) *indexer {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	return &indexer{
+		options:       options,
+		ignoreMatcher: gitignore.CompileIgnoreLines(ignoreRules...),
+		slots:         make(chan struct{}, concurrency-1),
+	}
+}
+
+// tryAcquireSlot returns true if a new goroutine can be started to walk a directory
+func (o *indexer) tryAcquireSlot() bool {
+	select {
+	case o.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o *indexer) releaseSlot() {
+	<-o.slots
+}
+
 // recursiveChecker visits the current source and it's inner files and folders, if any
 // the destination values are used to record the appropriate remote location for file or folder
-// ignoreRules are used to ignore file and folders
+// the ignore rules of the indexer are used to ignore file and folders
 // remoteDirectories are used to find the remote destination of the file/folder and to delete files/folders left behind after the attributes are changed
 // existingFileIndex is used to check for file/folder changes
-func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []string, remoteDirectories map[string]string, existingFileIndex FileIndex) (IndexerRet, error) {
+func (o *indexer) recursiveChecker(pathOptions recursiveCheckerPathOptions, remoteDirectories map[string]string, existingFileIndex FileIndex) (IndexerRet, error) {
 	klog.V(4).Infof("recursiveTar arguments: srcBase: %s, srcFile: %s, destBase: %s, destFile: %s", pathOptions.srcBase, pathOptions.srcFile, pathOptions.destBase, pathOptions.destFile)
 
 	// The destination is a LINUX container and thus we *must* use ToSlash in order
@@ -426,8 +483,6 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 	fileChanged := make(map[string]bool)
 	fileRemoteChanged := make(map[string]bool)
 
-	ignoreMatcher := gitignore.CompileIgnoreLines(ignoreRules...)
-
 	for _, matchedPath := range matchedPathsDir {
 		stat, err := os.Stat(matchedPath)
 		if err != nil {
@@ -439,24 +494,20 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 		if err != nil {
 			return IndexerRet{}, err
 		}
-		match := ignoreMatcher.MatchesPath(rel)
+		match := o.ignoreMatcher.MatchesPath(rel)
 		// the folder matches a glob rule and thus should be skipped
 		if match {
 			return IndexerRet{}, nil
 		}
 
+		var checksum string
 		if joinedRelPath != "." {
 			// check for changes in the size and the modified date of the file or folder
 			// and if the file is newly added
-			if _, ok := existingFileIndex.Files[joinedRelPath]; !ok {
-				fileChanged[matchedPath] = true
-				klog.V(4).Infof("file added: %s", matchedPath)
-			} else if !stat.ModTime().Equal(existingFileIndex.Files[joinedRelPath].LastModifiedDate) {
-				fileChanged[matchedPath] = true
-				klog.V(4).Infof("last modified date changed: %s", matchedPath)
-			} else if stat.Size() != existingFileIndex.Files[joinedRelPath].Size {
+			var changed bool
+			changed, checksum = o.checkChanges(matchedPath, stat, joinedRelPath, existingFileIndex)
+			if changed {
 				fileChanged[matchedPath] = true
-				klog.V(4).Infof("size changed: %s", matchedPath)
 			}
 		}
 
@@ -489,21 +540,11 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 			if len(entries) == 0 {
 				continue
 			}
-			for _, entry := range entries {
-				f, err := entry.Info()
-				if err != nil {
-					return IndexerRet{}, err
-				}
-				if _, ok := remoteDirectories[filepath.Join(joinedRelPath, f.Name())]; ok {
-					continue
-				}
-
-				opts := recursiveCheckerPathOptions{pathOptions.directory, pathOptions.srcBase, filepath.Join(pathOptions.srcFile, f.Name()), pathOptions.destBase, filepath.Join(pathOptions.destFile, f.Name())}
-				innerRet, err := recursiveChecker(opts, ignoreRules, remoteDirectories, existingFileIndex)
-				if err != nil {
-					return IndexerRet{}, err
-				}
-
+			innerRets, err := o.checkEntries(pathOptions, joinedRelPath, entries, remoteDirectories, existingFileIndex)
+			if err != nil {
+				return IndexerRet{}, err
+			}
+			for _, innerRet := range innerRets {
 				for k, v := range innerRet.NewFileMap {
 					ret.NewFileMap[k] = v
 				}
@@ -519,6 +560,7 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 			fileData, fileChangedData, fileRemoteChangedData := handleRemoteDataFile(pathOptions.destFile, matchedPath, joinedRelPath, remoteDirectories, existingFileIndex)
 			fileData.Size = stat.Size()
 			fileData.LastModifiedDate = stat.ModTime()
+			fileData.Checksum = checksum
 			ret.NewFileMap[joinedRelPath] = fileData
 
 			for data, value := range fileChangedData {
@@ -548,6 +590,91 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 	return ret, nil
 }
 
+// checkEntries runs recursiveChecker on the entries of the directory, walking the sub-directories in new goroutines when slots are available.
+// The results are returned in the order of the entries.
+func (o *indexer) checkEntries(pathOptions recursiveCheckerPathOptions, joinedRelPath string, entries []os.DirEntry, remoteDirectories map[string]string, existingFileIndex FileIndex) ([]IndexerRet, error) {
+	rets := make([]IndexerRet, len(entries))
+	errs := make([]error, len(entries))
+	var wg gosync.WaitGroup
+	for i, entry := range entries {
+		if _, ok := remoteDirectories[filepath.Join(joinedRelPath, entry.Name())]; ok {
+			continue
+		}
+
+		opts := recursiveCheckerPathOptions{pathOptions.directory, pathOptions.srcBase, filepath.Join(pathOptions.srcFile, entry.Name()), pathOptions.destBase, filepath.Join(pathOptions.destFile, entry.Name())}
+		if entry.IsDir() && o.tryAcquireSlot() {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer o.releaseSlot()
+				rets[i], errs[i] = o.recursiveChecker(opts, remoteDirectories, existingFileIndex)
+			}(i)
+			continue
+		}
+		rets[i], errs[i] = o.recursiveChecker(opts, remoteDirectories, existingFileIndex)
+		if errs[i] != nil {
+			break
+		}
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rets, nil
+}
+
+// checkChanges returns true if the file or folder has been added or modified since it was recorded in existingFileIndex.
+// The size and modification date are compared first; when content hashes are enabled, the checksum of a file
+// with the same size but a different modification date is compared with the recorded one.
+// It also returns the checksum of the file content to record in the index, if any.
+func (o *indexer) checkChanges(path string, stat os.FileInfo, relPath string, existingFileIndex FileIndex) (bool, string) {
+	existingFileData, ok := existingFileIndex.Files[relPath]
+	if !ok {
+		klog.V(4).Infof("file added: %s", path)
+		return true, o.computeChecksum(path, stat)
+	}
+	if stat.Size() != existingFileData.Size {
+		klog.V(4).Infof("size changed: %s", path)
+		return true, o.computeChecksum(path, stat)
+	}
+	if stat.ModTime().Equal(existingFileData.LastModifiedDate) {
+		if existingFileData.Checksum == "" {
+			return false, o.computeChecksum(path, stat)
+		}
+		return false, existingFileData.Checksum
+	}
+	checksum := o.computeChecksum(path, stat)
+	if checksum != "" && checksum == existingFileData.Checksum {
+		klog.V(4).Infof("last modified date changed, but content unchanged: %s", path)
+		return false, checksum
+	}
+	klog.V(4).Infof("last modified date changed: %s", path)
+	return true, checksum
+}
+
+// computeChecksum returns the checksum of the content of the file, if content hashes are enabled
+func (o *indexer) computeChecksum(path string, stat os.FileInfo) string {
+	if !o.options.ContentHash || !stat.Mode().IsRegular() {
+		return ""
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		klog.V(4).Infof("unable to compute checksum of %s: %v", path, err)
+		return ""
+	}
+	defer f.Close() // #nosec G307
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		klog.V(4).Infof("unable to compute checksum of %s: %v", path, err)
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // handleRemoteDataFile handles remote addition, deletion etc for the given file
 func handleRemoteDataFile(destFile, path, relPath string, remoteDirectories map[string]string, existingFileIndex FileIndex) (FileData, map[string]bool, map[string]bool) {
 	destFile = filepath.ToSlash(destFile)
@@ -1,10 +1,12 @@
 package util
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -1116,7 +1118,7 @@ func Test_recursiveChecker(t *testing.T) {
 				}
 			}
 			pathsOptions := recursiveCheckerPathOptions{tt.args.directory, tt.args.srcBase, tt.args.srcFile, tt.args.destBase, tt.args.destFile}
-			got, err := recursiveChecker(pathsOptions, tt.args.ignoreRules, tt.args.remoteDirectories, tt.args.existingFileIndex)
+			got, err := newIndexer(tt.args.ignoreRules, IndexerOptions{}).recursiveChecker(pathsOptions, tt.args.remoteDirectories, tt.args.existingFileIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("recursiveChecker() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1666,7 +1668,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRet, err := runIndexerWithExistingFileIndex(tt.args.directory, tt.args.ignoreRules, tt.args.remoteDirectories, tt.args.existingFileIndex)
+			gotRet, err := runIndexerWithExistingFileIndex(tt.args.directory, tt.args.ignoreRules, tt.args.remoteDirectories, tt.args.existingFileIndex, IndexerOptions{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("runIndexerWithExistingFileIndex() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1698,6 +1700,141 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 }
 
 // Copied from: https://go-review.googlesource.com/c/go/+/18034/2/src/path/filepath/match_test.go
+func Test_indexer_contentHash(t *testing.T) {
+	const content = "some content"
+	// sha256 of content
+	const checksum = "290f493c44f5d63d06b374d0a5abd292fae38b92cab2fae5efefe1b0e9347f56"
+
+	tests := []struct {
+		name string
+		// modify is applied on the file after it has been indexed
+		modify          func(t *testing.T, file string)
+		contentHash     bool
+		wantChanged     bool
+		wantChecksumSet bool
+	}{
+		{
+			name:        "content hash disabled, only modification date changed",
+			modify:      touch,
+			contentHash: false,
+			wantChanged: true,
+		},
+		{
+			name:            "content hash enabled, only modification date changed",
+			modify:          touch,
+			contentHash:     true,
+			wantChanged:     false,
+			wantChecksumSet: true,
+		},
+		{
+			name: "content hash enabled, content changed with same size",
+			modify: func(t *testing.T, file string) {
+				if err := os.WriteFile(file, []byte("other content"[:len(content)]), 0600); err != nil {
+					t.Fatal(err)
+				}
+				touch(t, file)
+			},
+			contentHash:     true,
+			wantChanged:     true,
+			wantChecksumSet: true,
+		},
+		{
+			name:            "content hash enabled, file unchanged",
+			modify:          func(t *testing.T, file string) {},
+			contentHash:     true,
+			wantChanged:     false,
+			wantChecksumSet: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directory := t.TempDir()
+			file := filepath.Join(directory, "file.txt")
+			if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			options := IndexerOptions{ContentHash: tt.contentHash}
+
+			existingFileIndex := NewFileIndex()
+			first, err := runIndexerWithExistingFileIndex(directory, nil, nil, existingFileIndex, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			existingFileIndex.Files = first.NewFileMap
+
+			tt.modify(t, file)
+
+			got, err := runIndexerWithExistingFileIndex(directory, nil, nil, existingFileIndex, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changed := len(got.FilesChanged) != 0
+			if changed != tt.wantChanged {
+				t.Errorf("file changed = %v, want %v", changed, tt.wantChanged)
+			}
+			gotChecksum := got.NewFileMap["file.txt"].Checksum
+			if (gotChecksum != "") != tt.wantChecksumSet {
+				t.Errorf("checksum = %q, want checksum set: %v", gotChecksum, tt.wantChecksumSet)
+			}
+			if tt.wantChecksumSet && !tt.wantChanged && gotChecksum != checksum {
+				t.Errorf("checksum = %q, want %q", gotChecksum, checksum)
+			}
+		})
+	}
+}
+
+func Test_indexer_concurrency(t *testing.T) {
+	directory := t.TempDir()
+	var want []string
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			dir := filepath.Join(directory, fmt.Sprintf("dir%d", i), fmt.Sprintf("subdir%d", j))
+			if err := os.MkdirAll(dir, 0750); err != nil {
+				t.Fatal(err)
+			}
+			file := filepath.Join(dir, "file.txt")
+			if err := os.WriteFile(file, []byte(file), 0600); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, file, dir)
+		}
+		want = append(want, filepath.Join(directory, fmt.Sprintf("dir%d", i)))
+	}
+
+	sortOpt := cmpopts.SortSlices(func(x, y string) bool {
+		return x < y
+	})
+	var sequential IndexerRet
+	for _, concurrency := range []int{1, 4, 64} {
+		got, err := runIndexerWithExistingFileIndex(directory, nil, nil, NewFileIndex(), IndexerOptions{Concurrency: concurrency})
+		if err != nil {
+			t.Fatalf("concurrency %d: unexpected error: %v", concurrency, err)
+		}
+		if diff := cmp.Diff(want, got.FilesChanged, sortOpt); diff != "" {
+			t.Errorf("concurrency %d: FilesChanged mismatch (-want +got):\n%s", concurrency, diff)
+		}
+		if concurrency == 1 {
+			sequential = got
+			continue
+		}
+		if diff := cmp.Diff(sequential.NewFileMap, got.NewFileMap); diff != "" {
+			t.Errorf("concurrency %d: NewFileMap mismatch with sequential walk (-want +got):\n%s", concurrency, diff)
+		}
+	}
+}
+
+// touch sets the modification date of the file one hour later
+func touch(t *testing.T, file string) {
+	stat, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtime := stat.ModTime().Add(time.Hour)
+	if err = os.Chtimes(file, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func Test_globEscape(t *testing.T) {
 	cases := []struct {
 		value string
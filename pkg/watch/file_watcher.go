@@ -1,9 +1,12 @@
 package watch
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/fsnotify/fsnotify"
@@ -14,10 +17,72 @@ import (
 	"github.com/redhat-developer/odo/pkg/util"
 )
 
-func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher, error) {
-	absIgnorePaths := dfutil.GetAbsGlobExps(path, fileIgnores)
+// errWatchesExhausted is returned when some paths cannot be watched with filesystem notifications,
+// generally because the limits of the system have been reached
+var errWatchesExhausted = errors.New("unable to watch all the paths with filesystem notifications")
+
+// fileWatcher notifies of the changes of the files and directories added to it.
+// As for fsnotify, adding a directory notifies of the changes of its direct children.
+type fileWatcher interface {
+	Add(name string) error
+	Remove(name string) error
+	Close() error
+	Events() <-chan fsnotify.Event
+	Errors() <-chan error
+}
+
+// newFileWatcherFunc returns a new fileWatcher
+type newFileWatcherFunc func() (fileWatcher, error)
+
+// fsnotifyWatcher is a fileWatcher relying on the filesystem notifications
+type fsnotifyWatcher struct {
+	*fsnotify.Watcher
+	// addErr is the last error returned when adding a path to the watcher, because the limits of the system have been reached
+	addErr error
+}
+
+var _ fileWatcher = (*fsnotifyWatcher)(nil)
 
+func newFsnotifyWatcher() (fileWatcher, error) {
 	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &fsnotifyWatcher{Watcher: watcher}, nil
+}
+
+func (o *fsnotifyWatcher) Add(name string) error {
+	err := o.Watcher.Add(name)
+	if isWatchLimitError(err) {
+		o.addErr = err
+	}
+	return err
+}
+
+// isWatchLimitError returns true if err is returned because the limits of the system on the number of watches
+// or of open files have been reached
+func isWatchLimitError(err error) bool {
+	// Linux "no space left on device" issues are usually resolved via
+	// $ sudo sysctl fs.inotify.max_user_watches=65536
+	// BSD / OSX: "too many open files" issues are usually resolved via
+	// $ sysctl variables "kern.maxfiles" and "kern.maxfilesperproc",
+	return errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE)
+}
+
+func (o *fsnotifyWatcher) Events() <-chan fsnotify.Event {
+	return o.Watcher.Events
+}
+
+func (o *fsnotifyWatcher) Errors() <-chan error {
+	return o.Watcher.Errors
+}
+
+// getFullSourcesWatcher returns a watcher created with newWatcher, watching the path and its sub-directories, except the ones matching fileIgnores.
+// An error wrapping errWatchesExhausted is returned if some directories cannot be watched with filesystem notifications.
+func getFullSourcesWatcher(path string, fileIgnores []string, newWatcher newFileWatcherFunc) (fileWatcher, error) {
+	absIgnorePaths := dfutil.GetAbsGlobExps(path, fileIgnores)
+
+	watcher, err := newWatcher()
 	if err != nil {
 		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
 	}
@@ -26,8 +91,13 @@ func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher
 	// so directory and the path in addRecursiveWatch() are the same
 	err = addRecursiveWatch(watcher, path, path, absIgnorePaths)
 	if err != nil {
+		_ = watcher.Close()
 		return nil, fmt.Errorf("error watching source path %s: %v", path, err)
 	}
+	if w, ok := watcher.(*fsnotifyWatcher); ok && w.addErr != nil {
+		_ = watcher.Close()
+		return nil, fmt.Errorf("%w: %v", errWatchesExhausted, w.addErr)
+	}
 	return watcher, nil
 }
 
@@ -38,7 +108,7 @@ func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher
 // rootPath is the root path of the file or directory,
 // path is the recursive path of the file or the directory,
 // ignores contains the glob rules for matching
-func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string, ignores []string) error {
+func addRecursiveWatch(watcher fileWatcher, rootPath string, path string, ignores []string) error {
 
 	fsys := filesystem.DefaultFs{}
 
@@ -68,11 +138,7 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 				return nil
 			}
 
-			err = watcher.Add(path)
-			if err != nil {
-				klog.V(4).Infof("error adding watcher for path %s: %v", path, err)
-			}
-			return nil
+			return addWatch(watcher, path)
 		}
 	}
 
@@ -128,14 +194,24 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 		}
 
 		klog.V(4).Infof("adding watch on path %s", folder)
-		err = watcher.Add(folder)
+		err = addWatch(watcher, folder)
 		if err != nil {
-			// Linux "no space left on device" issues are usually resolved via
-			// $ sudo sysctl fs.inotify.max_user_watches=65536
-			// BSD / OSX: "too many open files" issues are ussualy resolved via
-			// $ sysctl variables "kern.maxfiles" and "kern.maxfilesperproc",
-			klog.V(4).Infof("error adding watcher for path %s: %v", folder, err)
+			return err
 		}
 	}
 	return nil
 }
+
+// addWatch adds the path to the watcher. The errors returned because the limits of the system have been reached
+// are ignored, so the other paths are still added, as are the errors for paths removed meanwhile.
+func addWatch(watcher fileWatcher, path string) error {
+	err := watcher.Add(path)
+	if err == nil {
+		return nil
+	}
+	klog.V(4).Infof("error adding watcher for path %s: %v", path, err)
+	if isWatchLimitError(err) || errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return fmt.Errorf("unable to watch path %s: %w", path, err)
+}
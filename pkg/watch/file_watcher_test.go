@@ -0,0 +1,76 @@
+package watch
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// fakeFileWatcher is a fileWatcher returning addErr when a path is added
+type fakeFileWatcher struct {
+	addErr error
+}
+
+func (o *fakeFileWatcher) Add(name string) error         { return o.addErr }
+func (o *fakeFileWatcher) Remove(name string) error      { return nil }
+func (o *fakeFileWatcher) Close() error                  { return nil }
+func (o *fakeFileWatcher) Events() <-chan fsnotify.Event { return nil }
+func (o *fakeFileWatcher) Errors() <-chan error          { return nil }
+
+func Test_addWatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		addErr  error
+		wantErr bool
+	}{
+		{
+			name: "path added",
+		},
+		{
+			name:   "limit of watches reached",
+			addErr: syscall.ENOSPC,
+		},
+		{
+			name:   "limit of open files reached",
+			addErr: &os.PathError{Op: "open", Path: "dir", Err: syscall.EMFILE},
+		},
+		{
+			name:   "path removed meanwhile",
+			addErr: &os.PathError{Op: "lstat", Path: "dir", Err: syscall.ENOENT},
+		},
+		{
+			name:    "permission denied",
+			addErr:  &os.PathError{Op: "open", Path: "dir", Err: syscall.EACCES},
+			wantErr: true,
+		},
+		{
+			name:    "other error",
+			addErr:  errors.New("an error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := addWatch(&fakeFileWatcher{addErr: tt.addErr}, "dir")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("addWatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_getFullSourcesWatcher_addError(t *testing.T) {
+	dir := t.TempDir()
+	_, err := getFullSourcesWatcher(dir, nil, func() (fileWatcher, error) {
+		return &fakeFileWatcher{addErr: &os.PathError{Op: "open", Path: dir, Err: syscall.EACCES}}, nil
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if errors.Is(err, errWatchesExhausted) {
+		t.Errorf("error %v should not fall back to polling", err)
+	}
+}
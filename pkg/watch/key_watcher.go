@@ -9,9 +9,9 @@ import (
 	"golang.org/x/term"
 )
 
-// getKeyWatcher returns a channel which will emit
+// GetKeyWatcher returns a channel which will emit
 // characters when keys are pressed on the keyboard
-func getKeyWatcher(ctx context.Context, out io.Writer) <-chan byte {
+func GetKeyWatcher(ctx context.Context, out io.Writer) <-chan byte {
 
 	keyInput := make(chan byte)
 
@@ -0,0 +1,28 @@
+//go:build linux
+// +build linux
+
+package watch
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// networkFilesystems are the types of the filesystems for which no filesystem notification is received
+// for the changes made from other machines
+var networkFilesystems = map[uint32]bool{
+	unix.NFS_SUPER_MAGIC:  true,
+	unix.SMB_SUPER_MAGIC:  true,
+	unix.SMB2_SUPER_MAGIC: true,
+	unix.CIFS_SUPER_MAGIC: true,
+	// sshfs and other filesystems in userspace
+	unix.FUSE_SUPER_MAGIC: true,
+}
+
+// isNetworkFilesystem returns true if path is on a network filesystem
+func isNetworkFilesystem(path string) bool {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return false
+	}
+	return networkFilesystems[uint32(stat.Type)]
+}
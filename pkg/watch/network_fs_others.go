@@ -0,0 +1,10 @@
+//go:build !linux
+// +build !linux
+
+package watch
+
+// isNetworkFilesystem returns true if path is on a network filesystem.
+// The detection is supported on Linux only.
+func isNetworkFilesystem(path string) bool {
+	return false
+}
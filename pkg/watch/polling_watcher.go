@@ -0,0 +1,206 @@
+package watch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog"
+)
+
+// fileState is the state of a file or directory, as compared between two polls
+type fileState struct {
+	size    int64
+	modTime time.Time
+	mode    os.FileMode
+}
+
+// pollingWatcher is a fileWatcher comparing periodically the state of the watched paths with their previous state.
+// It is used on network filesystems, for which no notification is received, and when the number of watches is limited by the system.
+type pollingWatcher struct {
+	interval time.Duration
+	events   chan fsnotify.Event
+	errors   chan error
+	done     chan struct{}
+
+	closeOnce sync.Once
+	mu        sync.Mutex
+	// watched are the states of the watched paths and of their direct children, indexed by watched path then by path
+	watched map[string]map[string]fileState
+}
+
+var _ fileWatcher = (*pollingWatcher)(nil)
+
+// newPollingWatcher returns a pollingWatcher comparing the states of the watched paths every interval
+func newPollingWatcher(interval time.Duration) *pollingWatcher {
+	o := &pollingWatcher{
+		interval: interval,
+		events:   make(chan fsnotify.Event),
+		errors:   make(chan error),
+		done:     make(chan struct{}),
+		watched:  make(map[string]map[string]fileState),
+	}
+	go o.run()
+	return o
+}
+
+func (o *pollingWatcher) Add(name string) error {
+	name = filepath.Clean(name)
+	snapshot, err := takeSnapshot(name)
+	if err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, found := o.watched[name]; !found {
+		o.watched[name] = snapshot
+	}
+	return nil
+}
+
+func (o *pollingWatcher) Remove(name string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.watched, filepath.Clean(name))
+	return nil
+}
+
+func (o *pollingWatcher) Close() error {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+	return nil
+}
+
+func (o *pollingWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *pollingWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+func (o *pollingWatcher) run() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, event := range o.poll() {
+				select {
+				case o.events <- event:
+				case <-o.done:
+					return
+				}
+			}
+		case <-o.done:
+			return
+		}
+	}
+}
+
+// poll updates the states of the watched paths, and returns the events describing the changes since the previous poll
+func (o *pollingWatcher) poll() []fsnotify.Event {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	names := make([]string, 0, len(o.watched))
+	for name := range o.watched {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var events []fsnotify.Event
+	for _, name := range names {
+		previous := o.watched[name]
+		current, err := takeSnapshot(name)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				klog.V(4).Infof("error polling path %s: %v", name, err)
+				continue
+			}
+			current = nil
+		}
+		events = append(events, compareSnapshots(name, previous, current)...)
+		if current == nil {
+			// As for fsnotify, a deleted path is not watched anymore
+			delete(o.watched, name)
+			continue
+		}
+		o.watched[name] = current
+	}
+	return events
+}
+
+// takeSnapshot returns the state of the path and, if it is a directory, of its direct children
+func takeSnapshot(name string) (map[string]fileState, error) {
+	stat, err := os.Lstat(name)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := map[string]fileState{
+		name: newFileState(stat),
+	}
+	if !stat.IsDir() {
+		return snapshot, nil
+	}
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			// the entry has been deleted since the directory was read
+			continue
+		}
+		snapshot[filepath.Join(name, entry.Name())] = newFileState(info)
+	}
+	return snapshot, nil
+}
+
+func newFileState(info os.FileInfo) fileState {
+	return fileState{
+		size:    info.Size(),
+		modTime: info.ModTime(),
+		mode:    info.Mode(),
+	}
+}
+
+// compareSnapshots returns the events describing the changes between the previous and current snapshots of the watched path name.
+// A nil current snapshot indicates that the watched path has been deleted.
+func compareSnapshots(name string, previous, current map[string]fileState) []fsnotify.Event {
+	var events []fsnotify.Event
+	paths := make([]string, 0, len(previous)+len(current))
+	for path := range previous {
+		paths = append(paths, path)
+	}
+	for path := range current {
+		if _, found := previous[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		before, existed := previous[path]
+		after, exists := current[path]
+		switch {
+		case existed && !exists:
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Remove})
+		case !existed && exists:
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Create})
+		case before != after:
+			if path == name && after.mode.IsDir() {
+				// the changes of the content of the directory are notified for each child
+				continue
+			}
+			events = append(events, fsnotify.Event{Name: path, Op: fsnotify.Write})
+		}
+	}
+	return events
+}
@@ -0,0 +1,153 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_pollingWatcher_poll(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup creates the initial files in dir, before they are watched
+		setup func(t *testing.T, dir string)
+		// watch returns the paths to watch
+		watch func(dir string) []string
+		// modify modifies the files in dir, after they are watched
+		modify func(t *testing.T, dir string)
+		want   func(dir string) []fsnotify.Event
+	}{
+		{
+			name:  "no change",
+			setup: func(t *testing.T, dir string) { writeFile(t, filepath.Join(dir, "file1"), "content") },
+			watch: func(dir string) []string { return []string{dir} },
+			modify: func(t *testing.T, dir string) {
+			},
+			want: func(dir string) []fsnotify.Event { return nil },
+		},
+		{
+			name: "files created, modified and deleted in a watched directory",
+			setup: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "file1"), "content")
+				writeFile(t, filepath.Join(dir, "file2"), "content")
+			},
+			watch: func(dir string) []string { return []string{dir} },
+			modify: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "file1"), "new content")
+				if err := os.Remove(filepath.Join(dir, "file2")); err != nil {
+					t.Fatal(err)
+				}
+				writeFile(t, filepath.Join(dir, "file3"), "content")
+			},
+			want: func(dir string) []fsnotify.Event {
+				return []fsnotify.Event{
+					{Name: filepath.Join(dir, "file1"), Op: fsnotify.Write},
+					{Name: filepath.Join(dir, "file2"), Op: fsnotify.Remove},
+					{Name: filepath.Join(dir, "file3"), Op: fsnotify.Create},
+				}
+			},
+		},
+		{
+			name: "changes in a sub-directory not watched",
+			setup: func(t *testing.T, dir string) {
+				if err := os.Mkdir(filepath.Join(dir, "subdir"), 0750); err != nil {
+					t.Fatal(err)
+				}
+			},
+			watch: func(dir string) []string { return []string{dir} },
+			modify: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "subdir", "file1"), "content")
+			},
+			// the modification date of the sub-directory changes; such events on directories are ignored by evaluateFileChanges
+			want: func(dir string) []fsnotify.Event {
+				return []fsnotify.Event{
+					{Name: filepath.Join(dir, "subdir"), Op: fsnotify.Write},
+				}
+			},
+		},
+		{
+			name:  "watched file deleted",
+			setup: func(t *testing.T, dir string) { writeFile(t, filepath.Join(dir, "file1"), "content") },
+			watch: func(dir string) []string { return []string{filepath.Join(dir, "file1")} },
+			modify: func(t *testing.T, dir string) {
+				if err := os.Remove(filepath.Join(dir, "file1")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: func(dir string) []fsnotify.Event {
+				return []fsnotify.Event{
+					{Name: filepath.Join(dir, "file1"), Op: fsnotify.Remove},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.setup(t, dir)
+
+			watcher := newPollingWatcher(time.Hour)
+			defer watcher.Close()
+			for _, path := range tt.watch(dir) {
+				if err := watcher.Add(path); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tt.modify(t, dir)
+
+			got := watcher.poll()
+			if diff := cmp.Diff(tt.want(dir), got); diff != "" {
+				t.Errorf("poll() mismatch (-want +got):\n%s", diff)
+			}
+
+			// the changes are notified only once
+			if got = watcher.poll(); len(got) != 0 {
+				t.Errorf("poll() returned events %v on second call, want none", got)
+			}
+		})
+	}
+}
+
+func Test_pollingWatcher_events(t *testing.T) {
+	dir := t.TempDir()
+	watcher := newPollingWatcher(10 * time.Millisecond)
+	defer watcher.Close()
+	if err := watcher.Add(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(dir, "file1")
+	writeFile(t, file, "content")
+
+	select {
+	case event := <-watcher.Events():
+		want := fsnotify.Event{Name: file, Op: fsnotify.Create}
+		if event != want {
+			t.Errorf("got event %v, want %v", event, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("no event received")
+	}
+}
+
+// writeFile writes the content into the file, and changes its modification date so the change is detected
+// even when the filesystem has a coarse timestamp granularity
+func writeFile(t *testing.T, file string, content string) {
+	var mtime time.Time
+	if stat, err := os.Stat(file); err == nil {
+		mtime = stat.ModTime().Add(time.Second)
+	} else {
+		mtime = time.Now().Add(-time.Hour)
+	}
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -34,15 +34,23 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+
+	// DefaultPullInterval is the default interval at which the files generated in the container are pulled into the local directory
+	DefaultPullInterval = 2 * time.Second
+
+	// DefaultPollInterval is the default interval at which the files are polled for changes, when polling is used
+	DefaultPollInterval = time.Second
+	// DefaultDebounceInterval is the default duration to wait for other changes after a file change is detected, before syncing the files
+	DefaultDebounceInterval = 100 * time.Millisecond
 )
 
 type WatchClient struct {
 	kubeClient     kclient.ClientInterface
 	informerClient *informer.InformerClient
 
-	sourcesWatcher    *fsnotify.Watcher
+	sourcesWatcher    fileWatcher
 	deploymentWatcher watch.Interface
-	devfileWatcher    *fsnotify.Watcher
+	devfileWatcher    fileWatcher
 	podWatcher        watch.Interface
 	warningsWatcher   watch.Interface
 	keyWatcher        <-chan byte
@@ -50,6 +58,10 @@ type WatchClient struct {
 	// true to force sync, used when manual sync
 	forceSync bool
 
+	// pulledFiles are the local files written when pulling files from the container, with their modification time once pulled.
+	// They must not be pushed back to the container, until they are modified again locally.
+	pulledFiles map[string]time.Time
+
 	// deploymentGeneration indicates the generation of the latest observed Deployment
 	deploymentGeneration int64
 	readyReplicas        int32
@@ -75,6 +87,9 @@ type WatchParameters struct {
 	// WatchHandler func(kclient.ClientInterface, string, string, string, io.Writer, []string, []string, bool, []string, bool) error
 	// Custom function that can be used to push detected changes to remote devfile pod. For more info about what each of the parameters to this function, please refer, pkg/devfile/adapters/interface.go#PlatformAdapter
 	DevfileWatchHandler func(context.Context, common.PushParameters, *ComponentStatus) error
+	// Custom function that can be used to pull files generated in the remote devfile pod into the local directory. It is called periodically
+	// when the component is ready, and returns the absolute paths of the pulled files. If nil, no file is pulled.
+	DevfilePullHandler func(context.Context, dev.StartOptions) ([]string, error)
 	// Parameter whether or not to show build logs
 	Show bool
 	// DebugPort indicates which debug port to use for pushing after sync
@@ -87,7 +102,7 @@ type WatchParameters struct {
 // evaluateChangesFunc evaluates any file changes for the events by ignoring the files in fileIgnores slice and removes
 // any deleted paths from the watcher. It returns a slice of changed files (if any) and paths that are deleted (if any)
 // by the events
-type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) (changedFiles, deletedPaths []string)
+type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) (changedFiles, deletedPaths []string)
 
 // processEventsFunc processes the events received on the watcher. It uses the WatchParameters to trigger watch handler and writes to out
 // It returns a Duration after which to recall in case of error
@@ -105,13 +120,24 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 	klog.V(4).Infof("starting WatchAndPush, path: %s, component: %s, ignores %s", path, componentName, parameters.StartOptions.IgnorePaths)
 
 	var err error
+	polling := parameters.StartOptions.WatchPolling
+	if parameters.StartOptions.WatchFiles && !polling && isNetworkFilesystem(path) {
+		log.Fwarning(parameters.StartOptions.Out, "The current directory is on a network filesystem, polling for changes")
+		polling = true
+	}
 	if parameters.StartOptions.WatchFiles {
-		o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths)
+		o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths, getNewFileWatcher(polling, parameters))
+		if errors.Is(err, errWatchesExhausted) {
+			klog.V(2).Infof("falling back to polling: %v", err)
+			log.Fwarning(parameters.StartOptions.Out, "Unable to watch all the directories for changes, polling for changes instead. Increasing the number of watches allowed by the system (fs.inotify.max_user_watches on Linux) may help")
+			polling = true
+			o.sourcesWatcher, err = getFullSourcesWatcher(path, parameters.StartOptions.IgnorePaths, getNewFileWatcher(polling, parameters))
+		}
 		if err != nil {
 			return err
 		}
 	} else {
-		o.sourcesWatcher, err = fsnotify.NewWatcher()
+		o.sourcesWatcher, err = newFsnotifyWatcher()
 		if err != nil {
 			return err
 		}
@@ -134,10 +160,11 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 		o.podWatcher = NewNoOpWatcher()
 	}
 
-	o.devfileWatcher, err = fsnotify.NewWatcher()
+	o.devfileWatcher, err = getNewFileWatcher(polling, parameters)()
 	if err != nil {
 		return err
 	}
+	defer o.devfileWatcher.Close()
 	if parameters.StartOptions.WatchFiles {
 		var devfileFiles []string
 		devfileFiles, err = libdevfile.GetReferencedLocalFiles(*devfileObj)
@@ -166,7 +193,11 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 		o.warningsWatcher = NewNoOpWatcher()
 	}
 
-	o.keyWatcher = getKeyWatcher(ctx, parameters.StartOptions.Out)
+	if parameters.StartOptions.SkipKeyWatcher {
+		o.keyWatcher = nil
+	} else {
+		o.keyWatcher = GetKeyWatcher(ctx, parameters.StartOptions.Out)
+	}
 
 	err = o.processEvents(ctx, parameters, nil, nil, &componentStatus)
 	if err != nil {
@@ -176,6 +207,21 @@ func (o *WatchClient) WatchAndPush(ctx context.Context, parameters WatchParamete
 	return o.eventWatcher(ctx, parameters, evaluateFileChanges, o.processEvents, componentStatus)
 }
 
+// getNewFileWatcher returns the function creating the file watchers, polling the files for changes at the interval defined in parameters
+// if polling is true, or relying on filesystem notifications otherwise
+func getNewFileWatcher(polling bool, parameters WatchParameters) newFileWatcherFunc {
+	if !polling {
+		return newFsnotifyWatcher
+	}
+	interval := parameters.StartOptions.WatchPollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return func() (fileWatcher, error) {
+		return newPollingWatcher(interval), nil
+	}
+}
+
 // eventWatcher loops till the context's Done channel indicates it to stop looping, at which point it performs cleanup.
 // While looping, it listens for filesystem events and processes these events using the WatchParameters to push to the remote pod.
 // It outputs any logs to the out io Writer
@@ -197,9 +243,14 @@ func (o *WatchClient) eventWatcher(
 
 	var events []fsnotify.Event
 
+	debounceInterval := parameters.StartOptions.WatchDebounceInterval
+	if debounceInterval <= 0 {
+		debounceInterval = DefaultDebounceInterval
+	}
+
 	// sourcesTimer helps collect multiple events that happen in a quick succession. We start with 1ms as we don't care much
 	// at this point. In the select block, however, every time we receive an event, we reset the sourcesTimer to watch for
-	// 100ms since receiving that event. This is done because a single filesystem event by the user triggers multiple
+	// the debounce interval (100ms by default) since receiving that event. This is done because a single filesystem event by the user triggers multiple
 	// events for fsnotify. It's a known-issue, but not really bug. For more info look at below issues:
 	//    - https://github.com/fsnotify/fsnotify/issues/122
 	//    - https://github.com/fsnotify/fsnotify/issues/344
@@ -214,14 +265,26 @@ func (o *WatchClient) eventWatcher(
 	deployTimer := time.NewTimer(time.Millisecond)
 	<-deployTimer.C
 
+	// pullTicker triggers the pull of files generated in the container, when a pull handler is defined
+	var pullTick <-chan time.Time
+	if parameters.DevfilePullHandler != nil {
+		pullInterval := parameters.StartOptions.PullInterval
+		if pullInterval <= 0 {
+			pullInterval = DefaultPullInterval
+		}
+		pullTicker := time.NewTicker(pullInterval)
+		defer pullTicker.Stop()
+		pullTick = pullTicker.C
+	}
+
 	podsPhases := NewPodPhases()
 
 	for {
 		select {
-		case event := <-o.sourcesWatcher.Events:
+		case event := <-o.sourcesWatcher.Events():
 			events = append(events, event)
 			// We are waiting for more events in this interval
-			sourcesTimer.Reset(100 * time.Millisecond)
+			sourcesTimer.Reset(debounceInterval)
 
 		case <-sourcesTimer.C:
 			// timer has fired
@@ -234,6 +297,7 @@ func (o *WatchClient) eventWatcher(
 			if !o.forceSync {
 				// first find the files that have changed (also includes the ones newly created) or deleted
 				changedFiles, deletedPaths = evaluateChangesHandler(events, path, parameters.StartOptions.IgnorePaths, o.sourcesWatcher)
+				changedFiles = o.filterPulledFiles(changedFiles)
 				// process the changes and sync files with remote pod
 				if len(changedFiles) == 0 && len(deletedPaths) == 0 {
 					continue
@@ -252,9 +316,25 @@ func (o *WatchClient) eventWatcher(
 				events = []fsnotify.Event{} // empty the events slice to capture new events
 			}
 
-		case watchErr := <-o.sourcesWatcher.Errors:
+		case watchErr := <-o.sourcesWatcher.Errors():
 			return watchErr
 
+		case <-pullTick:
+			if !componentCanSyncFile(componentStatus.GetState()) {
+				continue
+			}
+			pulled, err := parameters.DevfilePullHandler(ctx, parameters.StartOptions)
+			if err != nil {
+				klog.V(4).Infof("Error pulling files from the container: %v", err)
+				continue
+			}
+			for _, file := range pulled {
+				fmt.Fprintf(out, "\nFile %s pulled from the container\n", file)
+				if parameters.StartOptions.WatchFiles {
+					o.recordPulledFile(file)
+				}
+			}
+
 		case key := <-o.keyWatcher:
 			if key == 'p' {
 				o.forceSync = true
@@ -286,8 +366,8 @@ func (o *WatchClient) eventWatcher(
 				return err
 			}
 
-		case <-o.devfileWatcher.Events:
-			devfileTimer.Reset(100 * time.Millisecond)
+		case <-o.devfileWatcher.Events():
+			devfileTimer.Reset(debounceInterval)
 
 		case <-devfileTimer.C:
 			fmt.Fprintf(out, "Updating Component...\n\n")
@@ -326,7 +406,7 @@ func (o *WatchClient) eventWatcher(
 				}
 			}
 
-		case watchErr := <-o.devfileWatcher.Errors:
+		case watchErr := <-o.devfileWatcher.Errors():
 			return watchErr
 
 		case <-ctx.Done():
@@ -336,9 +416,43 @@ func (o *WatchClient) eventWatcher(
 	}
 }
 
+// recordPulledFile records the modification time of the file pulled from the container
+func (o *WatchClient) recordPulledFile(file string) {
+	stat, err := os.Stat(file)
+	if err != nil {
+		klog.V(4).Infof("unable to get the modification time of the pulled file %s: %v", file, err)
+		return
+	}
+	if o.pulledFiles == nil {
+		o.pulledFiles = make(map[string]time.Time)
+	}
+	o.pulledFiles[file] = stat.ModTime()
+}
+
+// filterPulledFiles removes from changedFiles the files pulled from the container and not modified locally since,
+// as they do not need to be pushed back.
+// The files modified locally since they were pulled are not considered as pulled anymore.
+func (o *WatchClient) filterPulledFiles(changedFiles []string) []string {
+	if len(o.pulledFiles) == 0 {
+		return changedFiles
+	}
+	var result []string
+	for _, file := range changedFiles {
+		if pulledTime, found := o.pulledFiles[file]; found {
+			stat, err := os.Stat(file)
+			if err == nil && stat.ModTime().Equal(pulledTime) {
+				continue
+			}
+			delete(o.pulledFiles, file)
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
-func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -428,6 +542,7 @@ func (o *WatchClient) processEvents(
 		WatchDeletedFiles:        deletedPaths,
 		DevfileScanIndexForWatch: !hasFirstSuccessfulPushOccurred,
 	}
+	pushParams.TriggeredCommands, pushParams.SyncOnly = getTriggeredCommands(ctx, path, append(changedFiles, deletedPaths...))
 	oldStatus := *componentStatus
 	err := parameters.DevfileWatchHandler(ctx, pushParams, componentStatus)
 	if err != nil {
@@ -460,6 +575,34 @@ func (o *WatchClient) processEvents(
 	return nil
 }
 
+// getTriggeredCommands returns the names of the Devfile commands triggered by the changes of the files, and whether
+// the run command does not need to be restarted, as defined by the "dev.odo.watch.triggers" attribute of the Devfile
+func getTriggeredCommands(ctx context.Context, path string, files []string) ([]string, bool) {
+	if len(files) == 0 {
+		return nil, false
+	}
+	triggers, err := common.GetWatchTriggersFromAttributes(*odocontext.GetEffectiveDevfileObj(ctx))
+	if err != nil {
+		klog.V(2).Infof("ignoring watch triggers: %v", err)
+		return nil, false
+	}
+	if len(triggers) == 0 {
+		return nil, false
+	}
+	relFiles := make([]string, 0, len(files))
+	for _, file := range files {
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			klog.V(4).Infof("unable to get relative path of %q on %q: %v", file, path, err)
+			return nil, false
+		}
+		relFiles = append(relFiles, rel)
+	}
+	commands, syncOnly := common.GetTriggeredCommands(triggers, relFiles)
+	klog.V(4).Infof("commands triggered by the changes: %v, sync only: %v", commands, syncOnly)
+	return commands, syncOnly
+}
+
 func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	if !(event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename) {
 		stat, err := os.Lstat(event.Name)
@@ -4,18 +4,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/dev"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 )
 
-func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -140,11 +143,11 @@ func Test_eventWatcher(t *testing.T) {
 			componentStatus.SetState(StateReady)
 
 			o := WatchClient{
-				sourcesWatcher:    watcher,
+				sourcesWatcher:    &fsnotifyWatcher{Watcher: watcher},
 				deploymentWatcher: fakeWatcher{},
 				podWatcher:        fakeWatcher{},
 				warningsWatcher:   fakeWatcher{},
-				devfileWatcher:    fileWatcher,
+				devfileWatcher:    &fsnotifyWatcher{Watcher: fileWatcher},
 				keyWatcher:        make(chan byte),
 			}
 			tt.args.parameters.StartOptions.Out = out
@@ -161,3 +164,40 @@ func Test_eventWatcher(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchClient_filterPulledFiles(t *testing.T) {
+	dir := t.TempDir()
+	mainFile := filepath.Join(dir, "main.go")
+	pulledFile := filepath.Join(dir, "api.go")
+	for _, file := range []string{mainFile, pulledFile} {
+		if err := os.WriteFile(file, []byte("package main"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pulledTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	if err := os.Chtimes(pulledFile, pulledTime, pulledTime); err != nil {
+		t.Fatal(err)
+	}
+
+	o := WatchClient{}
+	o.recordPulledFile(pulledFile)
+	got := o.filterPulledFiles([]string{mainFile, pulledFile})
+	if diff := cmp.Diff([]string{mainFile}, got); diff != "" {
+		t.Errorf("filterPulledFiles() mismatch (-want +got):\n%s", diff)
+	}
+
+	// later events for the same write of the pulled file are ignored too
+	got = o.filterPulledFiles([]string{pulledFile})
+	if len(got) != 0 {
+		t.Errorf("filterPulledFiles() = %v, want no file", got)
+	}
+
+	// a later change of the pulled file must be pushed
+	if err := os.Chtimes(pulledFile, time.Now(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	got = o.filterPulledFiles([]string{pulledFile})
+	if diff := cmp.Diff([]string{pulledFile}, got); diff != "" {
+		t.Errorf("filterPulledFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -9,6 +9,7 @@
  * https://openapi-generator.tech
  * Do not edit the class manually.
  */
+import { DevCommandStatus } from './devCommandStatus';
 
 
 export interface ComponentGet200Response { 
@@ -16,5 +17,9 @@ export interface ComponentGet200Response {
      * Description of the component. This is the same as output of \'odo describe component -o json\'
      */
     component?: object;
+    /**
+     * Statuses of the run or debug commands executed by the Dev sessions
+     */
+    devCommands?: Array<DevCommandStatus>;
 }
 
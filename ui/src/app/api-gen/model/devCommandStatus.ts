@@ -0,0 +1,30 @@
+/**
+ * odo dev
+ * API interface for \'odo dev\'
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+export interface DevCommandStatus { 
+    platform?: string;
+    /**
+     * Name of the component the command belongs to, when several components are developed in the same session
+     */
+    component?: string;
+    name: string;
+    /**
+     * Status of the process: starting, running, stopped or errored
+     */
+    status: string;
+    /**
+     * Number of times the process has been restarted after exiting
+     */
+    restarts?: number;
+}
+
@@ -5,6 +5,7 @@ export * from './componentCommandPostRequest';
 export * from './componentGet200Response';
 export * from './compositeCommand';
 export * from './container';
+export * from './devCommandStatus';
 export * from './devfileContent';
 export * from './devfileGet200Response';
 export * from './devfilePutRequest';